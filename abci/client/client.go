@@ -26,6 +26,9 @@ type lockFreeCallCtxKey struct{}
 type Client interface {
 	service.Service
 	types.Application
+	// SimulateTx fails, without stopping the client, if the application
+	// does not support it (see types.Simulator).
+	types.Simulator
 
 	// TODO: remove as each method now returns an error
 	Error() error
@@ -210,6 +210,10 @@ func (cli *grpcClient) Query(ctx context.Context, req *types.RequestQuery) (*typ
 	return cli.client.Query(ctx, types.ToRequestQuery(req).GetQuery(), grpc.WaitForReady(true))
 }
 
+func (cli *grpcClient) SimulateTx(ctx context.Context, req *types.RequestSimulateTx) (*types.ResponseSimulateTx, error) {
+	return cli.client.SimulateTx(ctx, req, grpc.WaitForReady(true))
+}
+
 func (cli *grpcClient) Commit(ctx context.Context, _ *types.RequestCommit) (*types.ResponseCommit, error) {
 	return cli.client.Commit(ctx, types.ToRequestCommit().GetCommit(), grpc.WaitForReady(true))
 }
@@ -118,6 +118,17 @@ func (app *localClient) Query(ctx context.Context, req *types.RequestQuery) (*ty
 	return app.Application.Query(ctx, req)
 }
 
+func (app *localClient) SimulateTx(ctx context.Context, req *types.RequestSimulateTx) (*types.ResponseSimulateTx, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	simulator, ok := app.Application.(types.Simulator)
+	if !ok {
+		return nil, types.ErrSimulateTxNotSupported
+	}
+	return simulator.SimulateTx(ctx, req)
+}
+
 func (app *localClient) Commit(ctx context.Context, req *types.RequestCommit) (*types.ResponseCommit, error) {
 	app.mtx.Lock()
 	defer app.mtx.Unlock()
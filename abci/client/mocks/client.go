@@ -672,6 +672,36 @@ func (_m *Client) SetResponseCallback(_a0 abcicli.Callback) {
 	_m.Called(_a0)
 }
 
+// SimulateTx provides a mock function with given fields: _a0, _a1
+func (_m *Client) SimulateTx(_a0 context.Context, _a1 *types.RequestSimulateTx) (*types.ResponseSimulateTx, error) {
+	ret := _m.Called(_a0, _a1)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SimulateTx")
+	}
+
+	var r0 *types.ResponseSimulateTx
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.RequestSimulateTx) (*types.ResponseSimulateTx, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *types.RequestSimulateTx) *types.ResponseSimulateTx); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.ResponseSimulateTx)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *types.RequestSimulateTx) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with no fields
 func (_m *Client) Start() error {
 	ret := _m.Called()
@@ -178,6 +178,15 @@ func (cli *socketClient) recvResponseRoutine(conn io.Reader) {
 
 		switch r := res.Value.(type) {
 		case *types.Response_Exception: // app responded with error
+			if cli.optionalRequestSent() {
+				// The application, e.g. built against an older version of
+				// ABCI, does not support the method: only the call fails.
+				if err := cli.didRecvResponse(res); err != nil {
+					cli.stopForError(err)
+					return
+				}
+				continue
+			}
 			// XXX After setting cli.err, release waiters (e.g. reqres.Done())
 			cli.stopForError(errors.New(r.Exception.Error))
 			return
@@ -203,6 +212,21 @@ func (cli *socketClient) trackRequest(reqres *ReqRes) {
 	cli.reqSent.PushBack(reqres)
 }
 
+// optionalRequestSent reports whether the first request awaiting a response
+// is a call to a method the application may not support, such as SimulateTx,
+// to which an exception is a valid response.
+func (cli *socketClient) optionalRequestSent() bool {
+	cli.mtx.Lock()
+	defer cli.mtx.Unlock()
+
+	next := cli.reqSent.Front()
+	if next == nil {
+		return false
+	}
+	_, ok := next.Value.(*ReqRes).Request.Value.(*types.Request_SimulateTx)
+	return ok
+}
+
 func (cli *socketClient) didRecvResponse(res *types.Response) error {
 	cli.mtx.Lock()
 	defer cli.mtx.Unlock()
@@ -313,6 +337,20 @@ func (cli *socketClient) Query(ctx context.Context, req *types.RequestQuery) (*t
 	return reqRes.Response.GetQuery(), cli.Error()
 }
 
+func (cli *socketClient) SimulateTx(ctx context.Context, req *types.RequestSimulateTx) (*types.ResponseSimulateTx, error) {
+	reqRes, err := cli.queueRequest(ctx, types.ToRequestSimulateTx(req))
+	if err != nil {
+		return nil, err
+	}
+	if err := cli.Flush(ctx); err != nil {
+		return nil, err
+	}
+	if exception := reqRes.Response.GetException(); exception != nil {
+		return nil, errors.New(exception.Error)
+	}
+	return reqRes.Response.GetSimulateTx(), cli.Error()
+}
+
 func (cli *socketClient) Commit(ctx context.Context, _ *types.RequestCommit) (*types.ResponseCommit, error) {
 	reqRes, err := cli.queueRequest(ctx, types.ToRequestCommit())
 	if err != nil {
@@ -495,6 +533,11 @@ func resMatchesReq(req *types.Request, res *types.Response) (ok bool) {
 		_, ok = res.Value.(*types.Response_Commit)
 	case *types.Request_Query:
 		_, ok = res.Value.(*types.Response_Query)
+	case *types.Request_SimulateTx:
+		switch res.Value.(type) {
+		case *types.Response_SimulateTx, *types.Response_Exception:
+			ok = true
+		}
 	case *types.Request_InitChain:
 		_, ok = res.Value.(*types.Response_InitChain)
 	case *types.Request_ApplySnapshotChunk:
@@ -42,6 +42,22 @@ func TestCalls(t *testing.T) {
 	}
 }
 
+func TestSimulateTxNotSupported(t *testing.T) {
+	ctx := t.Context()
+	app := types.BaseApplication{}
+
+	_, c := setupClientServer(t, app)
+
+	// The application does not implement Simulator: the call fails, but
+	// not the client.
+	_, err := c.SimulateTx(ctx, &types.RequestSimulateTx{Tx: []byte("tx")})
+	require.ErrorContains(t, err, types.ErrSimulateTxNotSupported.Error())
+	require.NoError(t, c.Error())
+	require.True(t, c.IsRunning())
+	_, err = c.Echo(ctx, "hello")
+	require.NoError(t, err)
+}
+
 func TestHangingAsyncCalls(t *testing.T) {
 	app := slowApp{}
 
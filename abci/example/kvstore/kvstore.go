@@ -30,6 +30,7 @@ const (
 )
 
 var _ types.Application = (*Application)(nil)
+var _ types.Simulator = (*Application)(nil)
 
 // Application is the kvstore state machine. It complies with the abci.Application interface.
 // It takes transactions in the form of key=value and saves them in a database. This is
@@ -227,37 +228,7 @@ func (app *Application) FinalizeBlock(_ context.Context, req *types.RequestFinal
 			app.stagedTxs = append(app.stagedTxs, tx)
 		}
 
-		var key, value string
-		parts := bytes.Split(tx, []byte("="))
-		if len(parts) == 2 {
-			key, value = string(parts[0]), string(parts[1])
-		} else {
-			key, value = string(tx), string(tx)
-		}
-		respTxs[i] = &types.ExecTxResult{
-			Code: CodeTypeOK,
-			// With every transaction we can emit a series of events. To make it simple, we just emit the same events.
-			Events: []types.Event{
-				{
-					Type: "app",
-					Attributes: []types.EventAttribute{
-						{Key: "creator", Value: "Cosmoshi Netowoko", Index: true},
-						{Key: "key", Value: key, Index: true},
-						{Key: "index_key", Value: "index is working", Index: true},
-						{Key: "noindex_key", Value: "index is working", Index: false},
-					},
-				},
-				{
-					Type: "app",
-					Attributes: []types.EventAttribute{
-						{Key: "creator", Value: "Cosmoshi", Index: true},
-						{Key: "key", Value: value, Index: true},
-						{Key: "index_key", Value: "index is working", Index: true},
-						{Key: "noindex_key", Value: "index is working", Index: false},
-					},
-				},
-			},
-		}
+		respTxs[i] = execTxResult(tx)
 		app.state.Size++
 	}
 
@@ -322,6 +293,55 @@ func (app *Application) FinalizeBlock(_ context.Context, req *types.RequestFinal
 	return response, nil
 }
 
+// execTxResult returns the result of the execution of tx.
+func execTxResult(tx []byte) *types.ExecTxResult {
+	var key, value string
+	parts := bytes.Split(tx, []byte("="))
+	if len(parts) == 2 {
+		key, value = string(parts[0]), string(parts[1])
+	} else {
+		key, value = string(tx), string(tx)
+	}
+	return &types.ExecTxResult{
+		Code: CodeTypeOK,
+		// With every transaction we can emit a series of events. To make it simple, we just emit the same events.
+		Events: []types.Event{
+			{
+				Type: "app",
+				Attributes: []types.EventAttribute{
+					{Key: "creator", Value: "Cosmoshi Netowoko", Index: true},
+					{Key: "key", Value: key, Index: true},
+					{Key: "index_key", Value: "index is working", Index: true},
+					{Key: "noindex_key", Value: "index is working", Index: false},
+				},
+			},
+			{
+				Type: "app",
+				Attributes: []types.EventAttribute{
+					{Key: "creator", Value: "Cosmoshi", Index: true},
+					{Key: "key", Value: value, Index: true},
+					{Key: "index_key", Value: "index is working", Index: true},
+					{Key: "noindex_key", Value: "index is working", Index: false},
+				},
+			},
+		},
+	}
+}
+
+// SimulateTx returns the result FinalizeBlock would return for tx, without
+// staging it. The transactions failing CheckTx, which would be rejected from
+// the proposals, are reported with the code of CheckTx.
+func (app *Application) SimulateTx(ctx context.Context, req *types.RequestSimulateTx) (*types.ResponseSimulateTx, error) {
+	resp, err := app.CheckTx(ctx, &types.RequestCheckTx{Tx: req.Tx})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != CodeTypeOK {
+		return &types.ResponseSimulateTx{TxResult: &types.ExecTxResult{Code: resp.Code, Log: "invalid transaction format"}}, nil
+	}
+	return &types.ResponseSimulateTx{TxResult: execTxResult(bytes.Replace(req.Tx, []byte(":"), []byte("="), 1))}, nil
+}
+
 // Commit is called after FinalizeBlock and after Tendermint state which includes the updates to
 // AppHash, ConsensusParams and ValidatorSet has occurred.
 // The KVStore persists the validator updates and the new key values
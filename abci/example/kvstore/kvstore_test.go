@@ -225,6 +225,39 @@ func TestCheckTx(t *testing.T) {
 	}
 }
 
+func TestSimulateTx(t *testing.T) {
+	ctx := t.Context()
+	kvstore := NewInMemoryApplication()
+	tx := []byte(testKey + "=" + testValue)
+
+	resSimulate, err := kvstore.SimulateTx(ctx, &types.RequestSimulateTx{Tx: tx})
+	require.NoError(t, err)
+	simulated := resSimulate.TxResult
+	require.NotNil(t, simulated)
+
+	// The simulation persists nothing.
+	resQuery, err := kvstore.Query(ctx, &types.RequestQuery{Data: []byte(testKey)})
+	require.NoError(t, err)
+	require.Nil(t, resQuery.Value)
+	info, err := kvstore.Info(ctx, &types.RequestInfo{})
+	require.NoError(t, err)
+	require.Zero(t, info.LastBlockHeight)
+
+	// The simulation returns the result of the execution.
+	resFinalize, err := kvstore.FinalizeBlock(ctx, &types.RequestFinalizeBlock{Height: 1, Txs: [][]byte{tx}})
+	require.NoError(t, err)
+	require.Equal(t, resFinalize.TxResults[0], simulated)
+
+	// The transactions are formatted as in the proposals.
+	formatted, err := kvstore.SimulateTx(ctx, &types.RequestSimulateTx{Tx: []byte(testKey + ":" + testValue)})
+	require.NoError(t, err)
+	require.Equal(t, simulated, formatted.TxResult)
+
+	resSimulate, err = kvstore.SimulateTx(ctx, &types.RequestSimulateTx{Tx: []byte("hello")})
+	require.NoError(t, err)
+	require.Equal(t, CodeTypeInvalidTxFormat, resSimulate.TxResult.Code)
+}
+
 func TestClientServer(t *testing.T) {
 	ctx := t.Context()
 	// set up socket app
@@ -319,6 +352,9 @@ func makeClientServer(t *testing.T, app types.Application, name, transport strin
 func runClientTests(ctx context.Context, t *testing.T, client abcicli.Client) {
 	// run some tests....
 	tx := []byte(testKey + ":" + testValue)
+	resSimulate, err := client.SimulateTx(ctx, &types.RequestSimulateTx{Tx: tx})
+	require.NoError(t, err)
+	require.Equal(t, CodeTypeOK, resSimulate.TxResult.Code)
 	testKVStore(ctx, t, client, tx, testKey, testValue)
 	tx = []byte(testKey + "=" + testValue)
 	testKVStore(ctx, t, client, tx, testKey, testValue)
@@ -5,6 +5,8 @@ import (
 	"net"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cometbft/cometbft/abci/types"
 	cmtnet "github.com/cometbft/cometbft/libs/net"
@@ -74,3 +76,11 @@ func (app *gRPCApplication) Echo(_ context.Context, req *types.RequestEcho) (*ty
 func (app *gRPCApplication) Flush(context.Context, *types.RequestFlush) (*types.ResponseFlush, error) {
 	return &types.ResponseFlush{}, nil
 }
+
+func (app *gRPCApplication) SimulateTx(ctx context.Context, req *types.RequestSimulateTx) (*types.ResponseSimulateTx, error) {
+	simulator, ok := app.Application.(types.Simulator)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, types.ErrSimulateTxNotSupported.Error())
+	}
+	return simulator.SimulateTx(ctx, req)
+}
@@ -128,8 +128,8 @@ func (s *SocketServer) acceptConnectionsRoutine() {
 
 		connID := s.addConn(conn)
 
-		closeConn := make(chan error, 2)                            // Push to signal connection closed
-		responses := make(chan *types.Response, responseBufferSize) // A channel to buffer responses
+		closeConn := make(chan error, 2)                           // Push to signal connection closed
+		responses := make(chan socketResponse, responseBufferSize) // A channel to buffer responses
 
 		// Read requests from conn and deal with them
 		go s.handleRequests(closeConn, conn, responses)
@@ -160,7 +160,7 @@ func (s *SocketServer) waitForClose(closeConn chan error, connID int) {
 }
 
 // Read requests from conn and deal with them
-func (s *SocketServer) handleRequests(closeConn chan error, conn io.Reader, responses chan<- *types.Response) {
+func (s *SocketServer) handleRequests(closeConn chan error, conn io.Reader, responses chan<- socketResponse) {
 	bufReader := bufio.NewReader(conn)
 
 	locked := false // true only while appMtx is held inside the loop
@@ -203,10 +203,12 @@ func (s *SocketServer) handleRequests(closeConn chan error, conn io.Reader, resp
 		if err != nil {
 			// any error either from the application or because of an unknown request
 			// throws an exception back to the client. This will stop the server and
-			// should also halt the client.
-			responses <- types.ToResponseException(err.Error())
+			// should also halt the client, unless the request is a call to an
+			// optional method, which only fails.
+			_, optional := req.Value.(*types.Request_SimulateTx)
+			responses <- socketResponse{Response: types.ToResponseException(err.Error()), keepOpen: optional}
 		} else {
-			responses <- resp
+			responses <- socketResponse{Response: resp}
 		}
 		s.appMtx.Unlock()
 		locked = false
@@ -244,6 +246,16 @@ func (s *SocketServer) handleRequest(ctx context.Context, req *types.Request) (*
 			return nil, err
 		}
 		return types.ToResponseQuery(res), nil
+	case *types.Request_SimulateTx:
+		simulator, ok := s.app.(types.Simulator)
+		if !ok {
+			return nil, types.ErrSimulateTxNotSupported
+		}
+		res, err := simulator.SimulateTx(ctx, r.SimulateTx)
+		if err != nil {
+			return nil, err
+		}
+		return types.ToResponseSimulateTx(res), nil
 	case *types.Request_InitChain:
 		res, err := s.app.InitChain(ctx, r.InitChain)
 		if err != nil {
@@ -309,12 +321,19 @@ func (s *SocketServer) handleRequest(ctx context.Context, req *types.Request) (*
 	}
 }
 
+// socketResponse is a response to write to a connection.
+type socketResponse struct {
+	*types.Response
+	// whether the connection is kept open after an exception
+	keepOpen bool
+}
+
 // Pull responses from 'responses' and write them to conn.
-func (s *SocketServer) handleResponses(closeConn chan error, conn io.Writer, responses <-chan *types.Response) {
+func (s *SocketServer) handleResponses(closeConn chan error, conn io.Writer, responses <-chan socketResponse) {
 	bufWriter := bufio.NewWriter(conn)
 	for {
 		res := <-responses
-		err := types.WriteMessage(res, bufWriter)
+		err := types.WriteMessage(res.Response, bufWriter)
 		if err != nil {
 			closeConn <- fmt.Errorf("error writing message: %w", err)
 			return
@@ -330,7 +349,7 @@ func (s *SocketServer) handleResponses(closeConn chan error, conn io.Writer, res
 		// If the application has responded with an exception, the server returns the error
 		// back to the client and closes the connection. The receiving Tendermint client should
 		// log the error and gracefully terminate
-		if e, ok := res.Value.(*types.Response_Exception); ok {
+		if e, ok := res.Value.(*types.Response_Exception); ok && !res.keepOpen {
 			closeConn <- errors.New(e.Exception.Error)
 		}
 	}
@@ -3,8 +3,6 @@ package server
 import (
 	"testing"
 	"time"
-
-	"github.com/cometbft/cometbft/abci/types"
 )
 
 type panicReader struct{}
@@ -18,7 +16,7 @@ func (panicReader) Read(_ []byte) (int, error) {
 func TestHandleRequestsPanicBeforeLock(t *testing.T) {
 	s := &SocketServer{}
 	closeConn := make(chan error, 1)
-	responses := make(chan *types.Response, 1)
+	responses := make(chan socketResponse, 1)
 	done := make(chan struct{})
 
 	go func() {
@@ -1,6 +1,9 @@
 package types
 
-import "context"
+import (
+	"context"
+	"errors"
+)
 
 //go:generate ../../scripts/mockery_generate.sh Application
 
@@ -36,6 +39,19 @@ type Application interface {
 	ApplySnapshotChunk(context.Context, *RequestApplySnapshotChunk) (*ResponseApplySnapshotChunk, error) // Apply a snapshot chunk
 }
 
+// Simulator is implemented by the applications which can simulate the
+// execution of txs, for the simulate_tx RPC. It is optional, and checked by
+// type assertion: the SimulateTx calls to the applications which do not
+// implement it fail with ErrSimulateTxNotSupported.
+type Simulator interface {
+	// Execute a tx against the latest committed state without persisting it
+	SimulateTx(context.Context, *RequestSimulateTx) (*ResponseSimulateTx, error)
+}
+
+// ErrSimulateTxNotSupported is returned by the SimulateTx calls to the
+// applications which do not implement Simulator.
+var ErrSimulateTxNotSupported = errors.New("the application does not support SimulateTx")
+
 //-------------------------------------------------------
 // BaseApplication is a base form of Application
 
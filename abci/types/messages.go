@@ -76,6 +76,12 @@ func ToRequestQuery(req *RequestQuery) *Request {
 	}
 }
 
+func ToRequestSimulateTx(req *RequestSimulateTx) *Request {
+	return &Request{
+		Value: &Request_SimulateTx{req},
+	}
+}
+
 func ToRequestInitChain(req *RequestInitChain) *Request {
 	return &Request{
 		Value: &Request_InitChain{req},
@@ -180,6 +186,12 @@ func ToResponseQuery(res *ResponseQuery) *Response {
 	}
 }
 
+func ToResponseSimulateTx(res *ResponseSimulateTx) *Response {
+	return &Response{
+		Value: &Response_SimulateTx{res},
+	}
+}
+
 func ToResponseInitChain(res *ResponseInitChain) *Response {
 	return &Response{
 		Value: &Response_InitChain{res},
@@ -121,7 +121,7 @@ func (x ResponseOfferSnapshot_Result) String() string {
 }
 
 func (ResponseOfferSnapshot_Result) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{31, 0}
+	return fileDescriptor_252557cfdd89a31a, []int{33, 0}
 }
 
 type ResponseApplySnapshotChunk_Result int32
@@ -158,7 +158,7 @@ func (x ResponseApplySnapshotChunk_Result) String() string {
 }
 
 func (ResponseApplySnapshotChunk_Result) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{33, 0}
+	return fileDescriptor_252557cfdd89a31a, []int{35, 0}
 }
 
 type ResponseProcessProposal_ProposalStatus int32
@@ -186,7 +186,7 @@ func (x ResponseProcessProposal_ProposalStatus) String() string {
 }
 
 func (ResponseProcessProposal_ProposalStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{35, 0}
+	return fileDescriptor_252557cfdd89a31a, []int{37, 0}
 }
 
 type ResponseVerifyVoteExtension_VerifyStatus int32
@@ -218,7 +218,7 @@ func (x ResponseVerifyVoteExtension_VerifyStatus) String() string {
 }
 
 func (ResponseVerifyVoteExtension_VerifyStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{37, 0}
+	return fileDescriptor_252557cfdd89a31a, []int{39, 0}
 }
 
 type Request struct {
@@ -241,6 +241,7 @@ type Request struct {
 	//	*Request_FinalizeBlock
 	//	*Request_InsertTx
 	//	*Request_ReapTxs
+	//	*Request_SimulateTx
 	Value isRequest_Value `protobuf_oneof:"value"`
 }
 
@@ -337,6 +338,9 @@ type Request_InsertTx struct {
 type Request_ReapTxs struct {
 	ReapTxs *RequestReapTxs `protobuf:"bytes,22,opt,name=reap_txs,json=reapTxs,proto3,oneof" json:"reap_txs,omitempty"`
 }
+type Request_SimulateTx struct {
+	SimulateTx *RequestSimulateTx `protobuf:"bytes,23,opt,name=simulate_tx,json=simulateTx,proto3,oneof" json:"simulate_tx,omitempty"`
+}
 
 func (*Request_Echo) isRequest_Value()                {}
 func (*Request_Flush) isRequest_Value()               {}
@@ -356,6 +360,7 @@ func (*Request_VerifyVoteExtension) isRequest_Value() {}
 func (*Request_FinalizeBlock) isRequest_Value()       {}
 func (*Request_InsertTx) isRequest_Value()            {}
 func (*Request_ReapTxs) isRequest_Value()             {}
+func (*Request_SimulateTx) isRequest_Value()          {}
 
 func (m *Request) GetValue() isRequest_Value {
 	if m != nil {
@@ -490,6 +495,13 @@ func (m *Request) GetReapTxs() *RequestReapTxs {
 	return nil
 }
 
+func (m *Request) GetSimulateTx() *RequestSimulateTx {
+	if x, ok := m.GetValue().(*Request_SimulateTx); ok {
+		return x.SimulateTx
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Request) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -511,6 +523,7 @@ func (*Request) XXX_OneofWrappers() []interface{} {
 		(*Request_FinalizeBlock)(nil),
 		(*Request_InsertTx)(nil),
 		(*Request_ReapTxs)(nil),
+		(*Request_SimulateTx)(nil),
 	}
 }
 
@@ -962,6 +975,50 @@ func (m *RequestReapTxs) GetMaxGas() uint64 {
 	return 0
 }
 
+type RequestSimulateTx struct {
+	Tx []byte `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (m *RequestSimulateTx) Reset()         { *m = RequestSimulateTx{} }
+func (m *RequestSimulateTx) String() string { return proto.CompactTextString(m) }
+func (*RequestSimulateTx) ProtoMessage()    {}
+func (*RequestSimulateTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_252557cfdd89a31a, []int{9}
+}
+func (m *RequestSimulateTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestSimulateTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestSimulateTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestSimulateTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestSimulateTx.Merge(m, src)
+}
+func (m *RequestSimulateTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestSimulateTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestSimulateTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestSimulateTx proto.InternalMessageInfo
+
+func (m *RequestSimulateTx) GetTx() []byte {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
 type RequestCommit struct {
 }
 
@@ -969,7 +1026,7 @@ func (m *RequestCommit) Reset()         { *m = RequestCommit{} }
 func (m *RequestCommit) String() string { return proto.CompactTextString(m) }
 func (*RequestCommit) ProtoMessage()    {}
 func (*RequestCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{9}
+	return fileDescriptor_252557cfdd89a31a, []int{10}
 }
 func (m *RequestCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1006,7 +1063,7 @@ func (m *RequestListSnapshots) Reset()         { *m = RequestListSnapshots{} }
 func (m *RequestListSnapshots) String() string { return proto.CompactTextString(m) }
 func (*RequestListSnapshots) ProtoMessage()    {}
 func (*RequestListSnapshots) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{10}
+	return fileDescriptor_252557cfdd89a31a, []int{11}
 }
 func (m *RequestListSnapshots) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1045,7 +1102,7 @@ func (m *RequestOfferSnapshot) Reset()         { *m = RequestOfferSnapshot{} }
 func (m *RequestOfferSnapshot) String() string { return proto.CompactTextString(m) }
 func (*RequestOfferSnapshot) ProtoMessage()    {}
 func (*RequestOfferSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{11}
+	return fileDescriptor_252557cfdd89a31a, []int{12}
 }
 func (m *RequestOfferSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1099,7 +1156,7 @@ func (m *RequestLoadSnapshotChunk) Reset()         { *m = RequestLoadSnapshotChu
 func (m *RequestLoadSnapshotChunk) String() string { return proto.CompactTextString(m) }
 func (*RequestLoadSnapshotChunk) ProtoMessage()    {}
 func (*RequestLoadSnapshotChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{12}
+	return fileDescriptor_252557cfdd89a31a, []int{13}
 }
 func (m *RequestLoadSnapshotChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1160,7 +1217,7 @@ func (m *RequestApplySnapshotChunk) Reset()         { *m = RequestApplySnapshotC
 func (m *RequestApplySnapshotChunk) String() string { return proto.CompactTextString(m) }
 func (*RequestApplySnapshotChunk) ProtoMessage()    {}
 func (*RequestApplySnapshotChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{13}
+	return fileDescriptor_252557cfdd89a31a, []int{14}
 }
 func (m *RequestApplySnapshotChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1229,7 +1286,7 @@ func (m *RequestPrepareProposal) Reset()         { *m = RequestPrepareProposal{}
 func (m *RequestPrepareProposal) String() string { return proto.CompactTextString(m) }
 func (*RequestPrepareProposal) ProtoMessage()    {}
 func (*RequestPrepareProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{14}
+	return fileDescriptor_252557cfdd89a31a, []int{15}
 }
 func (m *RequestPrepareProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1331,7 +1388,7 @@ func (m *RequestProcessProposal) Reset()         { *m = RequestProcessProposal{}
 func (m *RequestProcessProposal) String() string { return proto.CompactTextString(m) }
 func (*RequestProcessProposal) ProtoMessage()    {}
 func (*RequestProcessProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{15}
+	return fileDescriptor_252557cfdd89a31a, []int{16}
 }
 func (m *RequestProcessProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1436,7 +1493,7 @@ func (m *RequestExtendVote) Reset()         { *m = RequestExtendVote{} }
 func (m *RequestExtendVote) String() string { return proto.CompactTextString(m) }
 func (*RequestExtendVote) ProtoMessage()    {}
 func (*RequestExtendVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{16}
+	return fileDescriptor_252557cfdd89a31a, []int{17}
 }
 func (m *RequestExtendVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1535,7 +1592,7 @@ func (m *RequestVerifyVoteExtension) Reset()         { *m = RequestVerifyVoteExt
 func (m *RequestVerifyVoteExtension) String() string { return proto.CompactTextString(m) }
 func (*RequestVerifyVoteExtension) ProtoMessage()    {}
 func (*RequestVerifyVoteExtension) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{17}
+	return fileDescriptor_252557cfdd89a31a, []int{18}
 }
 func (m *RequestVerifyVoteExtension) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1609,7 +1666,7 @@ func (m *RequestFinalizeBlock) Reset()         { *m = RequestFinalizeBlock{} }
 func (m *RequestFinalizeBlock) String() string { return proto.CompactTextString(m) }
 func (*RequestFinalizeBlock) ProtoMessage()    {}
 func (*RequestFinalizeBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{18}
+	return fileDescriptor_252557cfdd89a31a, []int{19}
 }
 func (m *RequestFinalizeBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1716,6 +1773,7 @@ type Response struct {
 	//	*Response_FinalizeBlock
 	//	*Response_InsertTx
 	//	*Response_ReapTxs
+	//	*Response_SimulateTx
 	Value isResponse_Value `protobuf_oneof:"value"`
 }
 
@@ -1723,7 +1781,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{19}
+	return fileDescriptor_252557cfdd89a31a, []int{20}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1815,6 +1873,9 @@ type Response_InsertTx struct {
 type Response_ReapTxs struct {
 	ReapTxs *ResponseReapTxs `protobuf:"bytes,23,opt,name=reap_txs,json=reapTxs,proto3,oneof" json:"reap_txs,omitempty"`
 }
+type Response_SimulateTx struct {
+	SimulateTx *ResponseSimulateTx `protobuf:"bytes,24,opt,name=simulate_tx,json=simulateTx,proto3,oneof" json:"simulate_tx,omitempty"`
+}
 
 func (*Response_Exception) isResponse_Value()           {}
 func (*Response_Echo) isResponse_Value()                {}
@@ -1835,6 +1896,7 @@ func (*Response_VerifyVoteExtension) isResponse_Value() {}
 func (*Response_FinalizeBlock) isResponse_Value()       {}
 func (*Response_InsertTx) isResponse_Value()            {}
 func (*Response_ReapTxs) isResponse_Value()             {}
+func (*Response_SimulateTx) isResponse_Value()          {}
 
 func (m *Response) GetValue() isResponse_Value {
 	if m != nil {
@@ -1976,6 +2038,13 @@ func (m *Response) GetReapTxs() *ResponseReapTxs {
 	return nil
 }
 
+func (m *Response) GetSimulateTx() *ResponseSimulateTx {
+	if x, ok := m.GetValue().(*Response_SimulateTx); ok {
+		return x.SimulateTx
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Response) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1998,6 +2067,7 @@ func (*Response) XXX_OneofWrappers() []interface{} {
 		(*Response_FinalizeBlock)(nil),
 		(*Response_InsertTx)(nil),
 		(*Response_ReapTxs)(nil),
+		(*Response_SimulateTx)(nil),
 	}
 }
 
@@ -2010,7 +2080,7 @@ func (m *ResponseException) Reset()         { *m = ResponseException{} }
 func (m *ResponseException) String() string { return proto.CompactTextString(m) }
 func (*ResponseException) ProtoMessage()    {}
 func (*ResponseException) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{20}
+	return fileDescriptor_252557cfdd89a31a, []int{21}
 }
 func (m *ResponseException) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2054,7 +2124,7 @@ func (m *ResponseEcho) Reset()         { *m = ResponseEcho{} }
 func (m *ResponseEcho) String() string { return proto.CompactTextString(m) }
 func (*ResponseEcho) ProtoMessage()    {}
 func (*ResponseEcho) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{21}
+	return fileDescriptor_252557cfdd89a31a, []int{22}
 }
 func (m *ResponseEcho) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2097,7 +2167,7 @@ func (m *ResponseFlush) Reset()         { *m = ResponseFlush{} }
 func (m *ResponseFlush) String() string { return proto.CompactTextString(m) }
 func (*ResponseFlush) ProtoMessage()    {}
 func (*ResponseFlush) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{22}
+	return fileDescriptor_252557cfdd89a31a, []int{23}
 }
 func (m *ResponseFlush) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2138,7 +2208,7 @@ func (m *ResponseInfo) Reset()         { *m = ResponseInfo{} }
 func (m *ResponseInfo) String() string { return proto.CompactTextString(m) }
 func (*ResponseInfo) ProtoMessage()    {}
 func (*ResponseInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{23}
+	return fileDescriptor_252557cfdd89a31a, []int{24}
 }
 func (m *ResponseInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2212,7 +2282,7 @@ func (m *ResponseInitChain) Reset()         { *m = ResponseInitChain{} }
 func (m *ResponseInitChain) String() string { return proto.CompactTextString(m) }
 func (*ResponseInitChain) ProtoMessage()    {}
 func (*ResponseInitChain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{24}
+	return fileDescriptor_252557cfdd89a31a, []int{25}
 }
 func (m *ResponseInitChain) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2349,7 @@ func (m *ResponseQuery) Reset()         { *m = ResponseQuery{} }
 func (m *ResponseQuery) String() string { return proto.CompactTextString(m) }
 func (*ResponseQuery) ProtoMessage()    {}
 func (*ResponseQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{25}
+	return fileDescriptor_252557cfdd89a31a, []int{26}
 }
 func (m *ResponseQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2386,7 +2456,7 @@ func (m *ResponseCheckTx) Reset()         { *m = ResponseCheckTx{} }
 func (m *ResponseCheckTx) String() string { return proto.CompactTextString(m) }
 func (*ResponseCheckTx) ProtoMessage()    {}
 func (*ResponseCheckTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{26}
+	return fileDescriptor_252557cfdd89a31a, []int{27}
 }
 func (m *ResponseCheckTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2479,7 +2549,7 @@ func (m *ResponseInsertTx) Reset()         { *m = ResponseInsertTx{} }
 func (m *ResponseInsertTx) String() string { return proto.CompactTextString(m) }
 func (*ResponseInsertTx) ProtoMessage()    {}
 func (*ResponseInsertTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{27}
+	return fileDescriptor_252557cfdd89a31a, []int{28}
 }
 func (m *ResponseInsertTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2523,7 +2593,7 @@ func (m *ResponseReapTxs) Reset()         { *m = ResponseReapTxs{} }
 func (m *ResponseReapTxs) String() string { return proto.CompactTextString(m) }
 func (*ResponseReapTxs) ProtoMessage()    {}
 func (*ResponseReapTxs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{28}
+	return fileDescriptor_252557cfdd89a31a, []int{29}
 }
 func (m *ResponseReapTxs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,6 +2629,50 @@ func (m *ResponseReapTxs) GetTxs() [][]byte {
 	return nil
 }
 
+type ResponseSimulateTx struct {
+	TxResult *ExecTxResult `protobuf:"bytes,1,opt,name=tx_result,json=txResult,proto3" json:"tx_result,omitempty"`
+}
+
+func (m *ResponseSimulateTx) Reset()         { *m = ResponseSimulateTx{} }
+func (m *ResponseSimulateTx) String() string { return proto.CompactTextString(m) }
+func (*ResponseSimulateTx) ProtoMessage()    {}
+func (*ResponseSimulateTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_252557cfdd89a31a, []int{30}
+}
+func (m *ResponseSimulateTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResponseSimulateTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResponseSimulateTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResponseSimulateTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResponseSimulateTx.Merge(m, src)
+}
+func (m *ResponseSimulateTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResponseSimulateTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResponseSimulateTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResponseSimulateTx proto.InternalMessageInfo
+
+func (m *ResponseSimulateTx) GetTxResult() *ExecTxResult {
+	if m != nil {
+		return m.TxResult
+	}
+	return nil
+}
+
 type ResponseCommit struct {
 	RetainHeight int64 `protobuf:"varint,3,opt,name=retain_height,json=retainHeight,proto3" json:"retain_height,omitempty"`
 }
@@ -2567,7 +2681,7 @@ func (m *ResponseCommit) Reset()         { *m = ResponseCommit{} }
 func (m *ResponseCommit) String() string { return proto.CompactTextString(m) }
 func (*ResponseCommit) ProtoMessage()    {}
 func (*ResponseCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{29}
+	return fileDescriptor_252557cfdd89a31a, []int{31}
 }
 func (m *ResponseCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2611,7 +2725,7 @@ func (m *ResponseListSnapshots) Reset()         { *m = ResponseListSnapshots{} }
 func (m *ResponseListSnapshots) String() string { return proto.CompactTextString(m) }
 func (*ResponseListSnapshots) ProtoMessage()    {}
 func (*ResponseListSnapshots) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{30}
+	return fileDescriptor_252557cfdd89a31a, []int{32}
 }
 func (m *ResponseListSnapshots) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2655,7 +2769,7 @@ func (m *ResponseOfferSnapshot) Reset()         { *m = ResponseOfferSnapshot{} }
 func (m *ResponseOfferSnapshot) String() string { return proto.CompactTextString(m) }
 func (*ResponseOfferSnapshot) ProtoMessage()    {}
 func (*ResponseOfferSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{31}
+	return fileDescriptor_252557cfdd89a31a, []int{33}
 }
 func (m *ResponseOfferSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2699,7 +2813,7 @@ func (m *ResponseLoadSnapshotChunk) Reset()         { *m = ResponseLoadSnapshotC
 func (m *ResponseLoadSnapshotChunk) String() string { return proto.CompactTextString(m) }
 func (*ResponseLoadSnapshotChunk) ProtoMessage()    {}
 func (*ResponseLoadSnapshotChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{32}
+	return fileDescriptor_252557cfdd89a31a, []int{34}
 }
 func (m *ResponseLoadSnapshotChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2745,7 +2859,7 @@ func (m *ResponseApplySnapshotChunk) Reset()         { *m = ResponseApplySnapsho
 func (m *ResponseApplySnapshotChunk) String() string { return proto.CompactTextString(m) }
 func (*ResponseApplySnapshotChunk) ProtoMessage()    {}
 func (*ResponseApplySnapshotChunk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{33}
+	return fileDescriptor_252557cfdd89a31a, []int{35}
 }
 func (m *ResponseApplySnapshotChunk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2803,7 +2917,7 @@ func (m *ResponsePrepareProposal) Reset()         { *m = ResponsePrepareProposal
 func (m *ResponsePrepareProposal) String() string { return proto.CompactTextString(m) }
 func (*ResponsePrepareProposal) ProtoMessage()    {}
 func (*ResponsePrepareProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{34}
+	return fileDescriptor_252557cfdd89a31a, []int{36}
 }
 func (m *ResponsePrepareProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2847,7 +2961,7 @@ func (m *ResponseProcessProposal) Reset()         { *m = ResponseProcessProposal
 func (m *ResponseProcessProposal) String() string { return proto.CompactTextString(m) }
 func (*ResponseProcessProposal) ProtoMessage()    {}
 func (*ResponseProcessProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{35}
+	return fileDescriptor_252557cfdd89a31a, []int{37}
 }
 func (m *ResponseProcessProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2891,7 +3005,7 @@ func (m *ResponseExtendVote) Reset()         { *m = ResponseExtendVote{} }
 func (m *ResponseExtendVote) String() string { return proto.CompactTextString(m) }
 func (*ResponseExtendVote) ProtoMessage()    {}
 func (*ResponseExtendVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{36}
+	return fileDescriptor_252557cfdd89a31a, []int{38}
 }
 func (m *ResponseExtendVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2935,7 +3049,7 @@ func (m *ResponseVerifyVoteExtension) Reset()         { *m = ResponseVerifyVoteE
 func (m *ResponseVerifyVoteExtension) String() string { return proto.CompactTextString(m) }
 func (*ResponseVerifyVoteExtension) ProtoMessage()    {}
 func (*ResponseVerifyVoteExtension) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{37}
+	return fileDescriptor_252557cfdd89a31a, []int{39}
 }
 func (m *ResponseVerifyVoteExtension) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2990,7 +3104,7 @@ func (m *ResponseFinalizeBlock) Reset()         { *m = ResponseFinalizeBlock{} }
 func (m *ResponseFinalizeBlock) String() string { return proto.CompactTextString(m) }
 func (*ResponseFinalizeBlock) ProtoMessage()    {}
 func (*ResponseFinalizeBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{38}
+	return fileDescriptor_252557cfdd89a31a, []int{40}
 }
 func (m *ResponseFinalizeBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3063,7 +3177,7 @@ func (m *CommitInfo) Reset()         { *m = CommitInfo{} }
 func (m *CommitInfo) String() string { return proto.CompactTextString(m) }
 func (*CommitInfo) ProtoMessage()    {}
 func (*CommitInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{39}
+	return fileDescriptor_252557cfdd89a31a, []int{41}
 }
 func (m *CommitInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3121,7 +3235,7 @@ func (m *ExtendedCommitInfo) Reset()         { *m = ExtendedCommitInfo{} }
 func (m *ExtendedCommitInfo) String() string { return proto.CompactTextString(m) }
 func (*ExtendedCommitInfo) ProtoMessage()    {}
 func (*ExtendedCommitInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{40}
+	return fileDescriptor_252557cfdd89a31a, []int{42}
 }
 func (m *ExtendedCommitInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3176,7 +3290,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{41}
+	return fileDescriptor_252557cfdd89a31a, []int{43}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3230,7 +3344,7 @@ func (m *EventAttribute) Reset()         { *m = EventAttribute{} }
 func (m *EventAttribute) String() string { return proto.CompactTextString(m) }
 func (*EventAttribute) ProtoMessage()    {}
 func (*EventAttribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{42}
+	return fileDescriptor_252557cfdd89a31a, []int{44}
 }
 func (m *EventAttribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3298,7 +3412,7 @@ func (m *ExecTxResult) Reset()         { *m = ExecTxResult{} }
 func (m *ExecTxResult) String() string { return proto.CompactTextString(m) }
 func (*ExecTxResult) ProtoMessage()    {}
 func (*ExecTxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{43}
+	return fileDescriptor_252557cfdd89a31a, []int{45}
 }
 func (m *ExecTxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3397,7 +3511,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{44}
+	return fileDescriptor_252557cfdd89a31a, []int{46}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3464,7 +3578,7 @@ func (m *Validator) Reset()         { *m = Validator{} }
 func (m *Validator) String() string { return proto.CompactTextString(m) }
 func (*Validator) ProtoMessage()    {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{45}
+	return fileDescriptor_252557cfdd89a31a, []int{47}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +3630,7 @@ func (m *ValidatorUpdate) Reset()         { *m = ValidatorUpdate{} }
 func (m *ValidatorUpdate) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdate) ProtoMessage()    {}
 func (*ValidatorUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{46}
+	return fileDescriptor_252557cfdd89a31a, []int{48}
 }
 func (m *ValidatorUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3568,7 +3682,7 @@ func (m *VoteInfo) Reset()         { *m = VoteInfo{} }
 func (m *VoteInfo) String() string { return proto.CompactTextString(m) }
 func (*VoteInfo) ProtoMessage()    {}
 func (*VoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{47}
+	return fileDescriptor_252557cfdd89a31a, []int{49}
 }
 func (m *VoteInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3626,7 +3740,7 @@ func (m *ExtendedVoteInfo) Reset()         { *m = ExtendedVoteInfo{} }
 func (m *ExtendedVoteInfo) String() string { return proto.CompactTextString(m) }
 func (*ExtendedVoteInfo) ProtoMessage()    {}
 func (*ExtendedVoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{48}
+	return fileDescriptor_252557cfdd89a31a, []int{50}
 }
 func (m *ExtendedVoteInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3701,7 +3815,7 @@ func (m *Misbehavior) Reset()         { *m = Misbehavior{} }
 func (m *Misbehavior) String() string { return proto.CompactTextString(m) }
 func (*Misbehavior) ProtoMessage()    {}
 func (*Misbehavior) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{49}
+	return fileDescriptor_252557cfdd89a31a, []int{51}
 }
 func (m *Misbehavior) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3777,7 +3891,7 @@ func (m *Snapshot) Reset()         { *m = Snapshot{} }
 func (m *Snapshot) String() string { return proto.CompactTextString(m) }
 func (*Snapshot) ProtoMessage()    {}
 func (*Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_252557cfdd89a31a, []int{50}
+	return fileDescriptor_252557cfdd89a31a, []int{52}
 }
 func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3857,6 +3971,7 @@ func init() {
 	proto.RegisterType((*RequestCheckTx)(nil), "tendermint.abci.RequestCheckTx")
 	proto.RegisterType((*RequestInsertTx)(nil), "tendermint.abci.RequestInsertTx")
 	proto.RegisterType((*RequestReapTxs)(nil), "tendermint.abci.RequestReapTxs")
+	proto.RegisterType((*RequestSimulateTx)(nil), "tendermint.abci.RequestSimulateTx")
 	proto.RegisterType((*RequestCommit)(nil), "tendermint.abci.RequestCommit")
 	proto.RegisterType((*RequestListSnapshots)(nil), "tendermint.abci.RequestListSnapshots")
 	proto.RegisterType((*RequestOfferSnapshot)(nil), "tendermint.abci.RequestOfferSnapshot")
@@ -3877,6 +3992,7 @@ func init() {
 	proto.RegisterType((*ResponseCheckTx)(nil), "tendermint.abci.ResponseCheckTx")
 	proto.RegisterType((*ResponseInsertTx)(nil), "tendermint.abci.ResponseInsertTx")
 	proto.RegisterType((*ResponseReapTxs)(nil), "tendermint.abci.ResponseReapTxs")
+	proto.RegisterType((*ResponseSimulateTx)(nil), "tendermint.abci.ResponseSimulateTx")
 	proto.RegisterType((*ResponseCommit)(nil), "tendermint.abci.ResponseCommit")
 	proto.RegisterType((*ResponseListSnapshots)(nil), "tendermint.abci.ResponseListSnapshots")
 	proto.RegisterType((*ResponseOfferSnapshot)(nil), "tendermint.abci.ResponseOfferSnapshot")
@@ -3904,215 +4020,219 @@ func init() {
 func init() { proto.RegisterFile("tendermint/abci/types.proto", fileDescriptor_252557cfdd89a31a) }
 
 var fileDescriptor_252557cfdd89a31a = []byte{
-	// 3321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xc9, 0x73, 0x1b, 0xc7,
-	0xd5, 0xc7, 0x60, 0xc7, 0xc3, 0xc2, 0x61, 0x93, 0xa2, 0x20, 0x48, 0x26, 0xa9, 0x51, 0xd9, 0x96,
-	0x65, 0x9b, 0xf4, 0x27, 0x7d, 0xf2, 0x52, 0xb2, 0xbf, 0x2f, 0x20, 0x04, 0x1a, 0xa4, 0x64, 0x92,
-	0x1e, 0x42, 0x72, 0x39, 0x8b, 0xc7, 0x43, 0xa0, 0x41, 0x8c, 0x05, 0x60, 0xc6, 0x33, 0x03, 0x1a,
-	0xf4, 0x29, 0x15, 0x27, 0x55, 0x29, 0x9f, 0x5c, 0x95, 0x4a, 0x95, 0x0f, 0xf1, 0x21, 0x87, 0x5c,
-	0xf2, 0x17, 0xe4, 0x90, 0xf2, 0x29, 0x07, 0x1f, 0x72, 0xf0, 0x31, 0x27, 0x27, 0x65, 0xdf, 0x7c,
-	0xcd, 0x21, 0xd7, 0x54, 0x2f, 0xb3, 0x01, 0x33, 0x58, 0x64, 0xe7, 0x90, 0x4a, 0x6e, 0xbd, 0xbc,
-	0xf7, 0xba, 0xfb, 0x75, 0xf7, 0x5b, 0x7e, 0xdd, 0x70, 0xd9, 0xc6, 0x83, 0x36, 0x36, 0xfb, 0xda,
-	0xc0, 0xde, 0x56, 0x4f, 0x5a, 0xda, 0xb6, 0x7d, 0x6e, 0x60, 0x6b, 0xcb, 0x30, 0x75, 0x5b, 0x47,
-	0x4b, 0x5e, 0xe7, 0x16, 0xe9, 0xac, 0xac, 0x9e, 0xea, 0xa7, 0x3a, 0xed, 0xdb, 0x26, 0x25, 0x46,
-	0x56, 0xd9, 0x38, 0xd5, 0xf5, 0xd3, 0x1e, 0xde, 0xa6, 0xb5, 0x93, 0x61, 0x67, 0xdb, 0xd6, 0xfa,
-	0xd8, 0xb2, 0xd5, 0xbe, 0xc1, 0x09, 0xae, 0xf8, 0x06, 0x69, 0x99, 0xe7, 0x86, 0xad, 0x6f, 0x3f,
-	0xc2, 0xe7, 0x7c, 0x94, 0xca, 0x13, 0x93, 0xbd, 0x86, 0xa9, 0xeb, 0x9d, 0x90, 0x6e, 0x3a, 0xb9,
-	0x6d, 0x43, 0x35, 0xd5, 0xbe, 0xc3, 0xbd, 0x39, 0xd1, 0x7d, 0xa6, 0xf6, 0xb4, 0xb6, 0x6a, 0xeb,
-	0x26, 0xa3, 0x90, 0x3e, 0x07, 0xc8, 0xc8, 0xf8, 0xfd, 0x21, 0xb6, 0x6c, 0x74, 0x13, 0x92, 0xb8,
-	0xd5, 0xd5, 0xcb, 0xc2, 0xa6, 0x70, 0x3d, 0x7f, 0xf3, 0xca, 0xd6, 0xd8, 0x02, 0xb7, 0x38, 0x5d,
-	0xbd, 0xd5, 0xd5, 0x1b, 0x31, 0x99, 0xd2, 0xa2, 0xdb, 0x90, 0xea, 0xf4, 0x86, 0x56, 0xb7, 0x1c,
-	0xa7, 0x4c, 0x4f, 0x44, 0x31, 0xed, 0x12, 0xa2, 0x46, 0x4c, 0x66, 0xd4, 0x64, 0x28, 0x6d, 0xd0,
-	0xd1, 0xcb, 0x89, 0xe9, 0x43, 0xed, 0x0d, 0x3a, 0x74, 0x28, 0x42, 0x8b, 0x76, 0x00, 0xb4, 0x81,
-	0x66, 0x2b, 0xad, 0xae, 0xaa, 0x0d, 0xca, 0x29, 0xca, 0x79, 0x35, 0x9a, 0x53, 0xb3, 0x6b, 0x84,
-	0xb0, 0x11, 0x93, 0x73, 0x9a, 0x53, 0x21, 0xd3, 0x7d, 0x7f, 0x88, 0xcd, 0xf3, 0x72, 0x7a, 0xfa,
-	0x74, 0xdf, 0x24, 0x44, 0x64, 0xba, 0x94, 0x1a, 0xbd, 0x0a, 0xd9, 0x56, 0x17, 0xb7, 0x1e, 0x29,
-	0xf6, 0xa8, 0x9c, 0xa5, 0x9c, 0x1b, 0x51, 0x9c, 0x35, 0x42, 0xd7, 0x1c, 0x35, 0x62, 0x72, 0xa6,
-	0xc5, 0x8a, 0xe8, 0x65, 0x48, 0xb7, 0xf4, 0x7e, 0x5f, 0xb3, 0xcb, 0x79, 0xca, 0xbb, 0x1e, 0xc9,
-	0x4b, 0xa9, 0x1a, 0x31, 0x99, 0xd3, 0xa3, 0x03, 0x28, 0xf5, 0x34, 0xcb, 0x56, 0xac, 0x81, 0x6a,
-	0x58, 0x5d, 0xdd, 0xb6, 0xca, 0x05, 0x2a, 0xe1, 0xc9, 0x28, 0x09, 0xf7, 0x35, 0xcb, 0x3e, 0x76,
-	0x88, 0x1b, 0x31, 0xb9, 0xd8, 0xf3, 0x37, 0x10, 0x79, 0x7a, 0xa7, 0x83, 0x4d, 0x57, 0x60, 0xb9,
-	0x38, 0x5d, 0xde, 0x21, 0xa1, 0x76, 0xf8, 0x89, 0x3c, 0xdd, 0xdf, 0x80, 0x7e, 0x04, 0x2b, 0x3d,
-	0x5d, 0x6d, 0xbb, 0xe2, 0x94, 0x56, 0x77, 0x38, 0x78, 0x54, 0x2e, 0x51, 0xa1, 0xcf, 0x44, 0x4e,
-	0x52, 0x57, 0xdb, 0x8e, 0x88, 0x1a, 0x61, 0x68, 0xc4, 0xe4, 0xe5, 0xde, 0x78, 0x23, 0x7a, 0x07,
-	0x56, 0x55, 0xc3, 0xe8, 0x9d, 0x8f, 0x4b, 0x5f, 0xa2, 0xd2, 0x6f, 0x44, 0x49, 0xaf, 0x12, 0x9e,
-	0x71, 0xf1, 0x48, 0x9d, 0x68, 0x45, 0x4d, 0x10, 0x0d, 0x13, 0x1b, 0xaa, 0x89, 0x15, 0xc3, 0xd4,
-	0x0d, 0xdd, 0x52, 0x7b, 0x65, 0x91, 0xca, 0x7e, 0x3a, 0x4a, 0xf6, 0x11, 0xa3, 0x3f, 0xe2, 0xe4,
-	0x8d, 0x98, 0xbc, 0x64, 0x04, 0x9b, 0x98, 0x54, 0xbd, 0x85, 0x2d, 0xcb, 0x93, 0xba, 0x3c, 0x4b,
-	0x2a, 0xa5, 0x0f, 0x4a, 0x0d, 0x34, 0xa1, 0x3a, 0xe4, 0xf1, 0x88, 0xb0, 0x2b, 0x67, 0xba, 0x8d,
-	0xcb, 0x88, 0x0a, 0x94, 0x22, 0x6f, 0x28, 0x25, 0x7d, 0xa8, 0xdb, 0xb8, 0x11, 0x93, 0x01, 0xbb,
-	0x35, 0xa4, 0xc2, 0x85, 0x33, 0x6c, 0x6a, 0x9d, 0x73, 0x2a, 0x46, 0xa1, 0x3d, 0x96, 0xa6, 0x0f,
-	0xca, 0x2b, 0x54, 0xe0, 0xb3, 0x51, 0x02, 0x1f, 0x52, 0x26, 0x22, 0xa2, 0xee, 0xb0, 0x34, 0x62,
-	0xf2, 0xca, 0xd9, 0x64, 0x33, 0x39, 0x62, 0x1d, 0x6d, 0xa0, 0xf6, 0xb4, 0x0f, 0xb1, 0x72, 0xd2,
-	0xd3, 0x5b, 0x8f, 0xca, 0xab, 0xd3, 0x8f, 0xd8, 0x2e, 0xa7, 0xde, 0x21, 0xc4, 0xe4, 0x88, 0x75,
-	0xfc, 0x0d, 0xe8, 0xff, 0x21, 0xa7, 0x0d, 0x2c, 0x6c, 0xda, 0xe4, 0xee, 0x5d, 0xa0, 0xa2, 0x36,
-	0xa3, 0x2f, 0x3d, 0x21, 0xa4, 0x97, 0x2f, 0xab, 0xf1, 0x32, 0xb9, 0xbb, 0x26, 0x56, 0x0d, 0xc5,
-	0x1e, 0x59, 0xe5, 0xb5, 0xe9, 0x77, 0x57, 0xc6, 0xaa, 0xd1, 0x1c, 0x91, 0x7b, 0x93, 0x31, 0x59,
-	0x71, 0x27, 0x03, 0xa9, 0x33, 0xb5, 0x37, 0xc4, 0xfb, 0xc9, 0x6c, 0x52, 0x4c, 0xed, 0x27, 0xb3,
-	0x19, 0x31, 0xbb, 0x9f, 0xcc, 0xe6, 0x44, 0xd8, 0x4f, 0x66, 0x41, 0xcc, 0x4b, 0x4f, 0x43, 0xde,
-	0x67, 0x17, 0x51, 0x19, 0x32, 0x7d, 0x6c, 0x59, 0xea, 0x29, 0xa6, 0x66, 0x34, 0x27, 0x3b, 0x55,
-	0xa9, 0x04, 0x05, 0xbf, 0x2d, 0x94, 0x3e, 0x11, 0x5c, 0x4e, 0x62, 0xe6, 0x08, 0xe7, 0x19, 0x36,
-	0xe9, 0x6e, 0x70, 0x4e, 0x5e, 0x45, 0xd7, 0xa0, 0x48, 0x35, 0xa9, 0x38, 0xfd, 0xc4, 0xd6, 0x26,
-	0xe5, 0x02, 0x6d, 0x7c, 0xc8, 0x89, 0x36, 0x20, 0x6f, 0xdc, 0x34, 0x5c, 0x92, 0x04, 0x25, 0x01,
-	0xe3, 0xa6, 0xe1, 0x10, 0x5c, 0x85, 0x02, 0x59, 0xab, 0x4b, 0x91, 0xa4, 0x83, 0xe4, 0x49, 0x1b,
-	0x27, 0x91, 0xfe, 0x1c, 0x07, 0x71, 0xdc, 0x7e, 0xa2, 0x97, 0x21, 0x49, 0x5c, 0x16, 0xf7, 0x0a,
-	0x95, 0x2d, 0xe6, 0xcf, 0xb6, 0x1c, 0x7f, 0xb6, 0xd5, 0x74, 0xfc, 0xd9, 0x4e, 0xf6, 0x8b, 0xaf,
-	0x36, 0x62, 0x9f, 0xfc, 0x75, 0x43, 0x90, 0x29, 0x07, 0xba, 0x44, 0xac, 0xa6, 0xaa, 0x0d, 0x14,
-	0xad, 0x4d, 0xa7, 0x9c, 0x23, 0x26, 0x51, 0xd5, 0x06, 0x7b, 0x6d, 0x74, 0x1f, 0xc4, 0x96, 0x3e,
-	0xb0, 0xf0, 0xc0, 0x1a, 0x5a, 0x0a, 0x73, 0x59, 0xdc, 0x17, 0x04, 0x2c, 0x3a, 0xf3, 0xb7, 0x35,
-	0x87, 0xf2, 0x88, 0x12, 0xca, 0x4b, 0xad, 0x60, 0x03, 0xda, 0x05, 0x70, 0xfd, 0x9a, 0x55, 0x4e,
-	0x6e, 0x26, 0x42, 0x0f, 0xc9, 0x43, 0x87, 0xe4, 0x81, 0xd1, 0x56, 0x6d, 0xbc, 0x93, 0x24, 0xd3,
-	0x95, 0x7d, 0x9c, 0xe8, 0x29, 0x58, 0x52, 0x0d, 0x43, 0xb1, 0x6c, 0xd5, 0xc6, 0xca, 0xc9, 0xb9,
-	0x8d, 0x2d, 0xea, 0x66, 0x0a, 0x72, 0x51, 0x35, 0x8c, 0x63, 0xd2, 0xba, 0x43, 0x1a, 0xd1, 0x93,
-	0x50, 0x22, 0x2e, 0x45, 0x53, 0x7b, 0x4a, 0x17, 0x6b, 0xa7, 0x5d, 0x9b, 0xba, 0x93, 0x84, 0x5c,
-	0xe4, 0xad, 0x0d, 0xda, 0x28, 0xb5, 0xdd, 0x1d, 0xa7, 0xee, 0x04, 0x21, 0x48, 0xb6, 0x55, 0x5b,
-	0xa5, 0x9a, 0x2c, 0xc8, 0xb4, 0x4c, 0xda, 0x0c, 0xd5, 0xee, 0x72, 0xfd, 0xd0, 0x32, 0x5a, 0x83,
-	0x34, 0x17, 0x9b, 0xa0, 0x62, 0x79, 0x0d, 0xad, 0x42, 0xca, 0x30, 0xf5, 0x33, 0x4c, 0xb7, 0x2e,
-	0x2b, 0xb3, 0x8a, 0x24, 0x43, 0x29, 0xe8, 0x7a, 0x50, 0x09, 0xe2, 0xf6, 0x88, 0x8f, 0x12, 0xb7,
-	0x47, 0xe8, 0x05, 0x48, 0x12, 0x45, 0xd2, 0x31, 0x4a, 0x21, 0xce, 0x96, 0xf3, 0x35, 0xcf, 0x0d,
-	0x2c, 0x53, 0x4a, 0xe9, 0x2a, 0x2c, 0x8d, 0x5d, 0xa9, 0x71, 0xa1, 0xd2, 0xae, 0x3b, 0x2c, 0xbf,
-	0x35, 0xe8, 0x32, 0xe4, 0xfa, 0xea, 0x88, 0xeb, 0x4d, 0xa0, 0xe7, 0x2f, 0xdb, 0x57, 0x47, 0x4c,
-	0x65, 0x17, 0x21, 0x43, 0x3a, 0x4f, 0x55, 0x8b, 0x9f, 0xde, 0x74, 0x5f, 0x1d, 0xbd, 0xae, 0x5a,
-	0xd2, 0x12, 0x14, 0x03, 0xde, 0x4f, 0x5a, 0x83, 0xd5, 0x30, 0x67, 0x26, 0x75, 0xdd, 0xf6, 0x80,
-	0x53, 0x42, 0xb7, 0x21, 0xeb, 0x7a, 0x33, 0x76, 0x46, 0x2f, 0x4d, 0xac, 0xd0, 0x21, 0x96, 0x5d,
-	0x52, 0x72, 0x38, 0xc9, 0x5e, 0x77, 0x55, 0x1e, 0xbb, 0x14, 0xe4, 0x8c, 0x6a, 0x18, 0x0d, 0xd5,
-	0xea, 0x4a, 0xef, 0x42, 0x39, 0xca, 0x53, 0xf9, 0xf6, 0x86, 0xad, 0xd0, 0xd9, 0x9b, 0x35, 0x48,
-	0x77, 0x74, 0xb3, 0xaf, 0xda, 0x54, 0x58, 0x51, 0xe6, 0x35, 0xb2, 0x67, 0xcc, 0x6b, 0x25, 0x68,
-	0x33, 0xab, 0x48, 0x0a, 0x5c, 0x8a, 0xf4, 0x56, 0x84, 0x45, 0x1b, 0xb4, 0x31, 0x53, 0x76, 0x51,
-	0x66, 0x15, 0x4f, 0x10, 0x9b, 0x2c, 0xab, 0x90, 0x61, 0x2d, 0xba, 0x56, 0x2a, 0x3f, 0x27, 0xf3,
-	0x9a, 0xf4, 0x69, 0x02, 0xd6, 0xc2, 0x7d, 0x16, 0xda, 0x84, 0x02, 0xd9, 0x09, 0xdb, 0xbf, 0x53,
-	0x09, 0x19, 0xfa, 0xea, 0xa8, 0xc9, 0xf7, 0x4a, 0x84, 0x04, 0x31, 0x96, 0xf1, 0xcd, 0xc4, 0xf5,
-	0x82, 0x4c, 0x8a, 0xe8, 0x01, 0x2c, 0xf7, 0xf4, 0x96, 0xda, 0x53, 0x7a, 0xaa, 0x65, 0x2b, 0x3c,
-	0x98, 0x61, 0xf7, 0xf5, 0xda, 0x84, 0xb2, 0x99, 0xf7, 0xc1, 0x6d, 0xb6, 0x9f, 0xc4, 0xb6, 0xf1,
-	0xab, 0xb6, 0x44, 0x65, 0xdc, 0x57, 0x9d, 0xad, 0x46, 0x77, 0x21, 0xdf, 0xd7, 0xac, 0x13, 0xdc,
-	0x55, 0xcf, 0x34, 0xdd, 0xe4, 0x17, 0x77, 0xf2, 0x7c, 0xbe, 0xe1, 0xd1, 0x70, 0x49, 0x7e, 0x36,
-	0xdf, 0x96, 0xa4, 0x02, 0xd7, 0xc5, 0x31, 0x5c, 0xe9, 0x85, 0x0d, 0xd7, 0x0b, 0xb0, 0x3a, 0xc0,
-	0x23, 0x5b, 0xf1, 0x4c, 0x03, 0x3b, 0x27, 0x19, 0xaa, 0x7a, 0x44, 0xfa, 0x5c, 0x63, 0x62, 0x91,
-	0x23, 0x83, 0x9e, 0xa1, 0x5e, 0xdf, 0xd0, 0x2d, 0x6c, 0x2a, 0x6a, 0xbb, 0x6d, 0x62, 0xcb, 0xa2,
-	0x81, 0x62, 0x81, 0xba, 0x72, 0xda, 0x5e, 0x65, 0xcd, 0xd2, 0x2f, 0xfd, 0x5b, 0x13, 0xf4, 0xf2,
-	0x5c, 0xf1, 0x82, 0xa7, 0xf8, 0x63, 0x58, 0xe5, 0xfc, 0xed, 0x80, 0xee, 0x59, 0xb4, 0x7d, 0x79,
-	0xf2, 0x2a, 0x8f, 0xeb, 0x1c, 0x39, 0xec, 0xd1, 0x6a, 0x4f, 0x3c, 0x9e, 0xda, 0x11, 0x24, 0xa9,
-	0x52, 0x92, 0xcc, 0x9a, 0x91, 0xf2, 0xbf, 0xdb, 0x56, 0x7c, 0x94, 0x80, 0xe5, 0x89, 0x90, 0xc9,
-	0x5d, 0x98, 0x10, 0xba, 0xb0, 0x78, 0xe8, 0xc2, 0x12, 0x0b, 0x2f, 0x8c, 0xef, 0x75, 0x72, 0xf6,
-	0x5e, 0xa7, 0xbe, 0xc7, 0xbd, 0x4e, 0x3f, 0xde, 0x5e, 0xff, 0x4b, 0x77, 0xe1, 0x37, 0x02, 0x54,
-	0xa2, 0xe3, 0xcc, 0xd0, 0xed, 0x78, 0x16, 0x96, 0xdd, 0xa9, 0xb8, 0xe2, 0x99, 0x61, 0x14, 0xdd,
-	0x0e, 0x2e, 0x3f, 0xd2, 0x9d, 0x3e, 0x09, 0xa5, 0xb1, 0x28, 0x98, 0x1d, 0xe5, 0xe2, 0x99, 0x7f,
-	0x7c, 0xe9, 0xe7, 0x09, 0xd7, 0xf1, 0x04, 0x42, 0xd5, 0x90, 0xdb, 0xfa, 0x26, 0xac, 0xb4, 0x71,
-	0x4b, 0x6b, 0x3f, 0xee, 0x65, 0x5d, 0xe6, 0xdc, 0xff, 0xbd, 0xab, 0x93, 0xa7, 0xe4, 0xd7, 0x79,
-	0xc8, 0xca, 0xd8, 0x32, 0x48, 0xe8, 0x87, 0x76, 0x20, 0x87, 0x47, 0x2d, 0x6c, 0xd8, 0x4e, 0xb4,
-	0x1c, 0x9e, 0x0c, 0x31, 0xea, 0xba, 0x43, 0xd9, 0x88, 0xc9, 0x1e, 0x1b, 0xba, 0xc5, 0xd1, 0x8e,
-	0x68, 0xe0, 0x82, 0xb3, 0xfb, 0xe1, 0x8e, 0x17, 0x1d, 0xb8, 0x23, 0x11, 0x99, 0xc9, 0x33, 0xae,
-	0x31, 0xbc, 0xe3, 0x16, 0xc7, 0x3b, 0x92, 0x33, 0x06, 0x0b, 0x00, 0x1e, 0xb5, 0x00, 0xe0, 0x91,
-	0x9e, 0xb1, 0xcc, 0x08, 0xc4, 0xe3, 0x45, 0x07, 0xf1, 0xc8, 0xcc, 0x98, 0xf1, 0x18, 0xe4, 0xf1,
-	0x9a, 0x0f, 0xf2, 0xc8, 0x45, 0xa6, 0x5d, 0x8c, 0x35, 0x04, 0xf3, 0x78, 0xc5, 0xc5, 0x3c, 0x0a,
-	0x91, 0x39, 0x17, 0x67, 0x1e, 0x07, 0x3d, 0x0e, 0x27, 0x40, 0x0f, 0x06, 0x52, 0x3c, 0x15, 0x29,
-	0x62, 0x06, 0xea, 0x71, 0x38, 0x81, 0x7a, 0x94, 0x66, 0x08, 0x9c, 0x01, 0x7b, 0xfc, 0x38, 0x1c,
-	0xf6, 0x88, 0x06, 0x26, 0xf8, 0x34, 0xe7, 0xc3, 0x3d, 0x94, 0x08, 0xdc, 0x43, 0x8c, 0xcc, 0xd1,
-	0x99, 0xf8, 0xb9, 0x81, 0x8f, 0x07, 0x21, 0xc0, 0x07, 0x83, 0x28, 0xae, 0x47, 0x0a, 0x9f, 0x03,
-	0xf9, 0x78, 0x10, 0x82, 0x7c, 0xa0, 0x99, 0x62, 0x67, 0x42, 0x1f, 0xbb, 0x41, 0xe8, 0x63, 0x25,
-	0x22, 0xea, 0xf4, 0x6e, 0x7b, 0x04, 0xf6, 0x71, 0x12, 0x85, 0x7d, 0x30, 0x7c, 0xe2, 0xb9, 0x48,
-	0x89, 0x0b, 0x80, 0x1f, 0x87, 0x13, 0xe0, 0xc7, 0x85, 0x19, 0x27, 0x6d, 0x06, 0xfa, 0xf1, 0x03,
-	0x3f, 0xfa, 0xb1, 0x16, 0x09, 0x79, 0x3a, 0x16, 0x20, 0x04, 0xfe, 0x78, 0xcd, 0x07, 0x7f, 0x5c,
-	0x9c, 0x71, 0x8f, 0xa7, 0xe3, 0x1f, 0x29, 0x31, 0xbd, 0x9f, 0xcc, 0x66, 0xc5, 0x1c, 0x43, 0x3e,
-	0xf6, 0x93, 0xd9, 0xbc, 0x58, 0x90, 0x9e, 0x21, 0x21, 0xd4, 0x98, 0xa1, 0x25, 0xc9, 0x0a, 0x36,
-	0x4d, 0xdd, 0xe4, 0x48, 0x06, 0xab, 0x48, 0xd7, 0x49, 0x3e, 0xec, 0x19, 0xd5, 0x29, 0x58, 0x09,
-	0x4d, 0x0a, 0x7d, 0x86, 0x54, 0xfa, 0x83, 0xe0, 0xf1, 0x52, 0xb4, 0xc4, 0x9f, 0x4b, 0xe7, 0x78,
-	0x2e, 0xed, 0x43, 0x50, 0xe2, 0x41, 0x04, 0x65, 0x03, 0xf2, 0x24, 0xd9, 0x1b, 0x03, 0x47, 0x54,
-	0xc3, 0x05, 0x47, 0x6e, 0xc0, 0x32, 0xf5, 0xd8, 0x0c, 0x67, 0xe1, 0x7e, 0x31, 0x49, 0xfd, 0xe2,
-	0x12, 0xe9, 0x60, 0xdb, 0xc3, 0x1c, 0xe4, 0xf3, 0xb0, 0xe2, 0xa3, 0x75, 0x93, 0x48, 0x86, 0x14,
-	0x88, 0x2e, 0x75, 0x95, 0x67, 0x93, 0x7f, 0x12, 0x3c, 0x0d, 0x79, 0xa8, 0x4a, 0x18, 0x00, 0x22,
-	0x7c, 0x4f, 0x00, 0x48, 0xfc, 0xb1, 0x01, 0x10, 0x7f, 0x52, 0x9c, 0x08, 0x26, 0xc5, 0xff, 0x10,
-	0xbc, 0x3d, 0x71, 0xe1, 0x8c, 0x96, 0xde, 0xc6, 0x3c, 0x4d, 0xa5, 0x65, 0x12, 0x13, 0xf5, 0xf4,
-	0x53, 0x9e, 0x8c, 0x92, 0x22, 0xa1, 0x72, 0x3d, 0x5f, 0x8e, 0x3b, 0x36, 0x37, 0xc3, 0x65, 0x91,
-	0x07, 0xcf, 0x70, 0x45, 0x48, 0x3c, 0xc2, 0x0c, 0x99, 0x2f, 0xc8, 0xa4, 0x48, 0xe8, 0xe8, 0xe1,
-	0xe3, 0x11, 0x04, 0xab, 0xa0, 0x97, 0x21, 0x47, 0x9f, 0x40, 0x14, 0xdd, 0xb0, 0x38, 0x1a, 0x1f,
-	0x88, 0xad, 0xd8, 0x33, 0xc9, 0xd6, 0x11, 0xa1, 0x39, 0x34, 0x2c, 0x39, 0x6b, 0xf0, 0x92, 0x2f,
-	0xe4, 0xc9, 0x05, 0x42, 0x9e, 0x2b, 0x90, 0x23, 0xb3, 0xb7, 0x0c, 0xb5, 0x85, 0xcb, 0x40, 0x27,
-	0xea, 0x35, 0x48, 0xbf, 0x8f, 0xc3, 0xd2, 0x98, 0xa7, 0x0b, 0x5d, 0xbb, 0x73, 0x24, 0xe3, 0x3e,
-	0x78, 0x67, 0x3e, 0x7d, 0xac, 0x03, 0x9c, 0xaa, 0x96, 0xf2, 0x81, 0x3a, 0xb0, 0x71, 0x9b, 0x2b,
-	0xc5, 0xd7, 0x82, 0x2a, 0x90, 0x25, 0xb5, 0xa1, 0x85, 0xdb, 0x1c, 0x69, 0x72, 0xeb, 0xa8, 0x01,
-	0x69, 0x7c, 0x86, 0x07, 0xb6, 0x55, 0xce, 0xd0, 0x6d, 0x5f, 0x9b, 0xcc, 0xc7, 0x49, 0xf7, 0x4e,
-	0x99, 0x6c, 0xf6, 0xb7, 0x5f, 0x6d, 0x88, 0x8c, 0xfa, 0x39, 0xbd, 0xaf, 0xd9, 0xb8, 0x6f, 0xd8,
-	0xe7, 0x32, 0xe7, 0x0f, 0x6a, 0x21, 0x3b, 0xa6, 0x05, 0x8a, 0x79, 0x16, 0x1c, 0x7c, 0x81, 0xe8,
-	0x54, 0xd3, 0x4d, 0xcd, 0x3e, 0x97, 0x8b, 0x7d, 0xdc, 0x37, 0x74, 0xbd, 0xa7, 0xb0, 0x3b, 0xfe,
-	0x14, 0x88, 0xe3, 0xe6, 0x28, 0x4c, 0x59, 0xd2, 0x35, 0x4f, 0xa7, 0x0e, 0x7e, 0x34, 0x11, 0x4f,
-	0x4b, 0x55, 0x28, 0x05, 0xa3, 0x04, 0x74, 0x0d, 0x8a, 0x26, 0xb6, 0x55, 0x6d, 0xa0, 0x04, 0x42,
-	0xfa, 0x02, 0x6b, 0x64, 0x17, 0x74, 0x3f, 0x99, 0x15, 0xc4, 0xf8, 0x7e, 0x32, 0x1b, 0x17, 0x13,
-	0xd2, 0x11, 0x5c, 0x08, 0x8d, 0x12, 0xd0, 0x4b, 0x90, 0xf3, 0x02, 0x0c, 0x81, 0xaa, 0x6e, 0x0a,
-	0x6e, 0xe4, 0xd1, 0x4a, 0x9f, 0x0b, 0x9e, 0xc8, 0x20, 0x12, 0x55, 0x87, 0xb4, 0x89, 0xad, 0x61,
-	0x8f, 0x61, 0x43, 0xa5, 0x9b, 0xcf, 0xcf, 0x17, 0x5f, 0x90, 0xd6, 0x61, 0xcf, 0x96, 0x39, 0xb3,
-	0xf4, 0x0e, 0xa4, 0x59, 0x0b, 0xca, 0x43, 0xe6, 0xc1, 0xc1, 0xbd, 0x83, 0xc3, 0xb7, 0x0e, 0xc4,
-	0x18, 0x02, 0x48, 0x57, 0x6b, 0xb5, 0xfa, 0x51, 0x53, 0x14, 0x50, 0x0e, 0x52, 0xd5, 0x9d, 0x43,
-	0xb9, 0x29, 0xc6, 0x49, 0xb3, 0x5c, 0xdf, 0xaf, 0xd7, 0x9a, 0x62, 0x02, 0x2d, 0x43, 0x91, 0x95,
-	0x95, 0xdd, 0x43, 0xf9, 0x8d, 0x6a, 0x53, 0x4c, 0xfa, 0x9a, 0x8e, 0xeb, 0x07, 0x77, 0xeb, 0xb2,
-	0x98, 0x92, 0xfe, 0x07, 0x2e, 0x45, 0x46, 0x24, 0x1e, 0xcc, 0x24, 0xf8, 0x60, 0x26, 0xe9, 0xd3,
-	0x38, 0x49, 0xd1, 0xa2, 0xc2, 0x0c, 0xb4, 0x3f, 0xb6, 0xf0, 0x9b, 0x0b, 0xc4, 0x28, 0x63, 0xab,
-	0x27, 0x59, 0x99, 0x89, 0x3b, 0xd8, 0x6e, 0x75, 0x59, 0xd8, 0xc3, 0xcc, 0x59, 0x51, 0x2e, 0xf2,
-	0x56, 0xca, 0x64, 0x31, 0xb2, 0xf7, 0x70, 0xcb, 0x56, 0xd8, 0x89, 0xb4, 0x68, 0x6a, 0x94, 0x23,
-	0x64, 0xa4, 0xf5, 0x98, 0x35, 0x4a, 0xef, 0x2e, 0xa4, 0xcb, 0x1c, 0xa4, 0xe4, 0x7a, 0x53, 0x7e,
-	0x5b, 0x4c, 0x20, 0x04, 0x25, 0x5a, 0x54, 0x8e, 0x0f, 0xaa, 0x47, 0xc7, 0x8d, 0x43, 0xa2, 0xcb,
-	0x15, 0x58, 0x72, 0x74, 0xe9, 0x34, 0xa6, 0xa4, 0x67, 0xe1, 0x62, 0x44, 0x8c, 0x14, 0x72, 0xa0,
-	0x7f, 0x2b, 0xf8, 0xa9, 0x83, 0x71, 0xce, 0x21, 0xa4, 0x2d, 0x5b, 0xb5, 0x87, 0x16, 0x57, 0xe2,
-	0x4b, 0xf3, 0x06, 0x4d, 0x5b, 0x4e, 0xe1, 0x98, 0xb2, 0xcb, 0x5c, 0x8c, 0x74, 0x1b, 0x4a, 0xc1,
-	0x9e, 0x68, 0x1d, 0x78, 0x87, 0x28, 0x2e, 0xdd, 0x01, 0x34, 0x19, 0x4b, 0x85, 0x24, 0xcb, 0x42,
-	0x58, 0xb2, 0xfc, 0x3b, 0x01, 0x2e, 0x4f, 0x89, 0x9b, 0xd0, 0x9b, 0x63, 0x8b, 0x7c, 0x65, 0x91,
-	0xa8, 0x6b, 0x8b, 0xb5, 0x8d, 0x2d, 0xf3, 0x16, 0x14, 0xfc, 0xed, 0xf3, 0x2d, 0xf2, 0xdb, 0xb8,
-	0x77, 0x89, 0x83, 0x59, 0xbd, 0x67, 0x4f, 0x85, 0xef, 0x68, 0x4f, 0x5f, 0x05, 0xb0, 0x47, 0x0a,
-	0x3b, 0xd6, 0x8e, 0x53, 0x7e, 0x22, 0x04, 0x2d, 0xc5, 0xad, 0xe6, 0x88, 0x5f, 0x82, 0x9c, 0xcd,
-	0x4b, 0x16, 0x3a, 0xf6, 0x43, 0x1c, 0x43, 0xea, 0xb0, 0x2d, 0x9e, 0xfe, 0xcf, 0xeb, 0xd9, 0x3d,
-	0x28, 0x84, 0x35, 0x5b, 0xe8, 0x6d, 0xb8, 0x38, 0x16, 0x75, 0xb8, 0xa2, 0x93, 0xf3, 0x06, 0x1f,
-	0x17, 0x82, 0xc1, 0x87, 0x23, 0xda, 0x1f, 0x3a, 0xa4, 0x82, 0xa1, 0xc3, 0xdb, 0x00, 0x1e, 0xd4,
-	0x41, 0x2c, 0x8c, 0xa9, 0x0f, 0x07, 0x6d, 0x7a, 0x02, 0x52, 0x32, 0xab, 0xa0, 0xdb, 0x90, 0x22,
-	0x27, 0xc9, 0xd1, 0xd3, 0xa4, 0x29, 0x26, 0x27, 0xc1, 0x07, 0x95, 0x30, 0x6a, 0x49, 0x03, 0x34,
-	0x09, 0x37, 0x47, 0x0c, 0xf1, 0x5a, 0x70, 0x88, 0xab, 0x91, 0xc0, 0x75, 0xf8, 0x50, 0x1f, 0x42,
-	0x8a, 0xee, 0x3c, 0x71, 0x67, 0xf4, 0x39, 0x85, 0x87, 0x9e, 0xa4, 0x8c, 0x7e, 0x02, 0xa0, 0xda,
-	0xb6, 0xa9, 0x9d, 0x0c, 0xbd, 0x01, 0x36, 0xc2, 0x4f, 0x4e, 0xd5, 0xa1, 0xdb, 0xb9, 0xc2, 0x8f,
-	0xd0, 0xaa, 0xc7, 0xea, 0x3b, 0x46, 0x3e, 0x81, 0xd2, 0x01, 0x94, 0x82, 0xbc, 0x4e, 0xb0, 0xc4,
-	0xe6, 0x10, 0x0c, 0x96, 0x58, 0xec, 0xcb, 0x83, 0x25, 0x37, 0xd4, 0x4a, 0xb0, 0x37, 0x23, 0x5a,
-	0x91, 0x7e, 0x1a, 0x87, 0x82, 0xff, 0xe0, 0xfd, 0xe7, 0xc5, 0x33, 0xd2, 0x2f, 0x04, 0xc8, 0xba,
-	0xcb, 0x0f, 0xbe, 0xea, 0x04, 0x5e, 0xdc, 0x98, 0xf6, 0xe2, 0xfe, 0xa7, 0x18, 0xf6, 0x14, 0x96,
-	0x70, 0xdf, 0xd7, 0xee, 0xb8, 0xee, 0x2f, 0x0a, 0xde, 0xf1, 0xeb, 0x9a, 0x9f, 0x2a, 0xc7, 0xdb,
-	0xdf, 0x81, 0x9c, 0x7b, 0x7b, 0x49, 0x06, 0xe3, 0xc0, 0x60, 0x02, 0xbf, 0x43, 0x1c, 0xc4, 0x5c,
-	0x85, 0x94, 0xa1, 0x7f, 0xc0, 0xdf, 0x79, 0x12, 0x32, 0xab, 0x48, 0x6d, 0x58, 0x1a, 0xbb, 0xfa,
-	0xe8, 0x0e, 0x64, 0x8c, 0xe1, 0x89, 0xe2, 0x1c, 0x8e, 0x31, 0xb0, 0xd0, 0x89, 0x8d, 0x87, 0x27,
-	0x3d, 0xad, 0x75, 0x0f, 0x9f, 0x3b, 0x93, 0x31, 0x86, 0x27, 0xf7, 0xd8, 0x19, 0x62, 0xa3, 0xc4,
-	0xfd, 0xa3, 0xfc, 0x4a, 0x80, 0xac, 0x73, 0x27, 0xd0, 0xff, 0x41, 0xce, 0x35, 0x2b, 0xee, 0x9b,
-	0x70, 0xa4, 0x3d, 0xe2, 0xf2, 0x3d, 0x16, 0x54, 0x75, 0x1e, 0xb3, 0xb5, 0xb6, 0xd2, 0xe9, 0xa9,
-	0xec, 0x2c, 0x95, 0x82, 0x3a, 0x63, 0x86, 0x87, 0xda, 0xe3, 0xbd, 0xbb, 0xbb, 0x3d, 0xf5, 0x54,
-	0xce, 0x53, 0x9e, 0xbd, 0x36, 0xa9, 0xf0, 0xc8, 0xee, 0xef, 0x02, 0x88, 0xe3, 0x37, 0xf6, 0x3b,
-	0xcf, 0x6e, 0xd2, 0xcd, 0x25, 0x42, 0xdc, 0x1c, 0xda, 0x86, 0x15, 0x97, 0x42, 0xb1, 0xb4, 0xd3,
-	0x81, 0x6a, 0x0f, 0x4d, 0xcc, 0xe1, 0x55, 0xe4, 0x76, 0x1d, 0x3b, 0x3d, 0x93, 0xab, 0x4e, 0x3d,
-	0xe6, 0xaa, 0x3f, 0x8a, 0x43, 0xde, 0x07, 0xf6, 0xa2, 0xff, 0xf5, 0x19, 0xa3, 0x52, 0x88, 0x67,
-	0xf0, 0xd1, 0x7a, 0xef, 0xbb, 0x41, 0x35, 0xc5, 0x17, 0x57, 0x53, 0x14, 0xa4, 0xee, 0x60, 0xc7,
-	0xc9, 0x85, 0xb1, 0xe3, 0xe7, 0x00, 0xd9, 0xba, 0xad, 0xf6, 0x94, 0x33, 0xdd, 0xd6, 0x06, 0xa7,
-	0x0a, 0x3b, 0x86, 0xcc, 0x74, 0x88, 0xb4, 0xe7, 0x21, 0xed, 0x38, 0xa2, 0x27, 0xf2, 0x67, 0x02,
-	0x64, 0xdd, 0xb0, 0x7b, 0xd1, 0x27, 0xd9, 0x35, 0x48, 0xf3, 0xc8, 0x92, 0xbd, 0xc9, 0xf2, 0x5a,
-	0x28, 0x48, 0x5e, 0x81, 0x6c, 0x1f, 0xdb, 0x2a, 0xb5, 0x83, 0xcc, 0xab, 0xb9, 0xf5, 0x1b, 0xaf,
-	0x40, 0xde, 0xf7, 0x72, 0x4e, 0x4c, 0xe3, 0x41, 0xfd, 0x2d, 0x31, 0x56, 0xc9, 0x7c, 0xfc, 0xd9,
-	0x66, 0xe2, 0x00, 0x7f, 0x40, 0x6e, 0xb3, 0x5c, 0xaf, 0x35, 0xea, 0xb5, 0x7b, 0xa2, 0x50, 0xc9,
-	0x7f, 0xfc, 0xd9, 0x66, 0x46, 0xc6, 0x14, 0x1f, 0xbd, 0x71, 0x0f, 0x96, 0xc6, 0x36, 0x26, 0x18,
-	0xb6, 0x20, 0x28, 0xdd, 0x7d, 0x70, 0x74, 0x7f, 0xaf, 0x56, 0x6d, 0xd6, 0x95, 0x87, 0x87, 0xcd,
-	0xba, 0x28, 0xa0, 0x8b, 0xb0, 0x72, 0x7f, 0xef, 0xf5, 0x46, 0x53, 0xa9, 0xdd, 0xdf, 0xab, 0x1f,
-	0x34, 0x95, 0x6a, 0xb3, 0x59, 0xad, 0xdd, 0x13, 0xe3, 0x37, 0xff, 0x58, 0x80, 0x64, 0x75, 0xa7,
-	0xb6, 0x87, 0x6a, 0x90, 0xa4, 0xb8, 0xca, 0xd4, 0x9f, 0x7b, 0x95, 0xe9, 0x48, 0x37, 0xda, 0x85,
-	0x14, 0x85, 0x5c, 0xd0, 0xf4, 0xaf, 0x7c, 0x95, 0x19, 0xd0, 0x37, 0x99, 0x0c, 0xbd, 0x91, 0x53,
-	0xff, 0xf6, 0x55, 0xa6, 0x23, 0xe1, 0xe8, 0x3e, 0x64, 0x9c, 0x8c, 0x7b, 0xd6, 0x87, 0xbb, 0xca,
-	0x4c, 0x78, 0x1a, 0x1d, 0x42, 0xd6, 0xcd, 0x49, 0x67, 0xfe, 0x21, 0xaa, 0xcc, 0xc6, 0xd9, 0xc8,
-	0xf4, 0x9c, 0xe4, 0x75, 0xd6, 0x9f, 0xa2, 0xca, 0x4c, 0xd4, 0x8d, 0x68, 0x9e, 0x01, 0x2b, 0xd3,
-	0x7f, 0x25, 0x56, 0x66, 0x40, 0xf8, 0x68, 0x0f, 0xd2, 0x3c, 0x5b, 0x9e, 0xf1, 0xd1, 0xb0, 0x32,
-	0x0b, 0x94, 0x47, 0x32, 0xe4, 0x3c, 0xc8, 0x6a, 0xf6, 0x5f, 0xcb, 0xca, 0x1c, 0xaf, 0x13, 0xe8,
-	0x1d, 0x28, 0x06, 0x33, 0xf1, 0xf9, 0x3e, 0x33, 0x56, 0xe6, 0x84, 0xff, 0x89, 0xfc, 0x60, 0x5a,
-	0x3e, 0xdf, 0xe7, 0xc6, 0xca, 0x9c, 0xaf, 0x01, 0xe8, 0x3d, 0x58, 0x9e, 0x4c, 0x9b, 0xe7, 0xff,
-	0xeb, 0x58, 0x59, 0xe0, 0x7d, 0x00, 0xf5, 0x01, 0x85, 0xa4, 0xdb, 0x0b, 0x7c, 0x7d, 0xac, 0x2c,
-	0xf2, 0x5c, 0x80, 0xda, 0xb0, 0x34, 0x9e, 0xc3, 0xce, 0xfb, 0x15, 0xb2, 0x32, 0xf7, 0xd3, 0x01,
-	0x1b, 0x25, 0x98, 0xfb, 0xce, 0xfb, 0x35, 0xb2, 0x32, 0xf7, 0x4b, 0x02, 0x7a, 0x00, 0xe0, 0x4b,
-	0x5f, 0xe7, 0xf8, 0x2a, 0x59, 0x99, 0xe7, 0x4d, 0x01, 0x19, 0xb0, 0x12, 0x96, 0xd7, 0x2e, 0xf2,
-	0x73, 0xb2, 0xb2, 0xd0, 0x53, 0x03, 0x39, 0xcf, 0xc1, 0x0c, 0x75, 0xbe, 0x9f, 0x94, 0x95, 0x39,
-	0xdf, 0x1c, 0x76, 0xaa, 0x5f, 0x7c, 0xbd, 0x2e, 0x7c, 0xf9, 0xf5, 0xba, 0xf0, 0xb7, 0xaf, 0xd7,
-	0x85, 0x4f, 0xbe, 0x59, 0x8f, 0x7d, 0xf9, 0xcd, 0x7a, 0xec, 0x2f, 0xdf, 0xac, 0xc7, 0x7e, 0xf8,
-	0xf4, 0xa9, 0x66, 0x77, 0x87, 0x27, 0x5b, 0x2d, 0xbd, 0xbf, 0xdd, 0xd2, 0xfb, 0xd8, 0x3e, 0xe9,
-	0xd8, 0x5e, 0xc1, 0xfb, 0x10, 0x7f, 0x92, 0xa6, 0x0e, 0xfe, 0xd6, 0x3f, 0x03, 0x00, 0x00, 0xff,
-	0xff, 0x0d, 0x83, 0x0c, 0x75, 0x30, 0x2f, 0x00, 0x00,
+	// 3383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xbb, 0x77, 0xe3, 0xc6,
+	0xd5, 0x27, 0xf8, 0xe6, 0xe5, 0x0b, 0x1a, 0x69, 0x77, 0xb9, 0xd8, 0xb5, 0xa4, 0xc5, 0x1e, 0xdb,
+	0xeb, 0xb5, 0x2d, 0xf9, 0xdb, 0xfd, 0xfc, 0xca, 0xda, 0x49, 0x28, 0x2e, 0x65, 0x4a, 0xbb, 0x96,
+	0x64, 0x88, 0x5a, 0x1f, 0xe7, 0x61, 0x18, 0x22, 0x47, 0x22, 0xbc, 0x24, 0x01, 0x03, 0xa0, 0x4c,
+	0xb9, 0xca, 0x89, 0xe3, 0x73, 0x72, 0x5c, 0xf9, 0x1c, 0x37, 0x2e, 0xe2, 0x22, 0x45, 0x9a, 0xfc,
+	0x05, 0xa9, 0x52, 0xa5, 0x70, 0x91, 0xc2, 0x65, 0x9a, 0x38, 0x39, 0x76, 0xe7, 0x36, 0x45, 0xda,
+	0x9c, 0x79, 0x00, 0x04, 0x48, 0x80, 0x8f, 0xb5, 0x53, 0xe4, 0x24, 0x1d, 0x66, 0xe6, 0xde, 0x3b,
+	0x33, 0x77, 0x66, 0xee, 0xe3, 0x37, 0x03, 0xb8, 0xe2, 0xe0, 0x7e, 0x1b, 0x5b, 0x3d, 0xbd, 0xef,
+	0x6c, 0x6a, 0xc7, 0x2d, 0x7d, 0xd3, 0x39, 0x37, 0xb1, 0xbd, 0x61, 0x5a, 0x86, 0x63, 0xa0, 0xf2,
+	0xa8, 0x71, 0x83, 0x34, 0x4a, 0x2b, 0xa7, 0xc6, 0xa9, 0x41, 0xdb, 0x36, 0xc9, 0x17, 0x23, 0x93,
+	0xd6, 0x4e, 0x0d, 0xe3, 0xb4, 0x8b, 0x37, 0x69, 0xe9, 0x78, 0x70, 0xb2, 0xe9, 0xe8, 0x3d, 0x6c,
+	0x3b, 0x5a, 0xcf, 0xe4, 0x04, 0x57, 0x7d, 0x9d, 0xb4, 0xac, 0x73, 0xd3, 0x31, 0x36, 0x1f, 0xe2,
+	0x73, 0xde, 0x8b, 0xf4, 0xd8, 0x64, 0xab, 0x69, 0x19, 0xc6, 0x49, 0x48, 0x33, 0x1d, 0xdc, 0xa6,
+	0xa9, 0x59, 0x5a, 0xcf, 0xe5, 0x5e, 0x9f, 0x68, 0x3e, 0xd3, 0xba, 0x7a, 0x5b, 0x73, 0x0c, 0x8b,
+	0x51, 0xc8, 0x1f, 0xe5, 0x21, 0xa3, 0xe0, 0xf7, 0x06, 0xd8, 0x76, 0xd0, 0x2d, 0x48, 0xe2, 0x56,
+	0xc7, 0xa8, 0x08, 0xeb, 0xc2, 0x8d, 0xfc, 0xad, 0xab, 0x1b, 0x63, 0x13, 0xdc, 0xe0, 0x74, 0xf5,
+	0x56, 0xc7, 0x68, 0xc4, 0x14, 0x4a, 0x8b, 0x9e, 0x87, 0xd4, 0x49, 0x77, 0x60, 0x77, 0x2a, 0x71,
+	0xca, 0xf4, 0x58, 0x14, 0xd3, 0x36, 0x21, 0x6a, 0xc4, 0x14, 0x46, 0x4d, 0xba, 0xd2, 0xfb, 0x27,
+	0x46, 0x25, 0x31, 0xbd, 0xab, 0x9d, 0xfe, 0x09, 0xed, 0x8a, 0xd0, 0xa2, 0x2d, 0x00, 0xbd, 0xaf,
+	0x3b, 0x6a, 0xab, 0xa3, 0xe9, 0xfd, 0x4a, 0x8a, 0x72, 0x5e, 0x8b, 0xe6, 0xd4, 0x9d, 0x1a, 0x21,
+	0x6c, 0xc4, 0x94, 0x9c, 0xee, 0x16, 0xc8, 0x70, 0xdf, 0x1b, 0x60, 0xeb, 0xbc, 0x92, 0x9e, 0x3e,
+	0xdc, 0x37, 0x08, 0x11, 0x19, 0x2e, 0xa5, 0x46, 0xaf, 0x40, 0xb6, 0xd5, 0xc1, 0xad, 0x87, 0xaa,
+	0x33, 0xac, 0x64, 0x29, 0xe7, 0x5a, 0x14, 0x67, 0x8d, 0xd0, 0x35, 0x87, 0x8d, 0x98, 0x92, 0x69,
+	0xb1, 0x4f, 0xf4, 0x12, 0xa4, 0x5b, 0x46, 0xaf, 0xa7, 0x3b, 0x95, 0x3c, 0xe5, 0x5d, 0x8d, 0xe4,
+	0xa5, 0x54, 0x8d, 0x98, 0xc2, 0xe9, 0xd1, 0x1e, 0x94, 0xba, 0xba, 0xed, 0xa8, 0x76, 0x5f, 0x33,
+	0xed, 0x8e, 0xe1, 0xd8, 0x95, 0x02, 0x95, 0xf0, 0x78, 0x94, 0x84, 0xfb, 0xba, 0xed, 0x1c, 0xba,
+	0xc4, 0x8d, 0x98, 0x52, 0xec, 0xfa, 0x2b, 0x88, 0x3c, 0xe3, 0xe4, 0x04, 0x5b, 0x9e, 0xc0, 0x4a,
+	0x71, 0xba, 0xbc, 0x7d, 0x42, 0xed, 0xf2, 0x13, 0x79, 0x86, 0xbf, 0x02, 0xfd, 0x14, 0x96, 0xbb,
+	0x86, 0xd6, 0xf6, 0xc4, 0xa9, 0xad, 0xce, 0xa0, 0xff, 0xb0, 0x52, 0xa2, 0x42, 0x9f, 0x8a, 0x1c,
+	0xa4, 0xa1, 0xb5, 0x5d, 0x11, 0x35, 0xc2, 0xd0, 0x88, 0x29, 0x4b, 0xdd, 0xf1, 0x4a, 0xf4, 0x36,
+	0xac, 0x68, 0xa6, 0xd9, 0x3d, 0x1f, 0x97, 0x5e, 0xa6, 0xd2, 0x6f, 0x46, 0x49, 0xaf, 0x12, 0x9e,
+	0x71, 0xf1, 0x48, 0x9b, 0xa8, 0x45, 0x4d, 0x10, 0x4d, 0x0b, 0x9b, 0x9a, 0x85, 0x55, 0xd3, 0x32,
+	0x4c, 0xc3, 0xd6, 0xba, 0x15, 0x91, 0xca, 0x7e, 0x32, 0x4a, 0xf6, 0x01, 0xa3, 0x3f, 0xe0, 0xe4,
+	0x8d, 0x98, 0x52, 0x36, 0x83, 0x55, 0x4c, 0xaa, 0xd1, 0xc2, 0xb6, 0x3d, 0x92, 0xba, 0x34, 0x4b,
+	0x2a, 0xa5, 0x0f, 0x4a, 0x0d, 0x54, 0xa1, 0x3a, 0xe4, 0xf1, 0x90, 0xb0, 0xab, 0x67, 0x86, 0x83,
+	0x2b, 0x88, 0x0a, 0x94, 0x23, 0x4f, 0x28, 0x25, 0x7d, 0x60, 0x38, 0xb8, 0x11, 0x53, 0x00, 0x7b,
+	0x25, 0xa4, 0xc1, 0x85, 0x33, 0x6c, 0xe9, 0x27, 0xe7, 0x54, 0x8c, 0x4a, 0x5b, 0x6c, 0xdd, 0xe8,
+	0x57, 0x96, 0xa9, 0xc0, 0xa7, 0xa3, 0x04, 0x3e, 0xa0, 0x4c, 0x44, 0x44, 0xdd, 0x65, 0x69, 0xc4,
+	0x94, 0xe5, 0xb3, 0xc9, 0x6a, 0xb2, 0xc5, 0x4e, 0xf4, 0xbe, 0xd6, 0xd5, 0x3f, 0xc0, 0xea, 0x71,
+	0xd7, 0x68, 0x3d, 0xac, 0xac, 0x4c, 0xdf, 0x62, 0xdb, 0x9c, 0x7a, 0x8b, 0x10, 0x93, 0x2d, 0x76,
+	0xe2, 0xaf, 0x40, 0x3f, 0x82, 0x9c, 0xde, 0xb7, 0xb1, 0xe5, 0x90, 0xb3, 0x77, 0x81, 0x8a, 0x5a,
+	0x8f, 0x3e, 0xf4, 0x84, 0x90, 0x1e, 0xbe, 0xac, 0xce, 0xbf, 0xc9, 0xd9, 0xb5, 0xb0, 0x66, 0xaa,
+	0xce, 0xd0, 0xae, 0x5c, 0x9c, 0x7e, 0x76, 0x15, 0xac, 0x99, 0xcd, 0x21, 0x39, 0x37, 0x19, 0x8b,
+	0x7d, 0x12, 0xc5, 0xdb, 0x7a, 0x6f, 0xd0, 0xd5, 0x1c, 0x4c, 0x06, 0x70, 0x69, 0xba, 0xe2, 0x0f,
+	0x39, 0x29, 0x1d, 0x02, 0xd8, 0x5e, 0x69, 0x2b, 0x03, 0xa9, 0x33, 0xad, 0x3b, 0xc0, 0xbb, 0xc9,
+	0x6c, 0x52, 0x4c, 0xed, 0x26, 0xb3, 0x19, 0x31, 0xbb, 0x9b, 0xcc, 0xe6, 0x44, 0xd8, 0x4d, 0x66,
+	0x41, 0xcc, 0xcb, 0x4f, 0x42, 0xde, 0x67, 0x5e, 0x51, 0x05, 0x32, 0x3d, 0x6c, 0xdb, 0xda, 0x29,
+	0xa6, 0xd6, 0x38, 0xa7, 0xb8, 0x45, 0xb9, 0x04, 0x05, 0xbf, 0x49, 0x95, 0x3f, 0x11, 0x20, 0xef,
+	0xb3, 0x96, 0x84, 0xf3, 0x0c, 0x5b, 0x74, 0x51, 0x39, 0x27, 0x2f, 0xa2, 0xeb, 0x50, 0xa4, 0x0b,
+	0xa2, 0xba, 0xed, 0xc4, 0x64, 0x27, 0x95, 0x02, 0xad, 0x7c, 0xc0, 0x89, 0xd6, 0x20, 0x6f, 0xde,
+	0x32, 0x3d, 0x92, 0x04, 0x25, 0x01, 0xf3, 0x96, 0xe9, 0x12, 0x5c, 0x83, 0x02, 0x99, 0xb1, 0x47,
+	0x91, 0xa4, 0x9d, 0xe4, 0x49, 0x1d, 0x27, 0x91, 0xff, 0x1c, 0x07, 0x71, 0xdc, 0x0c, 0xa3, 0x97,
+	0x20, 0x49, 0x3c, 0x1f, 0x77, 0x2e, 0xd2, 0x06, 0x73, 0x8b, 0x1b, 0xae, 0x5b, 0xdc, 0x68, 0xba,
+	0x6e, 0x71, 0x2b, 0xfb, 0xc5, 0x57, 0x6b, 0xb1, 0x4f, 0xfe, 0xb6, 0x26, 0x28, 0x94, 0x03, 0x5d,
+	0x26, 0xc6, 0x57, 0xd3, 0xfb, 0xaa, 0xde, 0xa6, 0x43, 0xce, 0x11, 0xcb, 0xaa, 0xe9, 0xfd, 0x9d,
+	0x36, 0xba, 0x0f, 0x62, 0xcb, 0xe8, 0xdb, 0xb8, 0x6f, 0x0f, 0x6c, 0x95, 0x79, 0xbe, 0x4a, 0x62,
+	0xd2, 0x31, 0x30, 0xb7, 0x5d, 0x73, 0x29, 0x0f, 0x28, 0xa1, 0x52, 0x6e, 0x05, 0x2b, 0xd0, 0x36,
+	0x80, 0xe7, 0x1e, 0xed, 0x4a, 0x72, 0x3d, 0x11, 0xba, 0xd7, 0x1e, 0xb8, 0x24, 0x47, 0x66, 0x5b,
+	0x73, 0xf0, 0x56, 0x92, 0x0c, 0x57, 0xf1, 0x71, 0xa2, 0x27, 0xa0, 0xac, 0x99, 0xa6, 0x6a, 0x3b,
+	0x64, 0xd3, 0x1c, 0x9f, 0x3b, 0xd8, 0xa6, 0xde, 0xaa, 0xa0, 0x14, 0x35, 0xd3, 0x3c, 0x24, 0xb5,
+	0x5b, 0xa4, 0x12, 0x3d, 0x0e, 0x25, 0xe2, 0x99, 0x74, 0xad, 0xab, 0x76, 0xb0, 0x7e, 0xda, 0x71,
+	0xa8, 0x57, 0x4a, 0x28, 0x45, 0x5e, 0xdb, 0xa0, 0x95, 0x72, 0x1b, 0x0a, 0x7e, 0xaf, 0x84, 0x10,
+	0x24, 0xdb, 0x9a, 0xa3, 0x51, 0x4d, 0x16, 0x14, 0xfa, 0x4d, 0xea, 0x4c, 0xcd, 0xe9, 0x70, 0xfd,
+	0xd0, 0x6f, 0x74, 0x11, 0xd2, 0x5c, 0x6c, 0x82, 0x8a, 0xe5, 0x25, 0xb4, 0x02, 0x29, 0xd3, 0x32,
+	0xce, 0x30, 0x5d, 0xba, 0xac, 0xc2, 0x0a, 0xb2, 0x02, 0xa5, 0xa0, 0x07, 0x43, 0x25, 0x88, 0x3b,
+	0x43, 0xde, 0x4b, 0xdc, 0x19, 0xa2, 0xe7, 0x20, 0x49, 0x14, 0x49, 0xfb, 0x28, 0x85, 0xf8, 0x6c,
+	0xce, 0xd7, 0x3c, 0x37, 0xb1, 0x42, 0x29, 0xe5, 0x6b, 0x50, 0x1e, 0x3b, 0x99, 0xe3, 0x42, 0xe5,
+	0x6d, 0x28, 0x05, 0x0f, 0x1f, 0xba, 0x02, 0xb9, 0x9e, 0x36, 0xe4, 0x7a, 0x13, 0xe8, 0xfe, 0xcb,
+	0xf6, 0xb4, 0x21, 0x53, 0xd9, 0x25, 0xc8, 0x90, 0xc6, 0x53, 0xcd, 0xe6, 0xbb, 0x37, 0xdd, 0xd3,
+	0x86, 0xaf, 0x69, 0xb6, 0x7c, 0x1d, 0x96, 0x26, 0xce, 0xe0, 0x44, 0x67, 0x65, 0x28, 0x06, 0x3c,
+	0xad, 0x7c, 0x11, 0x56, 0xc2, 0x1c, 0xa7, 0xdc, 0x81, 0x95, 0x30, 0x07, 0x88, 0x9e, 0x87, 0xac,
+	0xe7, 0x39, 0xd9, 0x46, 0xbe, 0x3c, 0xa1, 0x06, 0x97, 0x58, 0xf1, 0x48, 0xc9, 0x0e, 0x26, 0x1b,
+	0xa2, 0xa3, 0xf1, 0x38, 0xa9, 0xa0, 0x64, 0x34, 0xd3, 0x6c, 0x68, 0x76, 0x47, 0x7e, 0x07, 0x2a,
+	0x51, 0x5e, 0xd1, 0xb7, 0x80, 0x4c, 0x0d, 0xbc, 0x44, 0xea, 0x4f, 0x0c, 0xab, 0xa7, 0x39, 0x54,
+	0x58, 0x51, 0xe1, 0x25, 0xb2, 0xb0, 0xcc, 0x43, 0x26, 0x68, 0x35, 0x2b, 0xc8, 0x2a, 0x5c, 0x8e,
+	0xf4, 0x8c, 0x84, 0x45, 0xef, 0xb7, 0x31, 0x53, 0x52, 0x51, 0x61, 0x85, 0x91, 0x20, 0x36, 0x58,
+	0x56, 0x20, 0xdd, 0xda, 0x74, 0xae, 0x54, 0x7e, 0x4e, 0xe1, 0x25, 0xf9, 0xb3, 0x04, 0x5c, 0x0c,
+	0xf7, 0x8f, 0x68, 0x1d, 0x0a, 0x64, 0xb9, 0x1c, 0xff, 0x72, 0x26, 0x14, 0xe8, 0x69, 0xc3, 0x26,
+	0x5f, 0x50, 0x11, 0x12, 0xc4, 0x30, 0xc7, 0xd7, 0x13, 0x37, 0x0a, 0x0a, 0xf9, 0x44, 0x47, 0xb0,
+	0xd4, 0x35, 0x5a, 0x5a, 0x57, 0xed, 0x6a, 0xb6, 0xa3, 0xf2, 0xc0, 0x89, 0x1d, 0xea, 0xeb, 0x13,
+	0xca, 0x66, 0x9e, 0x0e, 0xb7, 0xd9, 0x7a, 0x12, 0x03, 0xc8, 0xcf, 0x63, 0x99, 0xca, 0xb8, 0xaf,
+	0xb9, 0x4b, 0x8d, 0xee, 0x42, 0xbe, 0xa7, 0xdb, 0xc7, 0xb8, 0xa3, 0x9d, 0xe9, 0x86, 0xc5, 0x4f,
+	0xf7, 0xe4, 0x26, 0x7e, 0x7d, 0x44, 0xc3, 0x25, 0xf9, 0xd9, 0x7c, 0x4b, 0x92, 0x0a, 0x9c, 0x29,
+	0xd7, 0xba, 0xa5, 0x17, 0xb6, 0x6e, 0xcf, 0xc1, 0x4a, 0x1f, 0x0f, 0x1d, 0x75, 0x64, 0x3f, 0xd8,
+	0x3e, 0xc9, 0x50, 0xd5, 0x23, 0xd2, 0xe6, 0x59, 0x1c, 0x9b, 0x6c, 0x19, 0xf4, 0x14, 0x8d, 0x30,
+	0x4c, 0xc3, 0xc6, 0x96, 0xaa, 0xb5, 0xdb, 0x16, 0xb6, 0x6d, 0x1a, 0x94, 0x16, 0x94, 0xb2, 0x5b,
+	0x5f, 0x65, 0xd5, 0xf2, 0xaf, 0xfd, 0x4b, 0x13, 0x8c, 0x28, 0xb8, 0xe2, 0x85, 0x91, 0xe2, 0x0f,
+	0x61, 0x85, 0xf3, 0xb7, 0x03, 0xba, 0x67, 0x91, 0xfd, 0x95, 0xc9, 0xf3, 0x3e, 0xae, 0x73, 0xe4,
+	0xb2, 0x47, 0xab, 0x3d, 0xf1, 0x68, 0x6a, 0x47, 0x90, 0xa4, 0x4a, 0x49, 0x32, 0x93, 0x47, 0xbe,
+	0xff, 0xd3, 0x96, 0xe2, 0xc3, 0x84, 0x67, 0xa1, 0x46, 0xe1, 0x99, 0x37, 0x31, 0x21, 0x74, 0x62,
+	0xf1, 0xd0, 0x89, 0x25, 0x16, 0x9e, 0x18, 0x5f, 0xeb, 0xe4, 0xec, 0xb5, 0x4e, 0x7d, 0x8f, 0x6b,
+	0x9d, 0x7e, 0xb4, 0xb5, 0xfe, 0xb7, 0xae, 0xc2, 0x6f, 0x04, 0x90, 0xa2, 0x63, 0xda, 0xd0, 0xe5,
+	0x78, 0x1a, 0x96, 0xbc, 0xa1, 0x78, 0xe2, 0x99, 0x61, 0x14, 0xbd, 0x06, 0x2e, 0x3f, 0xd2, 0xe7,
+	0x3e, 0x0e, 0xa5, 0xb1, 0x88, 0x9b, 0x6d, 0xe5, 0xe2, 0x99, 0xbf, 0x7f, 0xf9, 0x57, 0x09, 0x58,
+	0x09, 0x0b, 0x8b, 0x43, 0x4e, 0xeb, 0x1b, 0xb0, 0xdc, 0xc6, 0x2d, 0xbd, 0xfd, 0xa8, 0x87, 0x75,
+	0x89, 0x73, 0xff, 0xef, 0xac, 0x4e, 0xee, 0x92, 0xbf, 0xe6, 0x21, 0xab, 0x60, 0xdb, 0x34, 0xfa,
+	0x36, 0x46, 0x5b, 0x90, 0xc3, 0xc3, 0x16, 0x36, 0x1d, 0x37, 0xa4, 0x0e, 0x8f, 0xff, 0x19, 0x75,
+	0xdd, 0xa5, 0x24, 0xb0, 0x83, 0xc7, 0x86, 0x6e, 0x73, 0x64, 0x25, 0x1a, 0x24, 0xe1, 0xec, 0x7e,
+	0x68, 0xe5, 0x05, 0x17, 0x5a, 0x49, 0x44, 0xa2, 0x06, 0x8c, 0x6b, 0x0c, 0x5b, 0xb9, 0xcd, 0xb1,
+	0x95, 0xe4, 0x8c, 0xce, 0x02, 0xe0, 0x4a, 0x2d, 0x00, 0xae, 0xa4, 0x67, 0x4c, 0x33, 0x02, 0x5d,
+	0x79, 0xc1, 0x45, 0x57, 0x32, 0x33, 0x46, 0x3c, 0x06, 0xaf, 0xbc, 0xea, 0x83, 0x57, 0x72, 0x91,
+	0x29, 0x1e, 0x63, 0x0d, 0xc1, 0x57, 0x5e, 0xf6, 0xf0, 0x95, 0x42, 0x64, 0x7e, 0xc7, 0x99, 0xc7,
+	0x01, 0x96, 0xfd, 0x09, 0x80, 0x85, 0x01, 0x22, 0x4f, 0x44, 0x8a, 0x98, 0x81, 0xb0, 0xec, 0x4f,
+	0x20, 0x2c, 0xa5, 0x19, 0x02, 0x67, 0x40, 0x2c, 0x3f, 0x0b, 0x87, 0x58, 0xa2, 0x41, 0x10, 0x3e,
+	0xcc, 0xf9, 0x30, 0x16, 0x35, 0x02, 0x63, 0x11, 0x23, 0xf1, 0x00, 0x26, 0x7e, 0x6e, 0x90, 0xe5,
+	0x28, 0x04, 0x64, 0x61, 0x70, 0xc8, 0x8d, 0x48, 0xe1, 0x73, 0xa0, 0x2c, 0x47, 0x21, 0x28, 0x0b,
+	0x9a, 0x29, 0x76, 0x26, 0xcc, 0xb2, 0x1d, 0x84, 0x59, 0x96, 0x23, 0xa2, 0xce, 0xd1, 0x69, 0x8f,
+	0xc0, 0x59, 0x8e, 0xa3, 0x70, 0x16, 0x86, 0x85, 0x3c, 0x13, 0x29, 0x71, 0x01, 0xa0, 0x65, 0x7f,
+	0x02, 0x68, 0xb9, 0x30, 0x63, 0xa7, 0xcd, 0x40, 0x5a, 0x7e, 0xec, 0x47, 0x5a, 0x2e, 0x46, 0xc2,
+	0xab, 0xae, 0x05, 0x08, 0x81, 0x5a, 0x5e, 0xf5, 0x41, 0x2d, 0x97, 0x66, 0x9c, 0xe3, 0x10, 0xac,
+	0x65, 0x3b, 0x88, 0xb5, 0x54, 0x66, 0x68, 0x7f, 0x1e, 0xb0, 0x25, 0x25, 0xa6, 0x77, 0x93, 0xd9,
+	0xac, 0x98, 0x63, 0x30, 0xcb, 0x6e, 0x32, 0x9b, 0x17, 0x0b, 0xf2, 0x53, 0xb0, 0xe4, 0x0a, 0xf1,
+	0x0c, 0x36, 0x49, 0x7a, 0xb0, 0x65, 0x19, 0x16, 0x87, 0x4d, 0x58, 0x41, 0xbe, 0x01, 0x05, 0x8f,
+	0x74, 0x3a, 0x30, 0x43, 0x93, 0x4b, 0x9f, 0x41, 0x96, 0xff, 0x20, 0x40, 0xc1, 0x6f, 0x6b, 0x03,
+	0x89, 0x7b, 0x8e, 0x27, 0xee, 0x3e, 0xb8, 0x26, 0x1e, 0x84, 0x6b, 0xd6, 0x20, 0x4f, 0x92, 0xc6,
+	0x31, 0x24, 0x46, 0x33, 0x3d, 0x24, 0xe6, 0x26, 0x2c, 0x51, 0xcf, 0xcf, 0x40, 0x1d, 0xee, 0x5f,
+	0x93, 0xd4, 0xbf, 0x96, 0x49, 0x03, 0x5b, 0x66, 0x5a, 0x8d, 0x9e, 0x85, 0x65, 0x1f, 0xad, 0x97,
+	0x8c, 0x32, 0x58, 0x42, 0xf4, 0xa8, 0xab, 0x3c, 0x2b, 0xfd, 0x93, 0x00, 0x4b, 0x13, 0xb6, 0x3e,
+	0x14, 0x6d, 0x11, 0xbe, 0x27, 0xb4, 0x25, 0xfe, 0xc8, 0x68, 0x8b, 0x3f, 0xb9, 0x4e, 0x04, 0x93,
+	0xeb, 0x7f, 0x0a, 0x50, 0x0c, 0xb8, 0x1c, 0xb2, 0x04, 0x2d, 0xa3, 0x8d, 0x79, 0xba, 0x4b, 0xbf,
+	0x49, 0x6c, 0xd5, 0x35, 0x4e, 0x79, 0x52, 0x4b, 0x3e, 0x09, 0x95, 0xe7, 0x41, 0x73, 0xdc, 0x41,
+	0x7a, 0x99, 0x32, 0x8b, 0x60, 0x58, 0x81, 0xf0, 0x3e, 0xc4, 0xec, 0x36, 0xa1, 0xa0, 0x90, 0x4f,
+	0xb4, 0xc2, 0x37, 0x1f, 0x8f, 0x44, 0x58, 0x01, 0xbd, 0x04, 0x39, 0x7a, 0x6d, 0xa3, 0x1a, 0xa6,
+	0x5d, 0xc9, 0x4e, 0xc6, 0x68, 0xec, 0x6a, 0x67, 0xe3, 0x80, 0xd0, 0xec, 0x9b, 0xb6, 0x92, 0x35,
+	0xf9, 0x97, 0x2f, 0x74, 0xca, 0x05, 0x42, 0xa7, 0xab, 0x90, 0x23, 0xa3, 0xb7, 0x4d, 0xad, 0x85,
+	0x2b, 0x40, 0x07, 0x3a, 0xaa, 0x90, 0x7f, 0x1f, 0x87, 0xb2, 0x3b, 0x73, 0x17, 0xcf, 0x09, 0x9b,
+	0xbb, 0xbb, 0x25, 0xe3, 0x3e, 0x2c, 0x69, 0x3e, 0x7d, 0xac, 0x02, 0x9c, 0x6a, 0xb6, 0xfa, 0xbe,
+	0xd6, 0x77, 0x70, 0x9b, 0x2b, 0xc5, 0x57, 0x83, 0x24, 0xc8, 0x92, 0xd2, 0xc0, 0xc6, 0x6d, 0x0e,
+	0x6b, 0x79, 0x65, 0xd4, 0x80, 0x34, 0x3e, 0xc3, 0x7d, 0xc7, 0xae, 0x64, 0xe8, 0xb2, 0x5f, 0x9c,
+	0xcc, 0xeb, 0x49, 0xf3, 0x56, 0x85, 0x2c, 0xf6, 0xb7, 0x5f, 0xad, 0x89, 0x8c, 0xfa, 0x19, 0xa3,
+	0xa7, 0x3b, 0xb8, 0x67, 0x3a, 0xe7, 0x0a, 0xe7, 0x0f, 0x6a, 0x21, 0x3b, 0xa6, 0x05, 0x0a, 0xb0,
+	0x16, 0x5c, 0x9c, 0x82, 0xe8, 0x54, 0x37, 0x2c, 0xdd, 0x39, 0x57, 0x8a, 0x3d, 0xdc, 0x33, 0x0d,
+	0xa3, 0xab, 0xb2, 0x33, 0xfe, 0x04, 0x88, 0xae, 0xae, 0x3c, 0x9c, 0x2a, 0x44, 0x59, 0xf2, 0x75,
+	0x28, 0x8f, 0x59, 0xaf, 0xc9, 0xb8, 0x5c, 0x3e, 0x00, 0x34, 0x69, 0xa0, 0xd0, 0x0f, 0x20, 0xe7,
+	0x0c, 0x55, 0x0b, 0xdb, 0x83, 0xae, 0x8b, 0x1c, 0x3d, 0x16, 0x02, 0x66, 0xe0, 0x56, 0x73, 0xa8,
+	0x50, 0x22, 0x25, 0xeb, 0xf0, 0x2f, 0xb9, 0x0a, 0x25, 0x57, 0x22, 0x0f, 0xd4, 0xaf, 0x43, 0xd1,
+	0xc2, 0x0e, 0x81, 0x44, 0x03, 0xc9, 0x46, 0x81, 0x55, 0xb2, 0x23, 0xbf, 0x9b, 0xcc, 0x0a, 0x62,
+	0x7c, 0x37, 0x99, 0x8d, 0x8b, 0x09, 0xf9, 0x00, 0x2e, 0x84, 0xc6, 0x2f, 0xe8, 0x45, 0xc8, 0x8d,
+	0x42, 0x1f, 0x61, 0x3d, 0x31, 0x1d, 0xd1, 0x1a, 0xd1, 0xca, 0x7f, 0x14, 0xe0, 0x42, 0x68, 0x04,
+	0x83, 0xea, 0x90, 0xf6, 0xcd, 0xb3, 0x74, 0xeb, 0xd9, 0xf9, 0x22, 0x9f, 0x0d, 0x3e, 0x6f, 0xce,
+	0x2c, 0xbf, 0x0d, 0x69, 0x56, 0x83, 0xf2, 0x90, 0x39, 0xda, 0xbb, 0xb7, 0xb7, 0xff, 0xe6, 0x9e,
+	0x18, 0x43, 0x00, 0xe9, 0x6a, 0xad, 0x56, 0x3f, 0x68, 0x8a, 0x02, 0xca, 0x41, 0xaa, 0xba, 0xb5,
+	0xaf, 0x34, 0xc5, 0x38, 0xa9, 0x56, 0xea, 0xbb, 0xf5, 0x5a, 0x53, 0x4c, 0xa0, 0x25, 0x28, 0xb2,
+	0x6f, 0x75, 0x7b, 0x5f, 0x79, 0xbd, 0xda, 0x14, 0x93, 0xbe, 0xaa, 0xc3, 0xfa, 0xde, 0xdd, 0xba,
+	0x22, 0xa6, 0xe4, 0xff, 0x83, 0xcb, 0xee, 0x38, 0x26, 0x91, 0x37, 0x0f, 0x00, 0x13, 0x7c, 0x00,
+	0x98, 0xfc, 0x59, 0x1c, 0x24, 0x97, 0x27, 0x04, 0x4b, 0xdb, 0x1d, 0x9b, 0xf8, 0xad, 0x05, 0xa2,
+	0xa7, 0xb1, 0xd9, 0x93, 0x7c, 0xd1, 0xc2, 0x27, 0xd8, 0x69, 0x75, 0x58, 0x40, 0xc6, 0x0c, 0x64,
+	0x51, 0x29, 0xf2, 0x5a, 0xca, 0x64, 0x33, 0xb2, 0x77, 0x71, 0xcb, 0x51, 0xd9, 0x1e, 0xb7, 0x69,
+	0xd2, 0x96, 0x53, 0x8a, 0xac, 0xf6, 0x90, 0x55, 0xca, 0xef, 0x2c, 0xa4, 0xcb, 0x1c, 0xa4, 0x94,
+	0x7a, 0x53, 0x79, 0x4b, 0x4c, 0x20, 0x04, 0x25, 0xfa, 0xa9, 0x1e, 0xee, 0x55, 0x0f, 0x0e, 0x1b,
+	0xfb, 0x44, 0x97, 0xcb, 0x50, 0x76, 0x75, 0xe9, 0x56, 0xa6, 0xe4, 0xa7, 0xe1, 0x52, 0x44, 0xf4,
+	0x16, 0x72, 0x44, 0x7e, 0x2b, 0xf8, 0xa9, 0x83, 0x11, 0xd8, 0x3e, 0xa4, 0x6d, 0x47, 0x73, 0x06,
+	0x36, 0x57, 0xe2, 0x8b, 0xf3, 0x86, 0x73, 0x1b, 0xee, 0xc7, 0x21, 0x65, 0x57, 0xb8, 0x18, 0xf9,
+	0x79, 0x28, 0x05, 0x5b, 0xa2, 0x75, 0x30, 0xda, 0x44, 0x71, 0xf9, 0xce, 0xe8, 0x18, 0xfb, 0xe0,
+	0x9a, 0xc9, 0x34, 0x5e, 0x08, 0x4b, 0xe3, 0x7f, 0x27, 0xc0, 0x95, 0x29, 0x11, 0x1d, 0x7a, 0x63,
+	0x6c, 0x92, 0x2f, 0x2f, 0x12, 0x0f, 0x6e, 0xb0, 0xba, 0xb1, 0x69, 0xde, 0x86, 0x82, 0xbf, 0x7e,
+	0xbe, 0x49, 0x7e, 0x1b, 0x87, 0x0b, 0xa1, 0xc1, 0xa1, 0xcf, 0x42, 0x0b, 0xdf, 0xd1, 0x42, 0xbf,
+	0x02, 0xe0, 0x59, 0x3e, 0xd7, 0xcd, 0xcf, 0x30, 0x7d, 0x39, 0xd7, 0xf4, 0x11, 0x9c, 0xca, 0x07,
+	0xbe, 0x0c, 0x68, 0x08, 0x60, 0x57, 0x12, 0x0b, 0xc5, 0x0a, 0xe2, 0x59, 0xb0, 0xda, 0x46, 0x6f,
+	0xc1, 0xa5, 0xb1, 0x38, 0xc6, 0x13, 0x9d, 0x9c, 0x37, 0x9c, 0xb9, 0x10, 0x0c, 0x67, 0x5c, 0xd1,
+	0xfe, 0x60, 0x24, 0x15, 0x0c, 0x46, 0xde, 0x02, 0x18, 0x81, 0x30, 0xc4, 0xc2, 0x58, 0xc6, 0xa0,
+	0xdf, 0xa6, 0x3b, 0x20, 0xa5, 0xb0, 0x02, 0x79, 0x9e, 0x40, 0x76, 0x92, 0xab, 0xa7, 0x49, 0x53,
+	0x4c, 0x76, 0x82, 0x0f, 0xc4, 0x61, 0xd4, 0xb2, 0x0e, 0x68, 0x12, 0x08, 0x8f, 0xe8, 0xe2, 0xd5,
+	0x60, 0x17, 0xd7, 0x22, 0x21, 0xf5, 0xf0, 0xae, 0x3e, 0x80, 0x14, 0x5d, 0x79, 0xe2, 0x20, 0xe9,
+	0x6d, 0x10, 0x0f, 0x66, 0xc9, 0x37, 0xfa, 0x39, 0x80, 0xe6, 0x38, 0x96, 0x7e, 0x3c, 0x18, 0x75,
+	0xb0, 0x16, 0xbe, 0x73, 0xaa, 0x2e, 0xdd, 0xd6, 0x55, 0xbe, 0x85, 0x56, 0x46, 0xac, 0xbe, 0x6d,
+	0xe4, 0x13, 0x28, 0xef, 0x41, 0x29, 0xc8, 0xeb, 0x86, 0x5f, 0x6c, 0x0c, 0xc1, 0xf0, 0x8b, 0x45,
+	0xd3, 0xac, 0x30, 0x0a, 0xde, 0x12, 0xec, 0xca, 0x8b, 0x16, 0xe4, 0x5f, 0xc4, 0xa1, 0xe0, 0xdf,
+	0x78, 0xff, 0x7d, 0x11, 0x92, 0xfc, 0x91, 0x00, 0x59, 0x6f, 0xfa, 0xc1, 0xfb, 0xa6, 0xc0, 0x85,
+	0x21, 0xd3, 0x5e, 0xdc, 0x7f, 0x49, 0xc4, 0x2e, 0xd7, 0x12, 0xde, 0xf5, 0xe0, 0x1d, 0xcf, 0xfd,
+	0x25, 0xe7, 0x88, 0x6f, 0xf8, 0xae, 0x72, 0xbd, 0xfd, 0x1d, 0xc8, 0x79, 0xa7, 0x97, 0xe4, 0x44,
+	0x2e, 0x40, 0x27, 0xf0, 0x33, 0xc4, 0x8a, 0x64, 0x24, 0xa6, 0xf1, 0x3e, 0xbf, 0x81, 0x4a, 0x28,
+	0xac, 0x20, 0xb7, 0xa1, 0x3c, 0x76, 0xf4, 0xd1, 0x1d, 0xc8, 0x98, 0x83, 0x63, 0xd5, 0xdd, 0x1c,
+	0x63, 0x30, 0xa6, 0x1b, 0x6d, 0x0f, 0x8e, 0xbb, 0x7a, 0xeb, 0x1e, 0x3e, 0x77, 0x07, 0x63, 0x0e,
+	0x8e, 0xef, 0xb1, 0x3d, 0xc4, 0x7a, 0x89, 0xfb, 0x7b, 0xf9, 0x54, 0x80, 0xac, 0x7b, 0x26, 0xd0,
+	0x0f, 0x21, 0xe7, 0x99, 0x15, 0xef, 0x4a, 0x3b, 0xd2, 0x1e, 0x71, 0xf9, 0x23, 0x16, 0x54, 0x75,
+	0xef, 0xe2, 0xf5, 0xb6, 0x7a, 0xd2, 0xd5, 0xd8, 0x5e, 0x2a, 0x05, 0x75, 0xc6, 0x0c, 0x0f, 0xb5,
+	0xc7, 0x3b, 0x77, 0xb7, 0xbb, 0xda, 0xa9, 0x92, 0xa7, 0x3c, 0x3b, 0x6d, 0x52, 0xe0, 0x91, 0xdd,
+	0x3f, 0x04, 0x10, 0xc7, 0x4f, 0xec, 0x77, 0x1e, 0xdd, 0xa4, 0x9b, 0x4b, 0x84, 0xb8, 0x39, 0xb4,
+	0x09, 0xcb, 0x1e, 0x85, 0x6a, 0xeb, 0xa7, 0x7d, 0xcd, 0x19, 0x58, 0x98, 0x03, 0xbf, 0xc8, 0x6b,
+	0x3a, 0x74, 0x5b, 0x26, 0x67, 0x9d, 0x7a, 0xc4, 0x59, 0x7f, 0x18, 0x87, 0xbc, 0x0f, 0x86, 0x46,
+	0xff, 0xef, 0x33, 0x46, 0xa5, 0x10, 0xcf, 0xe0, 0xa3, 0x1d, 0x5d, 0x4f, 0x07, 0xd5, 0x14, 0x5f,
+	0x5c, 0x4d, 0x51, 0x60, 0xbf, 0x8b, 0x6a, 0x27, 0x17, 0x46, 0xb5, 0x9f, 0x01, 0xe4, 0x18, 0x8e,
+	0xd6, 0x25, 0xb0, 0x91, 0xde, 0x3f, 0x55, 0xd9, 0x36, 0x64, 0xa6, 0x43, 0xa4, 0x2d, 0x0f, 0x68,
+	0xc3, 0x01, 0xdd, 0x91, 0xbf, 0x14, 0x20, 0xeb, 0x85, 0xdd, 0x8b, 0x5e, 0x16, 0x5f, 0x84, 0x34,
+	0x8f, 0x2c, 0xd9, 0x6d, 0x31, 0x2f, 0x85, 0xc2, 0xf7, 0x12, 0x64, 0x7b, 0xd8, 0xd1, 0xa8, 0x1d,
+	0x64, 0x5e, 0xcd, 0x2b, 0xdf, 0x7c, 0x19, 0xf2, 0xbe, 0x8b, 0x7f, 0x62, 0x1a, 0xf7, 0xea, 0x6f,
+	0x8a, 0x31, 0x29, 0xf3, 0xf1, 0xe7, 0xeb, 0x89, 0x3d, 0xfc, 0x3e, 0x39, 0xcd, 0x4a, 0xbd, 0xd6,
+	0xa8, 0xd7, 0xee, 0x89, 0x82, 0x94, 0xff, 0xf8, 0xf3, 0xf5, 0x8c, 0x82, 0x29, 0x72, 0x7b, 0xf3,
+	0x1e, 0x94, 0xc7, 0x16, 0x26, 0x18, 0xb6, 0x20, 0x28, 0xdd, 0x3d, 0x3a, 0xb8, 0xbf, 0x53, 0xab,
+	0x36, 0xeb, 0xea, 0x83, 0xfd, 0x66, 0x5d, 0x14, 0xd0, 0x25, 0x58, 0xbe, 0xbf, 0xf3, 0x5a, 0xa3,
+	0xa9, 0xd6, 0xee, 0xef, 0xd4, 0xf7, 0x9a, 0x6a, 0xb5, 0xd9, 0xac, 0xd6, 0xee, 0x89, 0xf1, 0x5b,
+	0x9f, 0x16, 0x21, 0x59, 0xdd, 0xaa, 0xed, 0xa0, 0x1a, 0x24, 0x29, 0x52, 0x33, 0xf5, 0xfd, 0xa2,
+	0x34, 0x1d, 0x83, 0x47, 0xdb, 0x90, 0xa2, 0x20, 0x0e, 0x9a, 0xfe, 0xa0, 0x51, 0x9a, 0x01, 0xca,
+	0x93, 0xc1, 0xd0, 0x13, 0x39, 0xf5, 0x85, 0xa3, 0x34, 0x1d, 0xa3, 0x47, 0xf7, 0x21, 0xe3, 0xe6,
+	0xf0, 0xb3, 0x9e, 0x1d, 0x4a, 0x33, 0x81, 0x73, 0xb4, 0x0f, 0x59, 0x2f, 0xcb, 0x9d, 0xf9, 0x92,
+	0x4a, 0x9a, 0x8d, 0x00, 0x92, 0xe1, 0xb9, 0xe9, 0xf0, 0xac, 0x97, 0x55, 0xd2, 0x4c, 0x3c, 0x10,
+	0x1d, 0x01, 0xf8, 0xf2, 0xe6, 0x39, 0x5e, 0x5a, 0x49, 0xf3, 0x20, 0x84, 0x64, 0x41, 0x19, 0x02,
+	0x34, 0xfd, 0xc9, 0xa7, 0x34, 0xe3, 0xce, 0x02, 0xed, 0x40, 0x9a, 0x27, 0xe1, 0x33, 0x5e, 0x71,
+	0x4a, 0xb3, 0x6e, 0x21, 0x90, 0x02, 0xb9, 0x11, 0xb6, 0x36, 0xfb, 0x21, 0xab, 0x34, 0xc7, 0x75,
+	0x0c, 0x7a, 0x1b, 0x8a, 0xc1, 0x04, 0x7f, 0xbe, 0x97, 0xa2, 0xd2, 0x9c, 0xf7, 0x1d, 0x44, 0x7e,
+	0x30, 0xdb, 0x9f, 0xef, 0xe5, 0xa8, 0x34, 0xe7, 0xf5, 0x07, 0x7a, 0x17, 0x96, 0x26, 0xb3, 0xf1,
+	0xf9, 0x1f, 0x92, 0x4a, 0x0b, 0x5c, 0x88, 0xa0, 0x1e, 0xa0, 0x90, 0x2c, 0x7e, 0x81, 0x77, 0xa5,
+	0xd2, 0x22, 0xf7, 0x23, 0xa8, 0x0d, 0xe5, 0xf1, 0xd4, 0x78, 0xde, 0x77, 0xa6, 0xd2, 0xdc, 0x77,
+	0x25, 0xac, 0x97, 0x60, 0x4a, 0x3d, 0xef, 0xbb, 0x53, 0x69, 0xee, 0xab, 0x13, 0x72, 0x48, 0x7d,
+	0x59, 0xf1, 0x1c, 0xef, 0x50, 0xa5, 0x79, 0x2e, 0x51, 0x90, 0x09, 0xcb, 0x61, 0xe9, 0xf2, 0x22,
+	0xcf, 0x52, 0xa5, 0x85, 0xee, 0x56, 0xc8, 0x7e, 0x0e, 0x26, 0xbe, 0xf3, 0x3d, 0x53, 0x95, 0xe6,
+	0xbc, 0x64, 0xd9, 0xaa, 0x7e, 0xf1, 0xf5, 0xaa, 0xf0, 0xe5, 0xd7, 0xab, 0xc2, 0xdf, 0xbf, 0x5e,
+	0x15, 0x3e, 0xf9, 0x66, 0x35, 0xf6, 0xe5, 0x37, 0xab, 0xb1, 0xbf, 0x7c, 0xb3, 0x1a, 0xfb, 0xc9,
+	0x93, 0xa7, 0xba, 0xd3, 0x19, 0x1c, 0x6f, 0xb4, 0x8c, 0xde, 0x66, 0xcb, 0xe8, 0x61, 0xe7, 0xf8,
+	0xc4, 0x19, 0x7d, 0x8c, 0xfe, 0x36, 0x38, 0x4e, 0xd3, 0xb8, 0xe1, 0xf6, 0xbf, 0x06, 0x00, 0x90,
+	0x72, 0x9e, 0x5b, 0x8d, 0x30, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4133,6 +4253,7 @@ type ABCIClient interface {
 	CheckTx(ctx context.Context, in *RequestCheckTx, opts ...grpc.CallOption) (*ResponseCheckTx, error)
 	InsertTx(ctx context.Context, in *RequestInsertTx, opts ...grpc.CallOption) (*ResponseInsertTx, error)
 	ReapTxs(ctx context.Context, in *RequestReapTxs, opts ...grpc.CallOption) (*ResponseReapTxs, error)
+	SimulateTx(ctx context.Context, in *RequestSimulateTx, opts ...grpc.CallOption) (*ResponseSimulateTx, error)
 	Query(ctx context.Context, in *RequestQuery, opts ...grpc.CallOption) (*ResponseQuery, error)
 	Commit(ctx context.Context, in *RequestCommit, opts ...grpc.CallOption) (*ResponseCommit, error)
 	InitChain(ctx context.Context, in *RequestInitChain, opts ...grpc.CallOption) (*ResponseInitChain, error)
@@ -4209,6 +4330,15 @@ func (c *aBCIClient) ReapTxs(ctx context.Context, in *RequestReapTxs, opts ...gr
 	return out, nil
 }
 
+func (c *aBCIClient) SimulateTx(ctx context.Context, in *RequestSimulateTx, opts ...grpc.CallOption) (*ResponseSimulateTx, error) {
+	out := new(ResponseSimulateTx)
+	err := c.cc.Invoke(ctx, "/tendermint.abci.ABCI/SimulateTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aBCIClient) Query(ctx context.Context, in *RequestQuery, opts ...grpc.CallOption) (*ResponseQuery, error) {
 	out := new(ResponseQuery)
 	err := c.cc.Invoke(ctx, "/tendermint.abci.ABCI/Query", in, out, opts...)
@@ -4325,6 +4455,7 @@ type ABCIServer interface {
 	CheckTx(context.Context, *RequestCheckTx) (*ResponseCheckTx, error)
 	InsertTx(context.Context, *RequestInsertTx) (*ResponseInsertTx, error)
 	ReapTxs(context.Context, *RequestReapTxs) (*ResponseReapTxs, error)
+	SimulateTx(context.Context, *RequestSimulateTx) (*ResponseSimulateTx, error)
 	Query(context.Context, *RequestQuery) (*ResponseQuery, error)
 	Commit(context.Context, *RequestCommit) (*ResponseCommit, error)
 	InitChain(context.Context, *RequestInitChain) (*ResponseInitChain, error)
@@ -4361,6 +4492,9 @@ func (*UnimplementedABCIServer) InsertTx(ctx context.Context, req *RequestInsert
 func (*UnimplementedABCIServer) ReapTxs(ctx context.Context, req *RequestReapTxs) (*ResponseReapTxs, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReapTxs not implemented")
 }
+func (*UnimplementedABCIServer) SimulateTx(ctx context.Context, req *RequestSimulateTx) (*ResponseSimulateTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTx not implemented")
+}
 func (*UnimplementedABCIServer) Query(ctx context.Context, req *RequestQuery) (*ResponseQuery, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
@@ -4510,6 +4644,24 @@ func _ABCI_ReapTxs_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ABCI_SimulateTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestSimulateTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ABCIServer).SimulateTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.abci.ABCI/SimulateTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ABCIServer).SimulateTx(ctx, req.(*RequestSimulateTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ABCI_Query_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RequestQuery)
 	if err := dec(in); err != nil {
@@ -4755,6 +4907,10 @@ var _ABCI_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReapTxs",
 			Handler:    _ABCI_ReapTxs_Handler,
 		},
+		{
+			MethodName: "SimulateTx",
+			Handler:    _ABCI_SimulateTx_Handler,
+		},
 		{
 			MethodName: "Query",
 			Handler:    _ABCI_Query_Handler,
@@ -5232,6 +5388,29 @@ func (m *Request_ReapTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Request_SimulateTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Request_SimulateTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SimulateTx != nil {
+		{
+			size, err := m.SimulateTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	return len(dAtA) - i, nil
+}
 func (m *RequestEcho) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5397,12 +5576,12 @@ func (m *RequestInitChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err20 != nil {
-		return 0, err20
+	n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err21 != nil {
+		return 0, err21
 	}
-	i -= n20
-	i = encodeVarintTypes(dAtA, i, uint64(n20))
+	i -= n21
+	i = encodeVarintTypes(dAtA, i, uint64(n21))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -5558,6 +5737,36 @@ func (m *RequestReapTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RequestSimulateTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestSimulateTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequestSimulateTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tx) > 0 {
+		i -= len(m.Tx)
+		copy(dAtA[i:], m.Tx)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Tx)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RequestCommit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5760,12 +5969,12 @@ func (m *RequestPrepareProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x3a
 	}
-	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err22 != nil {
-		return 0, err22
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err23 != nil {
+		return 0, err23
 	}
-	i -= n22
-	i = encodeVarintTypes(dAtA, i, uint64(n22))
+	i -= n23
+	i = encodeVarintTypes(dAtA, i, uint64(n23))
 	i--
 	dAtA[i] = 0x32
 	if m.Height != 0 {
@@ -5848,12 +6057,12 @@ func (m *RequestProcessProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x3a
 	}
-	n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err24 != nil {
-		return 0, err24
+	n25, err25 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err25 != nil {
+		return 0, err25
 	}
-	i -= n24
-	i = encodeVarintTypes(dAtA, i, uint64(n24))
+	i -= n25
+	i = encodeVarintTypes(dAtA, i, uint64(n25))
 	i--
 	dAtA[i] = 0x32
 	if m.Height != 0 {
@@ -5971,12 +6180,12 @@ func (m *RequestExtendVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x22
 		}
 	}
-	n27, err27 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err27 != nil {
-		return 0, err27
+	n28, err28 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err28 != nil {
+		return 0, err28
 	}
-	i -= n27
-	i = encodeVarintTypes(dAtA, i, uint64(n27))
+	i -= n28
+	i = encodeVarintTypes(dAtA, i, uint64(n28))
 	i--
 	dAtA[i] = 0x1a
 	if m.Height != 0 {
@@ -6077,12 +6286,12 @@ func (m *RequestFinalizeBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	n28, err28 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err28 != nil {
-		return 0, err28
+	n29, err29 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err29 != nil {
+		return 0, err29
 	}
-	i -= n28
-	i = encodeVarintTypes(dAtA, i, uint64(n28))
+	i -= n29
+	i = encodeVarintTypes(dAtA, i, uint64(n29))
 	i--
 	dAtA[i] = 0x32
 	if m.Height != 0 {
@@ -6580,6 +6789,29 @@ func (m *Response_ReapTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *Response_SimulateTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Response_SimulateTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SimulateTx != nil {
+		{
+			size, err := m.SimulateTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ResponseException) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6998,6 +7230,41 @@ func (m *ResponseReapTxs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResponseSimulateTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResponseSimulateTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResponseSimulateTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxResult != nil {
+		{
+			size, err := m.TxResult.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ResponseCommit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7151,20 +7418,20 @@ func (m *ResponseApplySnapshotChunk) MarshalToSizedBuffer(dAtA []byte) (int, err
 		}
 	}
 	if len(m.RefetchChunks) > 0 {
-		dAtA52 := make([]byte, len(m.RefetchChunks)*10)
-		var j51 int
+		dAtA55 := make([]byte, len(m.RefetchChunks)*10)
+		var j54 int
 		for _, num := range m.RefetchChunks {
 			for num >= 1<<7 {
-				dAtA52[j51] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA55[j54] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j51++
+				j54++
 			}
-			dAtA52[j51] = uint8(num)
-			j51++
+			dAtA55[j54] = uint8(num)
+			j54++
 		}
-		i -= j51
-		copy(dAtA[i:], dAtA52[:j51])
-		i = encodeVarintTypes(dAtA, i, uint64(j51))
+		i -= j54
+		copy(dAtA[i:], dAtA55[:j54])
+		i = encodeVarintTypes(dAtA, i, uint64(j54))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -7871,12 +8138,12 @@ func (m *Misbehavior) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n58, err58 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err58 != nil {
-		return 0, err58
+	n61, err61 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err61 != nil {
+		return 0, err61
 	}
-	i -= n58
-	i = encodeVarintTypes(dAtA, i, uint64(n58))
+	i -= n61
+	i = encodeVarintTypes(dAtA, i, uint64(n61))
 	i--
 	dAtA[i] = 0x22
 	if m.Height != 0 {
@@ -8187,8 +8454,20 @@ func (m *Request_ReapTxs) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.ReapTxs != nil {
-		l = m.ReapTxs.Size()
+	if m.ReapTxs != nil {
+		l = m.ReapTxs.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Request_SimulateTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SimulateTx != nil {
+		l = m.SimulateTx.Size()
 		n += 2 + l + sovTypes(uint64(l))
 	}
 	return n
@@ -8337,6 +8616,19 @@ func (m *RequestReapTxs) Size() (n int) {
 	return n
 }
 
+func (m *RequestSimulateTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tx)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *RequestCommit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8833,6 +9125,18 @@ func (m *Response_ReapTxs) Size() (n int) {
 	}
 	return n
 }
+func (m *Response_SimulateTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SimulateTx != nil {
+		l = m.SimulateTx.Size()
+		n += 2 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 func (m *ResponseException) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9027,6 +9331,19 @@ func (m *ResponseReapTxs) Size() (n int) {
 	return n
 }
 
+func (m *ResponseSimulateTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxResult != nil {
+		l = m.TxResult.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *ResponseCommit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10106,6 +10423,41 @@ func (m *Request) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &Request_ReapTxs{v}
 			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SimulateTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RequestSimulateTx{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Request_SimulateTx{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -11079,6 +11431,90 @@ func (m *RequestReapTxs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RequestSimulateTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestSimulateTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestSimulateTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tx = append(m.Tx[:0], dAtA[iNdEx:postIndex]...)
+			if m.Tx == nil {
+				m.Tx = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RequestCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13603,6 +14039,41 @@ func (m *Response) Unmarshal(dAtA []byte) error {
 			}
 			m.Value = &Response_ReapTxs{v}
 			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SimulateTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResponseSimulateTx{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Response_SimulateTx{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -14907,6 +15378,92 @@ func (m *ResponseReapTxs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ResponseSimulateTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResponseSimulateTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResponseSimulateTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxResult == nil {
+				m.TxResult = &ExecTxResult{}
+			}
+			if err := m.TxResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ResponseCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
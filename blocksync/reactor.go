@@ -628,7 +628,7 @@ FOR_LOOP:
 			}
 
 			// Fully verify second.LastCommit to ensure all signatures are valid.
-			err = state.Validators.VerifyCommit(chainID, firstID, first.Height, second.LastCommit)
+			err = state.Validators.VerifyCommitWithCache(chainID, firstID, first.Height, second.LastCommit, r.blockExec.SignatureCache())
 			if err != nil {
 				r.handleValidationFailure(first, second, err)
 				continue FOR_LOOP
@@ -645,7 +645,7 @@ FOR_LOOP:
 				// if vote extensions were required at this height, verify all
 				// signatures in the extended commit since it is persisted to
 				// the store.
-				if err = state.Validators.VerifyCommit(chainID, firstID, first.Height, extCommit.ToCommit()); err != nil {
+				if err = state.Validators.VerifyCommitWithCache(chainID, firstID, first.Height, extCommit.ToCommit(), r.blockExec.SignatureCache()); err != nil {
 					r.handleValidationFailure(first, second, err)
 					continue FOR_LOOP
 				}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"errors"
+	"fmt"
 	"path/filepath"
 	"sync"
 
@@ -10,7 +11,10 @@ import (
 	"github.com/syndtr/goleveldb/leveldb/opt"
 	"github.com/syndtr/goleveldb/leveldb/util"
 
+	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 )
 
 var CompactGoLevelDBCmd = &cobra.Command{
@@ -23,7 +27,8 @@ and blockstores to reduce disk space for a pruning node. This should only be run
 once the node has stopped. This command will likely be omitted in the future after
 the planned refactor to the storage engine.
 
-Currently, only GoLevelDB is supported.
+Currently, only GoLevelDB is supported. To compact the databases of a running
+node, use compact-dbs instead.
 	`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if config.DBBackend != "goleveldb" {
@@ -35,6 +40,50 @@ Currently, only GoLevelDB is supported.
 	},
 }
 
+// CompactDBsCmd asks a running node to compact its databases.
+var CompactDBsCmd = &cobra.Command{
+	Use:   "compact-dbs [db...]",
+	Short: "compact the databases of a running node",
+	Long: `
+compact-dbs asks a running node to compact the given databases (e.g. blockstore,
+state, tx_index, evidence), or all of them, in the background. Compaction
+reclaims the disk space of the pruned data. The node must serve the unsafe RPC
+routes (see rpc.unsafe); the progress is reported in its logs, and its
+storage_stats RPC endpoint reports whether a compaction is in progress.
+	`,
+	Example: `
+	cometbft compact-dbs
+	cometbft compact-dbs blockstore state --rpc-laddr tcp://127.0.0.1:26657
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		addr := compactRPCAddr
+		if addr == "" {
+			addr = config.RPC.ListenAddress
+		}
+		client, err := rpcclient.New(addr)
+		if err != nil {
+			return fmt.Errorf("creating RPC client: %w", err)
+		}
+		result := new(ctypes.ResultCompactDBs)
+		if _, err := client.Call(cmd.Context(), "compact_dbs", map[string]any{"dbs": args}, result); err != nil {
+			return fmt.Errorf("requesting compaction: %w", err)
+		}
+		bz, err := cmtjson.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(bz))
+		return nil
+	},
+}
+
+var compactRPCAddr string
+
+func init() {
+	CompactDBsCmd.Flags().StringVar(&compactRPCAddr, "rpc-laddr", "",
+		"the RPC address of the node (defaults to rpc.laddr of the config)")
+}
+
 func compactGoLevelDBs(rootDir string, logger log.Logger) {
 	dbNames := []string{"state", "blockstore"}
 	o := &opt.Options{
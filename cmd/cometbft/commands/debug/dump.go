@@ -106,6 +106,12 @@ func dumpDebugData(outDir string, conf *cfg.Config, rpc *rpchttp.HTTP) {
 		return
 	}
 
+	logger.Info("getting node consensus trace...")
+	if err := dumpConsensusTrace(rpc, tmpDir, "consensus_trace.json"); err != nil {
+		// best-effort, as the node may not serve the trace
+		logger.Error("failed to dump node consensus trace", "error", err)
+	}
+
 	logger.Info("copying node WAL...")
 	if err := copyWAL(conf, tmpDir); err != nil {
 		logger.Error("failed to copy node WAL", "error", err)
@@ -76,6 +76,13 @@ func killCmdHandler(_ *cobra.Command, args []string) error {
 		return err
 	}
 
+	// The trace is best-effort, as the node may not serve it (e.g. when
+	// consensus.trace_heights is 0).
+	logger.Info("getting node consensus trace...")
+	if err := dumpConsensusTrace(rpc, tmpDir, "consensus_trace.json"); err != nil {
+		logger.Error("failed to dump node consensus trace", "error", err)
+	}
+
 	logger.Info("copying node WAL...")
 	if err := copyWAL(conf, tmpDir); err != nil {
 		return err
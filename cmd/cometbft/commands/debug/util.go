@@ -46,6 +46,18 @@ func dumpConsensusState(rpc *rpchttp.HTTP, dir, filename string) error {
 	return writeStateJSONToFile(consDump, dir, filename)
 }
 
+// dumpConsensusTrace gets the consensus timeline of the height currently
+// being decided from the CometBFT RPC and writes it to file. It returns an
+// error upon failure.
+func dumpConsensusTrace(rpc *rpchttp.HTTP, dir, filename string) error {
+	trace, err := rpc.ConsensusTrace(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("failed to get node consensus trace: %w", err)
+	}
+
+	return writeStateJSONToFile(trace, dir, filename)
+}
+
 // copyWAL copies the CometBFT node's WAL file. It returns an error if the
 // WAL file cannot be read or copied.
 func copyWAL(conf *cfg.Config, dir string) error {
@@ -5,8 +5,10 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/cometbft/cometbft/internal/keytypes"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/privval"
+	"github.com/cometbft/cometbft/types"
 )
 
 // GenValidatorCmd allows the generation of a keypair for a
@@ -15,15 +17,24 @@ var GenValidatorCmd = &cobra.Command{
 	Use:     "gen-validator",
 	Aliases: []string{"gen_validator"},
 	Short:   "Generate new validator keypair",
-	Run:     genValidator,
+	RunE:    genValidator,
 }
 
-func genValidator(*cobra.Command, []string) {
-	pv := privval.GenFilePV("", "")
+func init() {
+	GenValidatorCmd.Flags().StringVarP(&keyType, "key-type", "k", types.ABCIPubKeyTypeEd25519,
+		fmt.Sprintf("private validator key type (one of %s)", keytypes.SupportedKeyTypesStr()))
+}
+
+func genValidator(*cobra.Command, []string) error {
+	pv, err := privval.GenFilePVWithKeyType("", "", keyType)
+	if err != nil {
+		return fmt.Errorf("can't generate private validator: %w", err)
+	}
 	jsbz, err := cmtjson.Marshal(pv)
 	if err != nil {
-		panic(err)
+		return err
 	}
 	fmt.Printf(`%v
 `, string(jsbz))
+	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/cobra"
 
 	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/keytypes"
 	cmtos "github.com/cometbft/cometbft/libs/os"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	"github.com/cometbft/cometbft/p2p"
@@ -21,6 +22,15 @@ var InitFilesCmd = &cobra.Command{
 	RunE:  initFiles,
 }
 
+// keyType is the type of the private validator key generated by init,
+// testnet and gen-validator.
+var keyType string
+
+func init() {
+	InitFilesCmd.Flags().StringVarP(&keyType, "key-type", "k", types.ABCIPubKeyTypeEd25519,
+		fmt.Sprintf("private validator key type (one of %s)", keytypes.SupportedKeyTypesStr()))
+}
+
 func initFiles(*cobra.Command, []string) error {
 	return initFilesWithConfig(config)
 }
@@ -35,7 +45,11 @@ func initFilesWithConfig(config *cfg.Config) error {
 		logger.Info("Found private validator", "keyFile", privValKeyFile,
 			"stateFile", privValStateFile)
 	} else {
-		pv = privval.GenFilePV(privValKeyFile, privValStateFile)
+		var err error
+		pv, err = privval.GenFilePVWithKeyType(privValKeyFile, privValStateFile, keyType)
+		if err != nil {
+			return fmt.Errorf("can't generate private validator: %w", err)
+		}
 		pv.Save()
 		logger.Info("Generated private validator", "keyFile", privValKeyFile,
 			"stateFile", privValStateFile)
@@ -65,6 +79,7 @@ func initFilesWithConfig(config *cfg.Config) error {
 		if err != nil {
 			return fmt.Errorf("can't get pubkey: %w", err)
 		}
+		genDoc.ConsensusParams.Validator.PubKeyTypes = []string{pubKey.Type()}
 		genDoc.Validators = []types.GenesisValidator{{
 			Address: pubKey.Address(),
 			PubKey:  pubKey,
@@ -43,6 +43,11 @@ reindex from the base block height(inclusive); and the default end-height is 0,
 the tooling will reindex until the latest block height(inclusive). User can omit
 either or both arguments.
 
+Events are indexed according to the include_events and exclude_events rules of
+the tx_index section of the config. With the kv indexer, the data indexed
+earlier at each re-indexed height is deleted first, so re-indexing after
+changing the rules rebuilds a smaller index.
+
 Note: This operation requires ABCI Responses. Do not set DiscardABCIResponses to true if you
 want to use this command.
 	`,
@@ -83,6 +88,7 @@ want to use this command.
 			txIndexer:    ti,
 			blockStore:   bs,
 			stateStore:   ss,
+			eventFilter:  txindex.NewEventFilter(config.TxIndex.IncludeEvents, config.TxIndex.ExcludeEvents),
 		}
 		if err := eventReIndex(cmd, riArgs); err != nil {
 			panic(fmt.Errorf("%s: %w", reindexFailed, err))
@@ -137,6 +143,19 @@ type eventReIndexArgs struct {
 	txIndexer    txindex.TxIndexer
 	blockStore   state.BlockStore
 	stateStore   state.Store
+	eventFilter  *txindex.EventFilter
+}
+
+// heightDeleter is implemented by the tx indexers able to delete the
+// transactions indexed at a height before it is re-indexed.
+type heightDeleter interface {
+	DeleteHeight(height int64) error
+}
+
+// eventsDeleter is implemented by the block indexers able to delete the events
+// indexed at a height before it is re-indexed.
+type eventsDeleter interface {
+	DeleteEvents(height int64, events []abcitypes.Event) error
 }
 
 func eventReIndex(cmd *cobra.Command, args eventReIndexArgs) error {
@@ -160,9 +179,20 @@ func eventReIndex(cmd *cobra.Command, args eventReIndexArgs) error {
 				return fmt.Errorf("not able to load ABCI Response at height %d from the statestore", height)
 			}
 
+			if d, ok := args.txIndexer.(heightDeleter); ok {
+				if err := d.DeleteHeight(height); err != nil {
+					return fmt.Errorf("deleting the txs indexed at height %d failed: %w", height, err)
+				}
+			}
+			if d, ok := args.blockIndexer.(eventsDeleter); ok {
+				if err := d.DeleteEvents(height, resp.Events); err != nil {
+					return fmt.Errorf("deleting the block events indexed at height %d failed: %w", height, err)
+				}
+			}
+
 			e := types.EventDataNewBlockEvents{
 				Height: height,
-				Events: resp.Events,
+				Events: args.eventFilter.Apply(resp.Events),
 			}
 
 			numTxs := len(resp.TxResults)
@@ -179,7 +209,7 @@ func eventReIndex(cmd *cobra.Command, args eventReIndexArgs) error {
 						Result: *txResult,
 					}
 
-					if err = batch.Add(&tr); err != nil {
+					if err = batch.Add(args.eventFilter.ApplyTxResult(&tr)); err != nil {
 						return fmt.Errorf("adding tx to batch: %w", err)
 					}
 				}
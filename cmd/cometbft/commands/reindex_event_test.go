@@ -14,8 +14,12 @@ import (
 	abcitypes "github.com/cometbft/cometbft/abci/types"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/internal/test"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	blockmocks "github.com/cometbft/cometbft/state/indexer/mocks"
 	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/state/txindex/kv"
 	txmocks "github.com/cometbft/cometbft/state/txindex/mocks"
 	"github.com/cometbft/cometbft/types"
 )
@@ -189,3 +193,55 @@ func TestReIndexEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestReIndexEventRules(t *testing.T) {
+	mockBlockStore := &mocks.BlockStore{}
+	mockStateStore := &mocks.Store{}
+	mockBlockStore.
+		On("LoadBlock", base).Return(&types.Block{Data: types.Data{Txs: types.Txs{types.Tx("foo")}}})
+
+	attrs := []abcitypes.EventAttribute{
+		{Key: "sender", Value: "bob", Index: true},
+		{Key: "memo", Value: "hello", Index: true},
+	}
+	mockStateStore.
+		On("LoadFinalizeBlockResponse", base).Return(&abcitypes.ResponseFinalizeBlock{
+		Events:    []abcitypes.Event{{Type: "transfer", Attributes: attrs}},
+		TxResults: []*abcitypes.ExecTxResult{{Events: []abcitypes.Event{{Type: "transfer", Attributes: attrs}}}},
+	}, nil)
+
+	store := dbm.NewMemDB()
+	txIndexer := kv.NewTxIndex(store)
+	blockIndexer := blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events")))
+	args := eventReIndexArgs{
+		startHeight:  base,
+		endHeight:    base,
+		blockIndexer: blockIndexer,
+		txIndexer:    txIndexer,
+		blockStore:   mockBlockStore,
+		stateStore:   mockStateStore,
+	}
+
+	search := func(q string) (int, int) {
+		txs, err := txIndexer.Search(context.Background(), query.MustCompile(q))
+		require.NoError(t, err)
+		heights, err := blockIndexer.Search(context.Background(), query.MustCompile(q))
+		require.NoError(t, err)
+		return len(txs), len(heights)
+	}
+
+	require.NoError(t, eventReIndex(setupReIndexEventCmd(), args))
+	txs, blocks := search("transfer.memo = 'hello'")
+	require.Equal(t, 1, txs)
+	require.Equal(t, 1, blocks)
+
+	// Re-indexing with new rules drops the excluded attributes.
+	args.eventFilter = txindex.NewEventFilter(nil, []string{"transfer.memo"})
+	require.NoError(t, eventReIndex(setupReIndexEventCmd(), args))
+	txs, blocks = search("transfer.memo = 'hello'")
+	require.Zero(t, txs)
+	require.Zero(t, blocks)
+	txs, blocks = search("transfer.sender = 'bob'")
+	require.Equal(t, 1, txs)
+	require.Equal(t, 1, blocks)
+}
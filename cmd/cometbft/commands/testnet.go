@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/viper"
 
 	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/internal/keytypes"
 	"github.com/cometbft/cometbft/libs/bytes"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	"github.com/cometbft/cometbft/p2p"
@@ -74,6 +75,8 @@ func init() {
 		"P2P Port")
 	TestnetFilesCmd.Flags().BoolVar(&randomMonikers, "random-monikers", false,
 		"randomize the moniker for each generated node")
+	TestnetFilesCmd.Flags().StringVarP(&keyType, "key-type", "k", types.ABCIPubKeyTypeEd25519,
+		fmt.Sprintf("private validator key type (one of %s)", keytypes.SupportedKeyTypesStr()))
 }
 
 // TestnetFilesCmd allows initialisation of files for a CometBFT testnet.
@@ -185,6 +188,7 @@ func testnetFiles(*cobra.Command, []string) error {
 		InitialHeight:   initialHeight,
 		Validators:      genVals,
 	}
+	genDoc.ConsensusParams.Validator.PubKeyTypes = []string{keyType}
 
 	// Write genesis file.
 	for i := 0; i < nValidators+nNonValidators; i++ {
@@ -27,9 +27,11 @@ func main() {
 		cmd.ShowNodeIDCmd,
 		cmd.ReIndexEventCmd,
 		cmd.GenNodeKeyCmd,
+		cmd.GenRPCTokenCmd,
 		cmd.VersionCmd,
 		cmd.RollbackStateCmd,
 		cmd.CompactGoLevelDBCmd,
+		cmd.CompactDBsCmd,
 		cmd.InspectCmd,
 		debug.DebugCmd,
 		cli.NewCompletionCmd(rootCmd, true),
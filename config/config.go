@@ -161,12 +161,21 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.Consensus.ValidateBasic(); err != nil {
 		return ErrInSection{Section: "consensus", Err: err}
 	}
+	if err := cfg.Storage.ValidateBasic(); err != nil {
+		return ErrInSection{Section: "storage", Err: err}
+	}
+	if err := cfg.TxIndex.ValidateBasic(); err != nil {
+		return ErrInSection{Section: "tx_index", Err: err}
+	}
 	if err := cfg.Instrumentation.ValidateBasic(); err != nil {
 		return ErrInSection{Section: "instrumentation", Err: err}
 	}
 	if !cfg.Consensus.CreateEmptyBlocks && cfg.Mempool.Type == MempoolTypeNop {
 		return fmt.Errorf("`nop` mempool does not support create_empty_blocks = false")
 	}
+	if cfg.Storage.DataCompanion && cfg.RPC.GRPCPrivilegedListenAddress == "" {
+		return errors.New("storage.data_companion requires rpc.grpc_privileged_laddr to be set")
+	}
 	return nil
 }
 
@@ -339,7 +348,7 @@ type RPCConfig struct {
 	CORSAllowedHeaders []string `mapstructure:"cors_allowed_headers"`
 
 	// TCP or UNIX socket address for the gRPC server to listen on
-	// NOTE: This server only supports /broadcast_tx_commit
+	// NOTE: This server only supports /broadcast_tx_commit and /broadcast_txs
 	GRPCListenAddress string `mapstructure:"grpc_laddr"`
 
 	// Maximum number of simultaneous connections.
@@ -349,6 +358,15 @@ type RPCConfig struct {
 	// 0 - unlimited.
 	GRPCMaxOpenConnections int `mapstructure:"grpc_max_open_connections"`
 
+	// TCP or UNIX socket address for the gRPC server serving the block and
+	// block results services to listen on. Empty means the server is disabled.
+	GRPCServicesListenAddress string `mapstructure:"grpc_services_laddr"`
+
+	// TCP or UNIX socket address for the privileged gRPC server to listen on.
+	// The privileged server serves the data companion pruning service and must
+	// not be exposed publicly. Empty means the server is disabled.
+	GRPCPrivilegedListenAddress string `mapstructure:"grpc_privileged_laddr"`
+
 	// Activate unsafe RPC commands like /dial_persistent_peers and /unsafe_flush_mempool
 	Unsafe bool `mapstructure:"unsafe"`
 
@@ -400,8 +418,42 @@ type RPCConfig struct {
 	// See https://github.com/tendermint/tendermint/issues/3435
 	TimeoutBroadcastTxCommit time.Duration `mapstructure:"timeout_broadcast_tx_commit"`
 
+	// Serve /simulate_tx. Enable it only if the application implements the
+	// optional SimulateTx ABCI method (see the Simulator interface of
+	// abci/types); the calls fail otherwise.
+	SimulateTx bool `mapstructure:"simulate_tx"`
+
+	// How long to wait for the application to simulate a tx during
+	// /simulate_tx. 0 means no timeout.
+	TimeoutSimulateTx time.Duration `mapstructure:"timeout_simulate_tx"`
+
+	// Maximum number of txs simulated by the application at once, during
+	// /simulate_tx. 0 disables /simulate_tx.
+	MaxConcurrentSimulations int `mapstructure:"max_concurrent_simulations"`
+
+	// Maximum number of txs tracked at once by /broadcast_tx_track, including
+	// the resolved ones which are retained. 0 disables /broadcast_tx_track.
+	MaxTrackedTxs int `mapstructure:"max_tracked_txs"`
+
+	// Maximum number of txs tracked at once for a single client, identified
+	// by its API key or IP address, counted like MaxTrackedTxs. 0 means no
+	// limit per client.
+	MaxTrackedTxsPerClient int `mapstructure:"max_tracked_txs_per_client"`
+
+	// How long the status of a tracked tx is retained after it is committed or
+	// dropped.
+	TrackedTxRetention time.Duration `mapstructure:"tracked_tx_retention"`
+
+	// If true, /broadcast_tx_track accepts a webhook URL to which the status
+	// of the tx is posted once it is committed or dropped. Only the public IP
+	// addresses are posted to, without following redirects.
+	// WARNING: the node then sends HTTP requests to arbitrary URLs given by
+	// the clients.
+	TxTrackWebhooks bool `mapstructure:"tx_track_webhooks"`
+
 	// Maximum number of requests that can be sent in a batch
 	// https://www.jsonrpc.org/specification#batch
+	// Also limits the number of txs of a /broadcast_txs request.
 	MaxRequestBatchSize int `mapstructure:"max_request_batch_size"`
 
 	// Maximum size of request body, in bytes
@@ -410,6 +462,11 @@ type RPCConfig struct {
 	// Maximum size of request header, in bytes
 	MaxHeaderBytes int `mapstructure:"max_header_bytes"`
 
+	// Maximum size of the cache of the responses of the immutable routes,
+	// like blocks and commits below the latest height, in bytes. 0 disables
+	// the cache.
+	ResponseCacheBytes int64 `mapstructure:"response_cache_bytes"`
+
 	// The path to a file containing certificate that is used to create the HTTPS server.
 	// Might be either absolute path or path related to CometBFT's config directory.
 	//
@@ -431,6 +488,177 @@ type RPCConfig struct {
 	// pprof listen address (https://golang.org/pkg/net/http/pprof)
 	// FIXME: This should be moved under the instrumentation section
 	PprofListenAddress string `mapstructure:"pprof_laddr"`
+
+	// Authentication of the clients and the methods they may call
+	Auth RPCAuthConfig `mapstructure:"auth"`
+
+	// Rate limits of the clients, per remote IP address or API key
+	RateLimit RPCRateLimitConfig `mapstructure:"rate_limit"`
+}
+
+// RPCAuthConfig defines how the clients of the RPC server are authenticated
+// and which methods they may call.
+type RPCAuthConfig struct {
+	// The path to a file containing the hex-encoded secret, of at least 32
+	// bytes, of the HMAC-SHA256 signed JSON Web Tokens that the clients may
+	// present as bearer tokens. The roles of a client are listed in the
+	// "roles" claim of its token.
+	// Might be either absolute path or path related to CometBFT's config directory.
+	JWTSecretFile string `mapstructure:"jwt_secret_file"`
+
+	// The path to a file containing the PEM-encoded certificate authorities of
+	// the client certificates that the clients may present when TLS is
+	// enabled. The roles of a client are the organizations (O) of the subject
+	// of its certificate.
+	// Might be either absolute path or path related to CometBFT's config directory.
+	ClientCAFile string `mapstructure:"client_ca_file"`
+
+	// The methods each role may call. If empty, all clients may call all
+	// methods. Every client, authenticated or not, has the "anonymous" role.
+	Roles []RPCAuthRole `mapstructure:"roles"`
+}
+
+// RPCAuthRole lists the RPC methods that the clients with a role may call.
+type RPCAuthRole struct {
+	Name string `mapstructure:"name"`
+	// Method names, or prefixes followed by "*" to match all the methods
+	// with that prefix.
+	Methods []string `mapstructure:"methods"`
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *RPCAuthConfig) ValidateBasic() error {
+	if len(cfg.Roles) == 0 && (cfg.JWTSecretFile != "" || cfg.ClientCAFile != "") {
+		return errors.New("roles must be set to authenticate clients")
+	}
+	names := make(map[string]struct{}, len(cfg.Roles))
+	for _, role := range cfg.Roles {
+		if role.Name == "" {
+			return errors.New("empty role name")
+		}
+		if _, ok := names[role.Name]; ok {
+			return fmt.Errorf("duplicate role %q", role.Name)
+		}
+		names[role.Name] = struct{}{}
+		for _, method := range role.Methods {
+			if method == "" || strings.Contains(strings.TrimSuffix(method, "*"), "*") {
+				return fmt.Errorf("invalid method %q of role %q", method, role.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// Enabled reports whether the methods the clients may call are restricted.
+func (cfg *RPCAuthConfig) Enabled() bool {
+	return len(cfg.Roles) > 0
+}
+
+// RoleMethods returns the methods of each role.
+func (cfg *RPCAuthConfig) RoleMethods() map[string][]string {
+	roleMethods := make(map[string][]string, len(cfg.Roles))
+	for _, role := range cfg.Roles {
+		roleMethods[role.Name] = role.Methods
+	}
+	return roleMethods
+}
+
+// RPCRateLimitConfig defines the rate limits of the clients of the RPC server.
+// Each client has a bucket of tokens, refilled at a constant rate, from which
+// every call takes the cost of its method. The calls of a client with not
+// enough tokens are rejected with an HTTP 429 error.
+type RPCRateLimitConfig struct {
+	// The number of tokens per second of each remote IP address. 0 disables
+	// the rate limits.
+	Rate float64 `mapstructure:"rate"`
+
+	// The maximum number of tokens of each remote IP address.
+	Burst int `mapstructure:"burst"`
+
+	// The number of tokens per second of each API key, i.e. of the clients
+	// authenticated with the same token subject or certificate. If 0, the
+	// authenticated clients are limited per remote IP address.
+	APIKeyRate float64 `mapstructure:"api_key_rate"`
+
+	// The maximum number of tokens of each API key.
+	APIKeyBurst int `mapstructure:"api_key_burst"`
+
+	// The costs of the methods, 1 if not listed. The cost of a JSON-RPC batch
+	// is the sum of the costs of its requests, and broadcast_txs costs its
+	// cost per tx. A batch or broadcast_txs call costing more than the burst
+	// is rejected.
+	Costs []RPCMethodCost `mapstructure:"costs"`
+}
+
+// RPCMethodCost is the number of tokens that a call to an RPC method costs.
+type RPCMethodCost struct {
+	Method string `mapstructure:"method"`
+	Cost   int    `mapstructure:"cost"`
+}
+
+// DefaultRPCRateLimitConfig returns the default rate limits, which are
+// disabled.
+func DefaultRPCRateLimitConfig() RPCRateLimitConfig {
+	return RPCRateLimitConfig{
+		Rate:        0,
+		Burst:       100,
+		APIKeyRate:  0,
+		APIKeyBurst: 1000,
+		Costs: []RPCMethodCost{
+			{Method: "block_search", Cost: 10},
+			{Method: "tx_search", Cost: 10},
+			{Method: "blockchain", Cost: 5},
+			{Method: "consensus_params_changes", Cost: 5},
+			{Method: "validator_set_changes", Cost: 5},
+			{Method: "simulate_tx", Cost: 5},
+		},
+	}
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *RPCRateLimitConfig) ValidateBasic() error {
+	if cfg.Rate < 0 {
+		return cmterrors.ErrNegativeField{Field: "rate"}
+	}
+	if cfg.Rate > 0 && cfg.Burst < 1 {
+		return errors.New("burst must be positive")
+	}
+	if cfg.APIKeyRate < 0 {
+		return cmterrors.ErrNegativeField{Field: "api_key_rate"}
+	}
+	if cfg.APIKeyRate > 0 && cfg.APIKeyBurst < 1 {
+		return errors.New("api_key_burst must be positive")
+	}
+	methods := make(map[string]struct{}, len(cfg.Costs))
+	for _, c := range cfg.Costs {
+		if c.Method == "" {
+			return errors.New("empty method")
+		}
+		if _, ok := methods[c.Method]; ok {
+			return fmt.Errorf("duplicate cost of method %q", c.Method)
+		}
+		methods[c.Method] = struct{}{}
+		if c.Cost < 1 {
+			return fmt.Errorf("cost of method %q must be positive", c.Method)
+		}
+	}
+	return nil
+}
+
+// Enabled reports whether the clients are rate limited.
+func (cfg *RPCRateLimitConfig) Enabled() bool {
+	return cfg.Rate > 0 || cfg.APIKeyRate > 0
+}
+
+// MethodCosts returns the cost of each listed method.
+func (cfg *RPCRateLimitConfig) MethodCosts() map[string]int {
+	costs := make(map[string]int, len(cfg.Costs))
+	for _, c := range cfg.Costs {
+		costs[c.Method] = c.Cost
+	}
+	return costs
 }
 
 // DefaultRPCConfig returns a default configuration for the RPC server
@@ -443,6 +671,9 @@ func DefaultRPCConfig() *RPCConfig {
 		GRPCListenAddress:      "",
 		GRPCMaxOpenConnections: 900,
 
+		GRPCServicesListenAddress:   "",
+		GRPCPrivilegedListenAddress: "",
+
 		Unsafe:             false,
 		MaxOpenConnections: 900,
 
@@ -452,12 +683,24 @@ func DefaultRPCConfig() *RPCConfig {
 		TimeoutBroadcastTxCommit:  10 * time.Second,
 		WebSocketWriteBufferSize:  defaultSubscriptionBufferSize,
 
+		SimulateTx:               false,
+		TimeoutSimulateTx:        5 * time.Second,
+		MaxConcurrentSimulations: 10,
+
+		MaxTrackedTxs:          10000,
+		MaxTrackedTxsPerClient: 100,
+		TrackedTxRetention:     10 * time.Minute,
+		TxTrackWebhooks:        false,
+
 		MaxRequestBatchSize: 10,             // maximum requests in a JSON-RPC batch request
 		MaxBodyBytes:        int64(1000000), // 1MB
 		MaxHeaderBytes:      1 << 20,        // same as the net/http default
+		ResponseCacheBytes:  64 << 20,       // 64MB
 
 		TLSCertFile: "",
 		TLSKeyFile:  "",
+
+		RateLimit: DefaultRPCRateLimitConfig(),
 	}
 }
 
@@ -467,6 +710,7 @@ func TestRPCConfig() *RPCConfig {
 	cfg.ListenAddress = "tcp://127.0.0.1:36657"
 	cfg.GRPCListenAddress = "tcp://127.0.0.1:36658"
 	cfg.Unsafe = true
+	cfg.SimulateTx = true
 	return cfg
 }
 
@@ -498,6 +742,21 @@ func (cfg *RPCConfig) ValidateBasic() error {
 	if cfg.TimeoutBroadcastTxCommit < 0 {
 		return cmterrors.ErrNegativeField{Field: "timeout_broadcast_tx_commit"}
 	}
+	if cfg.TimeoutSimulateTx < 0 {
+		return cmterrors.ErrNegativeField{Field: "timeout_simulate_tx"}
+	}
+	if cfg.MaxConcurrentSimulations < 0 {
+		return cmterrors.ErrNegativeField{Field: "max_concurrent_simulations"}
+	}
+	if cfg.MaxTrackedTxs < 0 {
+		return cmterrors.ErrNegativeField{Field: "max_tracked_txs"}
+	}
+	if cfg.MaxTrackedTxsPerClient < 0 {
+		return cmterrors.ErrNegativeField{Field: "max_tracked_txs_per_client"}
+	}
+	if cfg.TrackedTxRetention < 0 {
+		return cmterrors.ErrNegativeField{Field: "tracked_tx_retention"}
+	}
 	if cfg.MaxRequestBatchSize < 0 {
 		return errors.New("max_request_batch_size can't be negative")
 	}
@@ -507,6 +766,18 @@ func (cfg *RPCConfig) ValidateBasic() error {
 	if cfg.MaxHeaderBytes < 0 {
 		return cmterrors.ErrNegativeField{Field: "max_header_bytes"}
 	}
+	if cfg.ResponseCacheBytes < 0 {
+		return cmterrors.ErrNegativeField{Field: "response_cache_bytes"}
+	}
+	if err := cfg.Auth.ValidateBasic(); err != nil {
+		return fmt.Errorf("auth: %w", err)
+	}
+	if cfg.Auth.ClientCAFile != "" && !cfg.IsTLSEnabled() {
+		return errors.New("auth.client_ca_file requires tls_cert_file and tls_key_file")
+	}
+	if err := cfg.RateLimit.ValidateBasic(); err != nil {
+		return fmt.Errorf("rate_limit: %w", err)
+	}
 	return nil
 }
 
@@ -535,6 +806,22 @@ func (cfg RPCConfig) CertFile() string {
 	return rootify(filepath.Join(DefaultConfigDir, path), cfg.RootDir)
 }
 
+func (cfg RPCConfig) AuthJWTSecretFile() string {
+	path := cfg.Auth.JWTSecretFile
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return rootify(filepath.Join(DefaultConfigDir, path), cfg.RootDir)
+}
+
+func (cfg RPCConfig) AuthClientCAFile() string {
+	path := cfg.Auth.ClientCAFile
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return rootify(filepath.Join(DefaultConfigDir, path), cfg.RootDir)
+}
+
 func (cfg RPCConfig) IsTLSEnabled() bool {
 	return cfg.TLSCertFile != "" && cfg.TLSKeyFile != ""
 }
@@ -1252,6 +1539,19 @@ type ConsensusConfig struct {
 	// Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
 	SkipTimeoutCommit bool `mapstructure:"skip_timeout_commit"`
 
+	// AdaptiveTimeouts replaces TimeoutPropose, TimeoutPrevote and
+	// TimeoutPrecommit by values derived from the proposal and vote
+	// propagation latency observed over the last AdaptiveTimeoutWindow rounds.
+	// The derived values are bounded by AdaptiveTimeoutMin and
+	// AdaptiveTimeoutMax. The *Delta values are still added on each round.
+	AdaptiveTimeouts bool `mapstructure:"adaptive_timeouts"`
+	// Number of rounds over which the propagation latency is estimated
+	AdaptiveTimeoutWindow int `mapstructure:"adaptive_timeout_window"`
+	// Lower bound of the adaptive timeouts
+	AdaptiveTimeoutMin time.Duration `mapstructure:"adaptive_timeout_min"`
+	// Upper bound of the adaptive timeouts
+	AdaptiveTimeoutMax time.Duration `mapstructure:"adaptive_timeout_max"`
+
 	// EmptyBlocks mode and possible interval between empty blocks
 	CreateEmptyBlocks         bool          `mapstructure:"create_empty_blocks"`
 	CreateEmptyBlocksInterval time.Duration `mapstructure:"create_empty_blocks_interval"`
@@ -1264,6 +1564,17 @@ type ConsensusConfig struct {
 
 	// BlockTimeTolerance is the maximum allowed difference between the proposed block time and wall-clock time.
 	BlockTimeTolerance time.Duration `mapstructure:"block_time_tolerance"`
+
+	// Maximum number of verified vote signatures cached. The cache is shared
+	// by consensus, blocksync and the state sync light client.
+	SignatureCacheSize int `mapstructure:"signature_cache_size"`
+
+	// Number of most recent heights for which the consensus timeline (step
+	// transitions, timeouts, arrival of proposals and votes) is kept and
+	// served by the consensus_trace RPC. 0 disables the recording.
+	TraceHeights int `mapstructure:"trace_heights"`
+	// Maximum number of events recorded per height
+	TraceEventsPerHeight int `mapstructure:"trace_events_per_height"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service
@@ -1278,12 +1589,19 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		TimeoutPrecommitDelta:       500 * time.Millisecond,
 		TimeoutCommit:               1000 * time.Millisecond,
 		SkipTimeoutCommit:           false,
+		AdaptiveTimeouts:            false,
+		AdaptiveTimeoutWindow:       20,
+		AdaptiveTimeoutMin:          200 * time.Millisecond,
+		AdaptiveTimeoutMax:          10 * time.Second,
 		CreateEmptyBlocks:           true,
 		CreateEmptyBlocksInterval:   0 * time.Second,
 		PeerGossipSleepDuration:     100 * time.Millisecond,
 		PeerQueryMaj23SleepDuration: 2000 * time.Millisecond,
 		DoubleSignCheckHeight:       int64(0),
 		BlockTimeTolerance:          60 * time.Second,
+		SignatureCacheSize:          10000,
+		TraceHeights:                0,
+		TraceEventsPerHeight:        10000,
 	}
 }
 
@@ -1374,6 +1692,23 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.TimeoutCommit < 0 {
 		return cmterrors.ErrNegativeField{Field: "timeout_commit"}
 	}
+	if cfg.AdaptiveTimeoutWindow < 0 {
+		return cmterrors.ErrNegativeField{Field: "adaptive_timeout_window"}
+	}
+	if cfg.AdaptiveTimeoutMin < 0 {
+		return cmterrors.ErrNegativeField{Field: "adaptive_timeout_min"}
+	}
+	if cfg.AdaptiveTimeoutMax < 0 {
+		return cmterrors.ErrNegativeField{Field: "adaptive_timeout_max"}
+	}
+	if cfg.AdaptiveTimeouts {
+		if cfg.AdaptiveTimeoutWindow == 0 {
+			return errors.New("adaptive_timeout_window must be positive when adaptive_timeouts is enabled")
+		}
+		if cfg.AdaptiveTimeoutMax < cfg.AdaptiveTimeoutMin {
+			return errors.New("adaptive_timeout_max can't be less than adaptive_timeout_min")
+		}
+	}
 	if cfg.CreateEmptyBlocksInterval < 0 {
 		return cmterrors.ErrNegativeField{Field: "create_empty_blocks_interval"}
 	}
@@ -1389,6 +1724,15 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.BlockTimeTolerance <= 0 {
 		return errors.New("block_time_tolerance must be positive")
 	}
+	if cfg.SignatureCacheSize <= 0 {
+		return errors.New("signature_cache_size must be positive")
+	}
+	if cfg.TraceHeights < 0 {
+		return cmterrors.ErrNegativeField{Field: "trace_heights"}
+	}
+	if cfg.TraceHeights > 0 && cfg.TraceEventsPerHeight <= 0 {
+		return errors.New("trace_events_per_height must be positive when trace_heights is positive")
+	}
 	return nil
 }
 
@@ -1402,6 +1746,16 @@ type StorageConfig struct {
 	// required for `/block_results` RPC queries, and to reindex events in the
 	// command-line tool.
 	DiscardABCIResponses bool `mapstructure:"discard_abci_responses"`
+
+	// If set to true, blocks are only pruned once both the application and the
+	// data companion allow it. The data companion sets its retain height via
+	// the pruning service of the privileged gRPC server, which must be enabled.
+	DataCompanion bool `mapstructure:"data_companion"`
+
+	// The number of most recent heights whose ABCI responses are kept. The
+	// responses of older heights are pruned even if their blocks are not.
+	// 0 keeps the responses as long as their blocks.
+	ABCIResponsesRetainBlocks int64 `mapstructure:"abci_responses_retain_blocks"`
 }
 
 // DefaultStorageConfig returns the default configuration options relating to
@@ -1420,6 +1774,18 @@ func TestStorageConfig() *StorageConfig {
 	}
 }
 
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *StorageConfig) ValidateBasic() error {
+	if cfg.ABCIResponsesRetainBlocks < 0 {
+		return cmterrors.ErrNegativeField{Field: "abci_responses_retain_blocks"}
+	}
+	if cfg.ABCIResponsesRetainBlocks > 0 && cfg.DiscardABCIResponses {
+		return errors.New("abci_responses_retain_blocks cannot be set when discard_abci_responses is true")
+	}
+	return nil
+}
+
 // -----------------------------------------------------------------------------
 // TxIndexConfig
 // Remember that Event has the following structure:
@@ -1446,6 +1812,21 @@ type TxIndexConfig struct {
 	// The PostgreSQL connection configuration, the connection format:
 	// postgresql://<user>:<password>@<host>:<port>/<db>?<opts>
 	PsqlConn string `mapstructure:"psql-conn"`
+
+	// The height below which the "kv" indexer prunes transactions and block
+	// events, in the background. It is applied on startup if it is greater
+	// than the current indexer retain height, which can also be raised with
+	// the unsafe /set_indexer_retain_height RPC endpoint. 0 disables it.
+	RetainHeight int64 `mapstructure:"retain_height"`
+
+	// Event types and composite keys ("type.key") of the event attributes to
+	// index. If not empty, only the matching attributes are indexed. Only the
+	// attributes the application flagged for indexing are ever indexed.
+	IncludeEvents []string `mapstructure:"include_events"`
+
+	// Event types and composite keys of the event attributes not to index.
+	// They take precedence over IncludeEvents.
+	ExcludeEvents []string `mapstructure:"exclude_events"`
 }
 
 // DefaultTxIndexConfig returns a default configuration for the transaction indexer.
@@ -1460,6 +1841,36 @@ func TestTxIndexConfig() *TxIndexConfig {
 	return DefaultTxIndexConfig()
 }
 
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *TxIndexConfig) ValidateBasic() error {
+	if cfg.RetainHeight < 0 {
+		return cmterrors.ErrNegativeField{Field: "retain_height"}
+	}
+	if cfg.RetainHeight > 0 && cfg.Indexer != "kv" {
+		return errors.New("retain_height is only supported by the kv indexer")
+	}
+	if err := validateEventRules("include_events", cfg.IncludeEvents); err != nil {
+		return err
+	}
+	return validateEventRules("exclude_events", cfg.ExcludeEvents)
+}
+
+// validateEventRules checks that the event types and composite keys of an
+// event indexing rule list are well formed. The keys indexed for every
+// transaction or block cannot be filtered.
+func validateEventRules(field string, rules []string) error {
+	for _, r := range rules {
+		switch {
+		case r == "" || strings.TrimSpace(r) != r || strings.HasPrefix(r, ".") || strings.HasSuffix(r, "."):
+			return fmt.Errorf("%s: invalid event type or composite key %q", field, r)
+		case r == "tx.hash" || r == "tx.height" || r == "block.height":
+			return fmt.Errorf("%s: %s is always indexed", field, r)
+		}
+	}
+	return nil
+}
+
 //-----------------------------------------------------------------------------
 // InstrumentationConfig
 
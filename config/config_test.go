@@ -43,6 +43,14 @@ func TestConfigValidateBasic(t *testing.T) {
 	cfg.Consensus.CreateEmptyBlocks = false
 	cfg.Mempool.Type = config.MempoolTypeNop
 	assert.Error(t, cfg.ValidateBasic())
+	cfg.Consensus.CreateEmptyBlocks = true
+	cfg.Mempool.Type = config.MempoolTypeFlood
+
+	// the data companion requires the privileged gRPC server
+	cfg.Storage.DataCompanion = true
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.RPC.GRPCPrivilegedListenAddress = "tcp://127.0.0.1:26670"
+	assert.NoError(t, cfg.ValidateBasic())
 }
 
 func TestTLSConfiguration(t *testing.T) {
@@ -80,6 +88,11 @@ func TestRPCConfigValidateBasic(t *testing.T) {
 		"MaxSubscriptionClients",
 		"MaxSubscriptionsPerClient",
 		"TimeoutBroadcastTxCommit",
+		"TimeoutSimulateTx",
+		"MaxConcurrentSimulations",
+		"MaxTrackedTxs",
+		"MaxTrackedTxsPerClient",
+		"TrackedTxRetention",
 		"MaxBodyBytes",
 		"MaxHeaderBytes",
 		"MaxRequestBatchSize",
@@ -92,6 +105,72 @@ func TestRPCConfigValidateBasic(t *testing.T) {
 	}
 }
 
+func TestRPCAuthConfigValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		auth      config.RPCAuthConfig
+		expectErr bool
+	}{
+		{"disabled", config.RPCAuthConfig{}, false},
+		{"roles only", config.RPCAuthConfig{Roles: []config.RPCAuthRole{{Name: "anonymous", Methods: []string{"status"}}}}, false},
+		{"prefix", config.RPCAuthConfig{Roles: []config.RPCAuthRole{{Name: "a", Methods: []string{"broadcast_tx_*", "*"}}}}, false},
+		{"secret without roles", config.RPCAuthConfig{JWTSecretFile: "secret"}, true},
+		{"empty name", config.RPCAuthConfig{Roles: []config.RPCAuthRole{{Methods: []string{"status"}}}}, true},
+		{"duplicate role", config.RPCAuthConfig{Roles: []config.RPCAuthRole{{Name: "a"}, {Name: "a"}}}, true},
+		{"empty method", config.RPCAuthConfig{Roles: []config.RPCAuthRole{{Name: "a", Methods: []string{""}}}}, true},
+		{"inner wildcard", config.RPCAuthConfig{Roles: []config.RPCAuthRole{{Name: "a", Methods: []string{"*_tx"}}}}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := config.TestRPCConfig()
+			cfg.Auth = tc.auth
+			if tc.expectErr {
+				assert.Error(t, cfg.ValidateBasic())
+			} else {
+				assert.NoError(t, cfg.ValidateBasic())
+			}
+		})
+	}
+
+	// Client certificates require TLS.
+	cfg := config.TestRPCConfig()
+	cfg.Auth = config.RPCAuthConfig{ClientCAFile: "ca.pem", Roles: []config.RPCAuthRole{{Name: "a"}}}
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.TLSCertFile, cfg.TLSKeyFile = "cert.pem", "key.pem"
+	assert.NoError(t, cfg.ValidateBasic())
+}
+
+func TestRPCRateLimitConfigValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		modify    func(*config.RPCRateLimitConfig)
+		expectErr bool
+	}{
+		{"default", func(*config.RPCRateLimitConfig) {}, false},
+		{"enabled", func(c *config.RPCRateLimitConfig) { c.Rate, c.APIKeyRate = 10, 100 }, false},
+		{"negative rate", func(c *config.RPCRateLimitConfig) { c.Rate = -1 }, true},
+		{"zero burst", func(c *config.RPCRateLimitConfig) { c.Rate, c.Burst = 10, 0 }, true},
+		{"negative api key rate", func(c *config.RPCRateLimitConfig) { c.APIKeyRate = -1 }, true},
+		{"zero api key burst", func(c *config.RPCRateLimitConfig) { c.APIKeyRate, c.APIKeyBurst = 10, 0 }, true},
+		{"empty method", func(c *config.RPCRateLimitConfig) { c.Costs = []config.RPCMethodCost{{Cost: 1}} }, true},
+		{"zero cost", func(c *config.RPCRateLimitConfig) { c.Costs = []config.RPCMethodCost{{Method: "status"}} }, true},
+		{"duplicate method", func(c *config.RPCRateLimitConfig) {
+			c.Costs = []config.RPCMethodCost{{Method: "status", Cost: 1}, {Method: "status", Cost: 2}}
+		}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := config.TestRPCConfig()
+			tc.modify(&cfg.RateLimit)
+			if tc.expectErr {
+				assert.Error(t, cfg.ValidateBasic())
+			} else {
+				assert.NoError(t, cfg.ValidateBasic())
+			}
+		})
+	}
+}
+
 func TestP2PConfigValidateBasic(t *testing.T) {
 	cfg := config.TestP2PConfig()
 	assert.NoError(t, cfg.ValidateBasic())
@@ -354,6 +433,18 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"BlockTimeTolerance":                   {func(c *config.ConsensusConfig) { c.BlockTimeTolerance = time.Second }, false},
 		"BlockTimeTolerance zero":              {func(c *config.ConsensusConfig) { c.BlockTimeTolerance = 0 }, true},
 		"BlockTimeTolerance negative":          {func(c *config.ConsensusConfig) { c.BlockTimeTolerance = -1 }, true},
+		"SignatureCacheSize zero":              {func(c *config.ConsensusConfig) { c.SignatureCacheSize = 0 }, true},
+		"TraceHeights":                         {func(c *config.ConsensusConfig) { c.TraceHeights = 100 }, false},
+		"TraceHeights negative":                {func(c *config.ConsensusConfig) { c.TraceHeights = -1 }, true},
+		"TraceEventsPerHeight zero":            {func(c *config.ConsensusConfig) { c.TraceHeights, c.TraceEventsPerHeight = 100, 0 }, true},
+		"AdaptiveTimeouts":                     {func(c *config.ConsensusConfig) { c.AdaptiveTimeouts = true }, false},
+		"AdaptiveTimeoutWindow negative":       {func(c *config.ConsensusConfig) { c.AdaptiveTimeoutWindow = -1 }, true},
+		"AdaptiveTimeoutWindow zero":           {func(c *config.ConsensusConfig) { c.AdaptiveTimeouts, c.AdaptiveTimeoutWindow = true, 0 }, true},
+		"AdaptiveTimeoutMin negative":          {func(c *config.ConsensusConfig) { c.AdaptiveTimeoutMin = -1 }, true},
+		"AdaptiveTimeoutMax negative":          {func(c *config.ConsensusConfig) { c.AdaptiveTimeoutMax = -1 }, true},
+		"AdaptiveTimeoutMax below min": {func(c *config.ConsensusConfig) {
+			c.AdaptiveTimeouts, c.AdaptiveTimeoutMin, c.AdaptiveTimeoutMax = true, time.Second, time.Millisecond
+		}, true},
 	}
 	for desc, tc := range testcases {
 		// appease linter
@@ -371,6 +462,48 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestStorageConfigValidateBasic(t *testing.T) {
+	cfg := config.TestStorageConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.ABCIResponsesRetainBlocks = 100
+	assert.NoError(t, cfg.ValidateBasic())
+
+	// responses are not kept at all
+	cfg.DiscardABCIResponses = true
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg.DiscardABCIResponses = false
+	cfg.ABCIResponsesRetainBlocks = -1
+	assert.Error(t, cfg.ValidateBasic())
+}
+
+func TestTxIndexConfigValidateBasic(t *testing.T) {
+	cfg := config.TestTxIndexConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.RetainHeight = 10
+	assert.NoError(t, cfg.ValidateBasic())
+
+	// retain height is not supported by the other indexers
+	cfg.Indexer = "psql"
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg.Indexer = "kv"
+	cfg.RetainHeight = -1
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg.RetainHeight = 0
+	cfg.IncludeEvents = []string{"transfer", "message.sender"}
+	cfg.ExcludeEvents = []string{"transfer.memo"}
+	assert.NoError(t, cfg.ValidateBasic())
+
+	for _, rule := range []string{"", " transfer", "transfer.", ".sender", "tx.hash", "block.height"} {
+		cfg.ExcludeEvents = []string{rule}
+		assert.Error(t, cfg.ValidateBasic(), rule)
+	}
+}
+
 func TestInstrumentationConfigValidateBasic(t *testing.T) {
 	cfg := config.TestInstrumentationConfig()
 	assert.NoError(t, cfg.ValidateBasic())
@@ -165,7 +165,7 @@ cors_allowed_methods = [{{ range .RPC.CORSAllowedMethods }}{{ printf "%q, " . }}
 cors_allowed_headers = [{{ range .RPC.CORSAllowedHeaders }}{{ printf "%q, " . }}{{end}}]
 
 # TCP or UNIX socket address for the gRPC server to listen on
-# NOTE: This server only supports /broadcast_tx_commit
+# NOTE: This server only supports /broadcast_tx_commit and /broadcast_txs
 grpc_laddr = "{{ .RPC.GRPCListenAddress }}"
 
 # Maximum number of simultaneous connections.
@@ -177,6 +177,15 @@ grpc_laddr = "{{ .RPC.GRPCListenAddress }}"
 # 1024 - 40 - 10 - 50 = 924 = ~900
 grpc_max_open_connections = {{ .RPC.GRPCMaxOpenConnections }}
 
+# TCP or UNIX socket address for the gRPC server serving the block and block
+# results services to listen on. Leave empty to disable it.
+grpc_services_laddr = "{{ .RPC.GRPCServicesListenAddress }}"
+
+# TCP or UNIX socket address for the privileged gRPC server to listen on.
+# The privileged server serves the data companion pruning service and must not
+# be exposed publicly. Leave empty to disable it.
+grpc_privileged_laddr = "{{ .RPC.GRPCPrivilegedListenAddress }}"
+
 # Activate unsafe RPC commands like /dial_seeds and /unsafe_flush_mempool
 unsafe = {{ .RPC.Unsafe }}
 
@@ -232,9 +241,43 @@ experimental_close_on_slow_client = {{ .RPC.CloseOnSlowClient }}
 # See https://github.com/tendermint/tendermint/issues/3435
 timeout_broadcast_tx_commit = "{{ .RPC.TimeoutBroadcastTxCommit }}"
 
+# Serve /simulate_tx. Enable it only if the application implements the
+# optional SimulateTx ABCI method; the calls fail otherwise.
+simulate_tx = {{ .RPC.SimulateTx }}
+
+# How long to wait for the application to simulate a tx during /simulate_tx.
+# Should be lower than the global HTTP write timeout (10s).
+# 0 means no timeout.
+timeout_simulate_tx = "{{ .RPC.TimeoutSimulateTx }}"
+
+# Maximum number of txs the application simulates at once during
+# /simulate_tx. The requests above the limit fail immediately.
+# 0 disables /simulate_tx.
+max_concurrent_simulations = {{ .RPC.MaxConcurrentSimulations }}
+
+# Maximum number of txs tracked at once by /broadcast_tx_track, including
+# the committed or dropped ones whose status is retained.
+# 0 disables /broadcast_tx_track.
+max_tracked_txs = {{ .RPC.MaxTrackedTxs }}
+
+# Maximum number of txs tracked at once for a single client, identified by
+# its API key or IP address. 0 means no limit per client.
+max_tracked_txs_per_client = {{ .RPC.MaxTrackedTxsPerClient }}
+
+# How long the status of a tracked tx is retained after the tx is committed
+# or dropped, for /tx_track.
+tracked_tx_retention = "{{ .RPC.TrackedTxRetention }}"
+
+# If true, /broadcast_tx_track accepts a webhook URL to which the status of
+# the tx is posted once it is committed or dropped. Only the public IP
+# addresses are posted to, without following redirects.
+# WARNING: the node then sends HTTP requests to any URL given by the clients.
+tx_track_webhooks = {{ .RPC.TxTrackWebhooks }}
+
 # Maximum number of requests that can be sent in a batch
 # If the value is set to '0' (zero-value), then no maximum batch size will be
 # enforced for a JSON-RPC batch request.
+# Also limits the number of txs of a /broadcast_txs request.
 max_request_batch_size = {{ .RPC.MaxRequestBatchSize }}
 
 # Maximum size of request body, in bytes
@@ -243,6 +286,12 @@ max_body_bytes = {{ .RPC.MaxBodyBytes }}
 # Maximum size of request header, in bytes
 max_header_bytes = {{ .RPC.MaxHeaderBytes }}
 
+# Maximum size of the cache of the responses of the immutable routes, like
+# blocks, commits, block results and validators below the latest height, in
+# bytes. Cached responses are served without reading and encoding them again.
+# 0 disables the cache.
+response_cache_bytes = {{ .RPC.ResponseCacheBytes }}
+
 # The path to a file containing certificate that is used to create the HTTPS server.
 # Might be either absolute path or path related to CometBFT's config directory.
 # If the certificate is signed by a certificate authority,
@@ -261,6 +310,73 @@ tls_key_file = "{{ .RPC.TLSKeyFile }}"
 # pprof listen address (https://golang.org/pkg/net/http/pprof)
 pprof_laddr = "{{ .RPC.PprofListenAddress }}"
 
+# Authentication of the RPC clients and the methods they may call, enforced
+# for the HTTP and websocket connections.
+[rpc.auth]
+
+# The path to a file containing the hex-encoded secret, of at least 32 bytes,
+# of the HMAC-SHA256 signed JSON Web Tokens that the clients may present in an
+# "Authorization: Bearer <token>" header. The roles of a client are listed in
+# the "roles" claim of its token. Tokens can be generated with the
+# gen-rpc-token command.
+# Might be either absolute path or path related to CometBFT's config directory.
+jwt_secret_file = "{{ .RPC.Auth.JWTSecretFile }}"
+
+# The path to a file containing the PEM-encoded certificate authorities of
+# the client certificates that the clients may present. The roles of a client
+# are the organizations (O) of the subject of its certificate.
+# NOTE: requires tls_cert_file and tls_key_file.
+# Might be either absolute path or path related to CometBFT's config directory.
+client_ca_file = "{{ .RPC.Auth.ClientCAFile }}"
+
+# The methods each role may call, for example:
+# [[rpc.auth.roles]]
+# name = "anonymous"
+# methods = ["health", "status", "block", "tx"]
+# [[rpc.auth.roles]]
+# name = "submitter"
+# methods = ["broadcast_tx_*"]
+#
+# A method ending with "*" matches all the methods with that prefix. Every
+# client, authenticated or not, has the "anonymous" role. If no role is set,
+# all clients may call all methods.
+{{- range .RPC.Auth.Roles }}
+[[rpc.auth.roles]]
+name = "{{ .Name }}"
+methods = [{{ range .Methods }}{{ printf "%q, " . }}{{end}}]
+{{- end }}
+
+# Rate limits of the RPC clients, enforced for the HTTP and websocket
+# connections. Each client has a bucket of tokens, refilled at a constant
+# rate, from which every call takes the cost of its method. The calls of a
+# client with not enough tokens are rejected with an HTTP 429 error, or a
+# JSON-RPC error with code -32003 on websocket connections.
+[rpc.rate_limit]
+
+# The number of tokens per second of each remote IP address.
+# 0 disables the rate limits.
+rate = {{ .RPC.RateLimit.Rate }}
+
+# The maximum number of tokens of each remote IP address.
+burst = {{ .RPC.RateLimit.Burst }}
+
+# The number of tokens per second of each API key, i.e. of the clients
+# authenticated with the same token subject or certificate (see [rpc.auth]).
+# If 0, the authenticated clients are limited per remote IP address.
+api_key_rate = {{ .RPC.RateLimit.APIKeyRate }}
+
+# The maximum number of tokens of each API key.
+api_key_burst = {{ .RPC.RateLimit.APIKeyBurst }}
+
+# The costs of the methods, 1 if not listed. The cost of a JSON-RPC batch is
+# the sum of the costs of its requests, and broadcast_txs costs its cost per
+# tx. A batch or broadcast_txs call costing more than the burst is rejected.
+{{- range .RPC.RateLimit.Costs }}
+[[rpc.rate_limit.costs]]
+method = "{{ .Method }}"
+cost = {{ .Cost }}
+{{- end }}
+
 #######################################################
 ###           P2P Configuration Options             ###
 #######################################################
@@ -585,6 +701,16 @@ double_sign_check_height = {{ .Consensus.DoubleSignCheckHeight }}
 # Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
 skip_timeout_commit = {{ .Consensus.SkipTimeoutCommit }}
 
+# Derive timeout_propose, timeout_prevote and timeout_precommit from the
+# proposal and vote propagation latency observed over the last
+# adaptive_timeout_window rounds, instead of using the static values above.
+# The derived values are bounded by adaptive_timeout_min and
+# adaptive_timeout_max; the *_delta values are still added on each round.
+adaptive_timeouts = {{ .Consensus.AdaptiveTimeouts }}
+adaptive_timeout_window = {{ .Consensus.AdaptiveTimeoutWindow }}
+adaptive_timeout_min = "{{ .Consensus.AdaptiveTimeoutMin }}"
+adaptive_timeout_max = "{{ .Consensus.AdaptiveTimeoutMax }}"
+
 # EmptyBlocks mode and possible interval between empty blocks
 create_empty_blocks = {{ .Consensus.CreateEmptyBlocks }}
 create_empty_blocks_interval = "{{ .Consensus.CreateEmptyBlocksInterval }}"
@@ -596,6 +722,20 @@ peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 # Maximum allowed difference between proposed block time and wall-clock time.
 block_time_tolerance = "{{ .Consensus.BlockTimeTolerance }}"
 
+# Maximum number of verified vote signatures cached. The cache is shared by
+# consensus, blocksync and the state sync light client, so that a signature is
+# verified only once.
+signature_cache_size = {{ .Consensus.SignatureCacheSize }}
+
+# Number of most recent heights for which the consensus timeline (step
+# transitions, fired timeouts, arrival of the proposal, block parts and votes,
+# PrepareProposal and ProcessProposal durations) is kept and served by the
+# /consensus_trace RPC endpoint. 0 disables the recording.
+trace_heights = {{ .Consensus.TraceHeights }}
+# Maximum number of events recorded per height. Events past this limit are
+# counted, but dropped.
+trace_events_per_height = {{ .Consensus.TraceEventsPerHeight }}
+
 #######################################################
 ###         Storage Configuration Options           ###
 #######################################################
@@ -607,6 +747,18 @@ block_time_tolerance = "{{ .Consensus.BlockTimeTolerance }}"
 # reindex events in the command-line tool.
 discard_abci_responses = {{ .Storage.DiscardABCIResponses}}
 
+# Set to true to only prune blocks once both the application and the data
+# companion allow it. The data companion sets its retain height via the pruning
+# service of the privileged gRPC server (see rpc.grpc_privileged_laddr), which
+# must be enabled.
+data_companion = {{ .Storage.DataCompanion }}
+
+# The number of most recent heights whose ABCI responses are kept. The responses
+# of older heights are pruned, even if their blocks are not, which bounds the
+# history served by /block_results. 0 keeps the responses as long as their
+# blocks. Requires discard_abci_responses to be false.
+abci_responses_retain_blocks = {{ .Storage.ABCIResponsesRetainBlocks }}
+
 #######################################################
 ###   Transaction Indexer Configuration Options     ###
 #######################################################
@@ -629,6 +781,22 @@ indexer = "{{ .TxIndex.Indexer }}"
 #   postgresql://<user>:<password>@<host>:<port>/<db>?<opts>
 psql-conn = "{{ .TxIndex.PsqlConn }}"
 
+# The height below which the "kv" indexer prunes transactions and block events,
+# in the background. It is applied on startup if it is greater than the current
+# indexer retain height, which can also be raised with the unsafe
+# /set_indexer_retain_height RPC endpoint. 0 disables it.
+retain_height = {{ .TxIndex.RetainHeight }}
+
+# Event types (e.g. "transfer") and composite keys (e.g. "transfer.sender") of
+# the event attributes to index, among those the application flagged for
+# indexing. If empty, all of them are indexed. Run "cometbft reindex-event" to
+# apply new rules to the blocks indexed earlier.
+include_events = [{{ range .TxIndex.IncludeEvents }}{{ printf "%q, " . }}{{end}}]
+
+# Event types and composite keys of the event attributes not to index. They take
+# precedence over include_events.
+exclude_events = [{{ range .TxIndex.ExcludeEvents }}{{ printf "%q, " . }}{{end}}]
+
 #######################################################
 ###       Instrumentation Configuration Options     ###
 #######################################################
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -59,6 +60,40 @@ func TestEnsureTestRoot(t *testing.T) {
 	ensureFiles(t, rootDir, config.DefaultDataDir, baseConfig.Genesis, baseConfig.PrivValidatorKey, baseConfig.PrivValidatorState)
 }
 
+func TestRPCAuthRolesRoundTrip(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.RPC.Auth.JWTSecretFile = "rpc_jwt_secret.hex"
+	cfg.RPC.Auth.Roles = []config.RPCAuthRole{
+		{Name: "anonymous", Methods: []string{"health", "status"}},
+		{Name: "Submitter", Methods: []string{"broadcast_tx_*"}},
+	}
+	configFile := filepath.Join(t.TempDir(), config.DefaultConfigFileName)
+	config.WriteConfigFile(configFile, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(configFile)
+	require.NoError(t, v.ReadInConfig())
+	read := config.DefaultConfig()
+	require.NoError(t, v.Unmarshal(read))
+	assert.Equal(t, cfg.RPC.Auth, read.RPC.Auth)
+}
+
+func TestRPCRateLimitRoundTrip(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.RPC.RateLimit.Rate = 2.5
+	cfg.RPC.RateLimit.APIKeyRate = 50
+	cfg.RPC.RateLimit.Costs = append(cfg.RPC.RateLimit.Costs, config.RPCMethodCost{Method: "genesis", Cost: 20})
+	configFile := filepath.Join(t.TempDir(), config.DefaultConfigFileName)
+	config.WriteConfigFile(configFile, cfg)
+
+	v := viper.New()
+	v.SetConfigFile(configFile)
+	require.NoError(t, v.ReadInConfig())
+	read := config.DefaultConfig()
+	require.NoError(t, v.Unmarshal(read))
+	assert.Equal(t, cfg.RPC.RateLimit, read.RPC.RateLimit)
+}
+
 func assertValidConfig(t *testing.T, configFile string) {
 	t.Helper()
 	// list of words we expect in the config
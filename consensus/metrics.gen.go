@@ -204,6 +204,12 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "proposal_create_count",
 			Help:      "ProposalCreationCount is the total number of proposals created by this node since process start. The metric is annotated by the status of the proposal from the application, either 'accepted' or 'rejected'.",
 		}, labels).With(labelsAndValues...),
+		AdaptiveTimeoutSeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "adaptive_timeout_seconds",
+			Help:      "Base timeout in seconds chosen for a step when adaptive timeouts are enabled.",
+		}, append(labels, "step")).With(labelsAndValues...),
 		RoundVotingPowerPercent: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -264,6 +270,7 @@ func NopMetrics() *Metrics {
 		VoteExtensionReceiveCount:   discard.NewCounter(),
 		ProposalReceiveCount:        discard.NewCounter(),
 		ProposalCreateCount:         discard.NewCounter(),
+		AdaptiveTimeoutSeconds:      discard.NewGauge(),
 		RoundVotingPowerPercent:     discard.NewGauge(),
 		LateVotes:                   discard.NewCounter(),
 		PeerHeight:                  discard.NewGauge(),
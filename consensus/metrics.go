@@ -134,6 +134,13 @@ type Metrics struct {
 	// either 'accepted' or 'rejected'.
 	ProposalCreateCount metrics.Counter
 
+	// AdaptiveTimeoutSeconds is the base timeout, in seconds, chosen for a
+	// step when adaptive timeouts are enabled. The per round delta is not
+	// included. The metric is labeled by step: 'propose', 'prevote' or
+	// 'precommit'.
+	//metrics:Base timeout in seconds chosen for a step when adaptive timeouts are enabled.
+	AdaptiveTimeoutSeconds metrics.Gauge `metrics_labels:"step"`
+
 	// RoundVotingPowerPercent is the percentage of the total voting power received
 	// with a round. The value begins at 0 for each round and approaches 1.0 as
 	// additional voting power is observed. The metric is labeled by vote type.
@@ -135,6 +135,12 @@ type State struct {
 
 	// offline state sync height indicating to which height the node synced offline
 	offlineStateSyncHeight int64
+
+	// timeline of the most recent heights, for diagnosing slow rounds
+	trace *traceRecorder
+
+	// latency estimates used when config.AdaptiveTimeouts is enabled
+	adaptiveTimeouts *adaptiveTimeouts
 }
 
 // StateOption sets an optional parameter on the State.
@@ -165,6 +171,7 @@ func NewState(
 		evpool:           evpool,
 		evsw:             cmtevents.NewEventSwitch(),
 		metrics:          NopMetrics(),
+		adaptiveTimeouts: newAdaptiveTimeouts(config),
 	}
 	for _, option := range options {
 		option(cs)
@@ -220,6 +227,14 @@ func OfflineStateSyncHeight(height int64) StateOption {
 	return func(cs *State) { cs.offlineStateSyncHeight = height }
 }
 
+// TraceSize sets the number of most recent heights for which the consensus
+// timeline is kept, and the maximum number of events recorded per height.
+// The timeline is not recorded by default, or if the number of heights is
+// zero.
+func TraceSize(heights, eventsPerHeight int) StateOption {
+	return func(cs *State) { cs.trace = newTraceRecorder(heights, eventsPerHeight) }
+}
+
 // String returns a string.
 func (cs *State) String() string {
 	// better not to access shared variables
@@ -270,6 +285,26 @@ func (cs *State) GetRoundStateSimpleJSON() ([]byte, error) {
 	return cmtjson.Marshal(cs.RoundStateSimple())
 }
 
+// GetTrace returns the consensus timeline of the given height, or false if
+// it is not kept (anymore).
+// This function is thread-safe.
+func (cs *State) GetTrace(height int64) (*HeightTrace, bool) {
+	return cs.trace.get(height)
+}
+
+// GetTraceJSON returns a json of the consensus timeline of the given height.
+func (cs *State) GetTraceJSON(height int64) ([]byte, error) {
+	if cs.trace == nil {
+		return nil, errors.New("the consensus trace is disabled (consensus.trace_heights = 0)")
+	}
+	trace, ok := cs.trace.get(height)
+	if !ok {
+		return nil, fmt.Errorf("no consensus trace for height %d; only the %d most recent heights are kept",
+			height, cs.trace.maxHeights)
+	}
+	return cmtjson.Marshal(trace)
+}
+
 // GetValidators returns a copy of the current validators.
 func (cs *State) GetValidators() (int64, []*types.Validator) {
 	cs.mtx.RLock()
@@ -548,6 +583,18 @@ func (cs *State) updateRoundStep(round int32, step cstypes.RoundStepType) {
 	}
 	cs.Round = round
 	cs.Step = step
+	cs.recordTrace(cs.Height, traceEvent{typ: TraceEventStep, round: round, step: step})
+}
+
+// recordTrace adds the event to the consensus timeline of the given height.
+// Events replayed from the WAL are not recorded, as their timing is
+// meaningless.
+func (cs *State) recordTrace(height int64, event traceEvent) {
+	if cs.trace == nil || cs.replayMode {
+		return
+	}
+	event.time = cmttime.Now()
+	cs.trace.record(height, event)
 }
 
 // enterNewRound(height, 0) at cs.StartTime.
@@ -923,10 +970,27 @@ func (cs *State) handleMsg(mi msgInfo) {
 		// will not cause transition.
 		// once proposal is set, we can receive block parts
 		err = cs.setProposal(msg.Proposal)
+		if err == nil && cs.Proposal == msg.Proposal {
+			cs.recordTrace(msg.Proposal.Height, traceEvent{
+				typ:      TraceEventProposal,
+				round:    msg.Proposal.Round,
+				peerID:   peerID,
+				polRound: msg.Proposal.POLRound,
+				blockID:  msg.Proposal.BlockID,
+			})
+		}
 
 	case *BlockPartMessage:
 		// if the proposal is complete, we'll enterPrevote or tryFinalizeCommit
 		added, err = cs.addProposalBlockPart(msg, peerID)
+		if added {
+			cs.recordTrace(msg.Height, traceEvent{
+				typ:       TraceEventBlockPart,
+				round:     msg.Round,
+				peerID:    peerID,
+				partIndex: msg.Part.Index,
+			})
+		}
 
 		// We unlock here to yield to any routines that need to read the RoundState.
 		// Previously, this code held the lock from the point at which the final block
@@ -1014,6 +1078,13 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 	cs.mtx.Lock()
 	defer cs.mtx.Unlock()
 
+	cs.recordTrace(ti.Height, traceEvent{
+		typ:      TraceEventTimeout,
+		round:    ti.Round,
+		step:     ti.Step,
+		duration: ti.Duration,
+	})
+
 	switch ti.Step {
 	case cstypes.RoundStepNewHeight:
 		// NewRound event fired from enterNewRound.
@@ -1028,6 +1099,7 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 			cs.Logger.Error("failed publishing timeout propose", "err", err)
 		}
 
+		cs.observeLatency(cs.adaptiveTimeouts.proposal, &cs.adaptiveTimeouts.proposeStart, ti.Round)
 		cs.enterPrevote(ti.Height, ti.Round)
 
 	case cstypes.RoundStepPrevoteWait:
@@ -1043,6 +1115,7 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 		}
 
 		cs.emitPrecommitTimeoutMetrics(ti.Round)
+		cs.observeLatency(cs.adaptiveTimeouts.precommit, &cs.adaptiveTimeouts.precommitStart, ti.Round)
 		cs.enterPrecommit(ti.Height, ti.Round)
 		cs.enterNewRound(ti.Height, ti.Round+1)
 
@@ -1116,6 +1189,7 @@ func (cs *State) enterNewRound(height int64, round int32) {
 	// we don't fire newStep for this step,
 	// but we fire an event, so update the round step first
 	cs.updateRoundStep(round, cstypes.RoundStepNewRound)
+	cs.adaptiveTimeouts.startRound(round)
 	cs.Validators = validators
 	// If round == 0, we've already reset these upon new height, and meanwhile
 	// we might have received a proposal for round 0.
@@ -1206,7 +1280,8 @@ func (cs *State) enterPropose(height int64, round int32) {
 	}()
 
 	// If we don't get the proposal and all block parts quick enough, enterPrevote
-	cs.scheduleTimeout(cs.config.Propose(round), height, round, cstypes.RoundStepPropose)
+	cs.startLatency(&cs.adaptiveTimeouts.proposeStart)
+	cs.scheduleTimeout(cs.proposeTimeout(round), height, round, cstypes.RoundStepPropose)
 
 	// Nothing more to do if we're not a validator
 	if cs.privValidator == nil {
@@ -1233,6 +1308,8 @@ func (cs *State) enterPropose(height int64, round int32) {
 
 	if cs.isProposer(address) {
 		logger.Debug("propose step; our turn to propose", "proposer", address)
+		// our own proposal says nothing about the propagation latency
+		cs.adaptiveTimeouts.proposeStart = time.Time{}
 		cs.decideProposal(height, round)
 	} else {
 		logger.Debug("propose step; not our turn to propose", "proposer", cs.Validators.GetProposer().Address)
@@ -1347,10 +1424,17 @@ func (cs *State) createProposalBlock(ctx context.Context) (*types.Block, error)
 
 	proposerAddr := cs.privValidatorPubKey.Address()
 
+	start := cmttime.Now()
 	ret, err := cs.blockExec.CreateProposalBlock(ctx, cs.Height, cs.state, lastExtCommit, proposerAddr)
 	if err != nil {
 		panic(err)
 	}
+	cs.recordTrace(cs.Height, traceEvent{
+		typ:      TraceEventPrepareProposal,
+		round:    cs.Round,
+		duration: cmttime.Now().Sub(start),
+		txs:      len(ret.Txs),
+	})
 	return ret, nil
 }
 
@@ -1421,12 +1505,19 @@ func (cs *State) defaultDoPrevote(height int64, round int32) {
 		Please see `PrepareProosal`-`ProcessProposal` coherence and determinism properties
 		in the ABCI++ specification.
 	*/
+	start := cmttime.Now()
 	isAppValid, err := cs.blockExec.ProcessProposal(cs.ProposalBlock, cs.state)
 	if err != nil {
 		panic(fmt.Sprintf(
 			"state machine returned an error (%v) when calling ProcessProposal", err,
 		))
 	}
+	cs.recordTrace(height, traceEvent{
+		typ:      TraceEventProcessProposal,
+		round:    round,
+		duration: cmttime.Now().Sub(start),
+		accepted: isAppValid,
+	})
 	cs.metrics.MarkProposalProcessed(isAppValid)
 
 	// Vote nil if the Application rejected the block
@@ -1472,7 +1563,12 @@ func (cs *State) enterPrevoteWait(height int64, round int32) {
 	}()
 
 	// Wait for some more prevotes; enterPrecommit
-	cs.scheduleTimeout(cs.config.Prevote(round), height, round, cstypes.RoundStepPrevoteWait)
+	// Only the wait for votes is measured, not the wait for a proposal which
+	// already got +2/3 prevotes.
+	if _, ok := cs.Votes.Prevotes(round).TwoThirdsMajority(); !ok {
+		cs.startLatency(&cs.adaptiveTimeouts.prevoteStart)
+	}
+	cs.scheduleTimeout(cs.prevoteTimeout(round), height, round, cstypes.RoundStepPrevoteWait)
 }
 
 // Enter: `timeoutPrevote` after any +2/3 prevotes.
@@ -1500,6 +1596,10 @@ func (cs *State) enterPrecommit(height int64, round int32) {
 		cs.newStep()
 	}()
 
+	// the prevote wait is over, on +2/3 prevotes for a block or nil, or on
+	// timeout
+	cs.observeLatency(cs.adaptiveTimeouts.prevote, &cs.adaptiveTimeouts.prevoteStart, round)
+
 	// check for a polka
 	blockID, ok := cs.Votes.Prevotes(round).TwoThirdsMajority()
 
@@ -1631,7 +1731,11 @@ func (cs *State) enterPrecommitWait(height int64, round int32) {
 	}()
 
 	// wait for some more precommits; enterNewRound
-	cs.scheduleTimeout(cs.config.Precommit(round), height, round, cstypes.RoundStepPrecommitWait)
+	// Nothing is awaited if +2/3 precommits for nil were already received.
+	if _, ok := cs.Votes.Precommits(round).TwoThirdsMajority(); !ok {
+		cs.startLatency(&cs.adaptiveTimeouts.precommitStart)
+	}
+	cs.scheduleTimeout(cs.precommitTimeout(round), height, round, cstypes.RoundStepPrecommitWait)
 }
 
 // Enter: +2/3 precommits for block
@@ -2086,6 +2190,8 @@ func (cs *State) addProposalBlockPart(msg *BlockPartMessage, peerID p2p.ID) (add
 }
 
 func (cs *State) handleCompleteProposal(blockHeight int64) {
+	cs.observeLatency(cs.adaptiveTimeouts.proposal, &cs.adaptiveTimeouts.proposeStart, cs.Round)
+
 	// Update Valid* if we can.
 	prevotes := cs.Votes.Prevotes(cs.Round)
 	blockID, hasTwoThirds := prevotes.TwoThirdsMajority()
@@ -2172,6 +2278,32 @@ func (cs *State) tryAddVote(vote *types.Vote, peerID p2p.ID) (bool, error) {
 	return added, nil
 }
 
+// cacheVerifiedPrecommit adds the signature of a precommit, which was verified
+// when it was added to a vote set, to the shared signature cache. This way it
+// is not verified again when the commit it ends up in is verified.
+func (cs *State) cacheVerifiedPrecommit(vote *types.Vote) {
+	cache := cs.blockExec.SignatureCache()
+	if cache == nil || vote.Type != cmtproto.PrecommitType {
+		return
+	}
+	cache.Add(string(vote.Signature), types.SignatureCacheValue{
+		ValidatorAddress: vote.ValidatorAddress,
+		VoteSignBytes:    types.VoteSignBytes(cs.state.ChainID, vote.ToProto()),
+	})
+}
+
+// traceVote records the arrival of an added vote in the consensus timeline.
+func (cs *State) traceVote(vote *types.Vote, peerID p2p.ID) {
+	cs.recordTrace(vote.Height, traceEvent{
+		typ:      TraceEventVote,
+		round:    vote.Round,
+		peerID:   peerID,
+		voteType: vote.Type,
+		valIndex: vote.ValidatorIndex,
+		blockID:  vote.BlockID,
+	})
+}
+
 func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error) {
 	cs.Logger.Debug(
 		"Adding vote",
@@ -2206,6 +2338,9 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 			return added, err
 		}
 
+		cs.cacheVerifiedPrecommit(vote)
+		cs.traceVote(vote, peerID)
+
 		cs.Logger.Debug("added vote to last precommits", "last_commit", cs.LastCommit.StringShort())
 		if err := cs.eventBus.PublishEventVote(types.EventDataVote{Vote: vote}); err != nil {
 			return added, err
@@ -2292,6 +2427,9 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		}
 		return added, err
 	}
+	cs.cacheVerifiedPrecommit(vote)
+	cs.traceVote(vote, peerID)
+
 	if vote.Round == cs.Round {
 		vals := cs.state.Validators
 		_, val := vals.GetByIndex(vote.ValidatorIndex)
@@ -2394,6 +2532,9 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 
 		blockID, ok := precommits.TwoThirdsMajority()
 		if ok {
+			// the precommit wait of this round, if any, is over
+			cs.observeLatency(cs.adaptiveTimeouts.precommit, &cs.adaptiveTimeouts.precommitStart, vote.Round)
+
 			// Executed as TwoThirdsMajority could be from a higher round
 			cs.enterNewRound(height, vote.Round)
 			cs.enterPrecommit(height, vote.Round)
@@ -5,9 +5,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/go-kit/kit/metrics"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -393,6 +395,234 @@ func TestStateFullRound1(t *testing.T) {
 	validateLastPrecommit(t, cs, vss[0], propBlockHash)
 }
 
+func TestStateTrace(t *testing.T) {
+	cs, _ := randState(1)
+	height, round := cs.Height, cs.Round
+
+	// the timeline is not recorded by default
+	_, err := cs.GetTraceJSON(height)
+	require.ErrorContains(t, err, "disabled")
+	TraceSize(10, 1000)(cs)
+
+	voteCh := subscribeUnBuffered(cs.eventBus, types.EventQueryVote)
+	newRoundCh := subscribe(cs.eventBus, types.EventQueryNewRound)
+
+	startTestRound(cs, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensurePrevote(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+	ensureNewRound(newRoundCh, height+1, 0)
+
+	trace, ok := cs.GetTrace(height)
+	require.True(t, ok)
+	assert.Equal(t, height, trace.Height)
+	assert.Zero(t, trace.DroppedEvents)
+
+	var steps []string
+	counts := make(map[TraceEventType]int)
+	for i, ev := range trace.Events {
+		counts[ev.Type]++
+		if ev.Type == TraceEventStep {
+			steps = append(steps, ev.Step)
+		}
+		if i > 0 {
+			assert.False(t, ev.Time.Before(trace.Events[i-1].Time), "events must be in chronological order")
+		}
+	}
+	assert.Equal(t, 1, counts[TraceEventPrepareProposal])
+	assert.Equal(t, 1, counts[TraceEventProcessProposal])
+	assert.Equal(t, 1, counts[TraceEventProposal])
+	assert.Equal(t, 1, counts[TraceEventBlockPart])
+	assert.Equal(t, 2, counts[TraceEventVote])
+	assert.Subset(t, steps, []string{
+		cstypes.RoundStepNewRound.String(),
+		cstypes.RoundStepPropose.String(),
+		cstypes.RoundStepPrevote.String(),
+		cstypes.RoundStepPrecommit.String(),
+		cstypes.RoundStepCommit.String(),
+	})
+
+	bz, err := cs.GetTraceJSON(height)
+	require.NoError(t, err)
+	assert.Contains(t, string(bz), `"type":"prepare_proposal"`)
+	assert.Contains(t, string(bz), `"info":"txs=0"`)
+
+	_, err = cs.GetTraceJSON(height + 10)
+	require.Error(t, err)
+}
+
+func TestStateAdaptiveTimeouts(t *testing.T) {
+	cs, _ := randState(1)
+	// not a validator, so that we wait for the propose timeout
+	cs.SetPrivValidator(nil)
+	height, round := cs.Height, cs.Round
+
+	conf := *cs.config
+	conf.AdaptiveTimeouts = true
+	conf.AdaptiveTimeoutWindow = 2
+	conf.AdaptiveTimeoutMin = 20 * time.Millisecond
+	conf.AdaptiveTimeoutMax = 100 * time.Millisecond
+	cs.config = &conf
+	cs.adaptiveTimeouts = newAdaptiveTimeouts(&conf)
+	gauge := newLabeledGauge()
+	cs.metrics.AdaptiveTimeoutSeconds = gauge
+	at := cs.adaptiveTimeouts
+
+	// no latency observed yet, the configured timeouts are used, within the
+	// bounds
+	assert.Equal(t, conf.TimeoutPropose, cs.proposeTimeout(0))
+	assert.Equal(t, conf.AdaptiveTimeoutMin+2*conf.TimeoutPrevoteDelta, cs.prevoteTimeout(2))
+	assert.Equal(t, conf.AdaptiveTimeoutMin, cs.precommitTimeout(0))
+
+	// twice the highest latency in the window, within the bounds
+	at.proposal.add(5 * time.Millisecond)
+	assert.Equal(t, 20*time.Millisecond, cs.proposeTimeout(0))
+	at.proposal.add(30 * time.Millisecond)
+	assert.Equal(t, 60*time.Millisecond, cs.proposeTimeout(0))
+	assert.Equal(t, 60*time.Millisecond+3*conf.TimeoutProposeDelta, cs.proposeTimeout(3))
+	at.proposal.add(80 * time.Millisecond)
+	assert.Equal(t, 100*time.Millisecond, cs.proposeTimeout(0))
+	// older samples leave the window
+	at.proposal.add(15 * time.Millisecond)
+	at.proposal.add(15 * time.Millisecond)
+	assert.Equal(t, 30*time.Millisecond, cs.proposeTimeout(0))
+	assert.Equal(t, 0.03, gauge.value("step", "propose"))
+
+	// a latency is only observed once, for the round it was started in
+	start := time.Now()
+	at.startRound(1)
+	at.prevoteStart = start
+	at.observe(at.prevote, &at.prevoteStart, 0, start.Add(40*time.Millisecond))
+	assert.Equal(t, conf.AdaptiveTimeoutMin, cs.prevoteTimeout(0))
+	at.observe(at.prevote, &at.prevoteStart, 1, start.Add(40*time.Millisecond))
+	at.observe(at.prevote, &at.prevoteStart, 1, start.Add(time.Second))
+	assert.Equal(t, 80*time.Millisecond, cs.prevoteTimeout(0))
+	assert.Equal(t, 0.08, gauge.value("step", "prevote"))
+
+	// the adapted timeout is scheduled
+	ticker := &recordingTicker{TimeoutTicker: NewTimeoutTicker()}
+	cs.SetTimeoutTicker(ticker)
+	timeoutCh := subscribe(cs.eventBus, types.EventQueryTimeoutPropose)
+
+	startTestRound(cs, height, round)
+	ensureNewTimeout(timeoutCh, height, round, (30 * time.Millisecond).Nanoseconds())
+
+	ti, ok := ticker.last(cstypes.RoundStepPropose)
+	require.True(t, ok)
+	assert.Equal(t, 30*time.Millisecond, ti.Duration)
+}
+
+func TestStateAdaptiveTimeoutsObserveLatency(t *testing.T) {
+	cs1, vss := randState(4)
+	vs2, vs3, vs4 := vss[1], vss[2], vss[3]
+	height, round := cs1.Height, cs1.Round
+
+	conf := *cs1.config
+	conf.AdaptiveTimeouts = true
+	conf.AdaptiveTimeoutMin = time.Millisecond
+	cs1.config = &conf
+	cs1.adaptiveTimeouts = newAdaptiveTimeouts(&conf)
+
+	voteCh := subscribeUnBuffered(cs1.eventBus, types.EventQueryVote)
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	timeoutWaitCh := subscribe(cs1.eventBus, types.EventQueryTimeoutWait)
+
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensureNewProposal(proposalCh, height, round)
+	rs := cs1.GetRoundState()
+	blockID := types.BlockID{Hash: rs.ProposalBlock.Hash(), PartSetHeader: rs.ProposalBlockParts.Header()}
+	ensurePrevote(voteCh, height, round)
+
+	// +2/3 prevotes for anything: the prevote wait times out
+	signAddVotes(cs1, cmtproto.PrevoteType, nil, types.PartSetHeader{}, false, vs2, vs3)
+	ensurePrevote(voteCh, height, round)
+	ensurePrevote(voteCh, height, round)
+	ensureNewTimeout(timeoutWaitCh, height, round, conf.TimeoutPrevote.Nanoseconds())
+	ensurePrecommit(voteCh, height, round)
+
+	// +2/3 precommits for anything, then for nil: the precommit wait is over
+	signAddVotes(cs1, cmtproto.PrecommitType, blockID.Hash, blockID.PartSetHeader, true, vs2)
+	signAddVotes(cs1, cmtproto.PrecommitType, nil, types.PartSetHeader{}, false, vs3)
+	ensurePrecommit(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+	signAddVotes(cs1, cmtproto.PrecommitType, nil, types.PartSetHeader{}, false, vs4)
+	ensurePrecommit(voteCh, height, round)
+	ensureNewRound(newRoundCh, height, round+1)
+
+	cs1.mtx.RLock()
+	defer cs1.mtx.RUnlock()
+	at := cs1.adaptiveTimeouts
+	// we are the proposer, so our own proposal is not a propagation sample
+	assert.Empty(t, at.proposal.samples)
+	// the round which timed out is included, with at least the time waited
+	require.Len(t, at.prevote.samples, 1)
+	assert.GreaterOrEqual(t, at.prevote.samples[0], conf.TimeoutPrevote)
+	// the precommit wait is measured once, until +2/3 precommits for nil
+	assert.Len(t, at.precommit.samples, 1)
+}
+
+// recordingTicker records the timeouts scheduled on the wrapped ticker.
+type recordingTicker struct {
+	TimeoutTicker
+
+	mtx       sync.Mutex
+	scheduled []timeoutInfo
+}
+
+func (rt *recordingTicker) ScheduleTimeout(ti timeoutInfo) {
+	rt.mtx.Lock()
+	rt.scheduled = append(rt.scheduled, ti)
+	rt.mtx.Unlock()
+	rt.TimeoutTicker.ScheduleTimeout(ti)
+}
+
+func (rt *recordingTicker) last(step cstypes.RoundStepType) (timeoutInfo, bool) {
+	rt.mtx.Lock()
+	defer rt.mtx.Unlock()
+	for i := len(rt.scheduled) - 1; i >= 0; i-- {
+		if rt.scheduled[i].Step == step {
+			return rt.scheduled[i], true
+		}
+	}
+	return timeoutInfo{}, false
+}
+
+// labeledGauge is a metrics.Gauge recording the last value set for each set
+// of label values.
+type labeledGauge struct {
+	mtx    *sync.Mutex
+	labels []string
+	values map[string]float64
+}
+
+func newLabeledGauge() *labeledGauge {
+	return &labeledGauge{mtx: &sync.Mutex{}, values: make(map[string]float64)}
+}
+
+func (g *labeledGauge) With(labelValues ...string) metrics.Gauge {
+	return &labeledGauge{mtx: g.mtx, labels: append(g.labels, labelValues...), values: g.values}
+}
+
+func (g *labeledGauge) Set(value float64) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	g.values[strings.Join(g.labels, ",")] = value
+}
+
+func (g *labeledGauge) Add(delta float64) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	g.values[strings.Join(g.labels, ",")] += delta
+}
+
+func (g *labeledGauge) value(labelValues ...string) float64 {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	return g.values[strings.Join(labelValues, ",")]
+}
+
 // nil is proposed, so prevote and precommit nil
 func TestStateFullRoundNil(t *testing.T) {
 	cs, _ := randState(1)
@@ -0,0 +1,185 @@
+package consensus
+
+import (
+	"time"
+
+	cfg "github.com/cometbft/cometbft/config"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+// adaptiveTimeoutFactor is the margin applied to the highest latency observed
+// in the window to obtain a timeout.
+const adaptiveTimeoutFactor = 2
+
+// latencyWindow holds the most recent latency samples of one kind.
+type latencyWindow struct {
+	samples []time.Duration
+	next    int
+}
+
+func newLatencyWindow(size int) *latencyWindow {
+	return &latencyWindow{samples: make([]time.Duration, 0, size)}
+}
+
+// add adds a sample, overwriting the oldest one if the window is full.
+func (w *latencyWindow) add(d time.Duration) {
+	if len(w.samples) < cap(w.samples) {
+		w.samples = append(w.samples, d)
+		return
+	}
+	w.samples[w.next] = d
+	w.next = (w.next + 1) % len(w.samples)
+}
+
+// max returns the highest sample in the window, or false if it is empty.
+func (w *latencyWindow) max() (time.Duration, bool) {
+	if len(w.samples) == 0 {
+		return 0, false
+	}
+	m := w.samples[0]
+	for _, d := range w.samples[1:] {
+		if d > m {
+			m = d
+		}
+	}
+	return m, true
+}
+
+// adaptiveTimeouts estimates the proposal and vote propagation latency over
+// the most recent rounds, and derives the base propose, prevote and precommit
+// timeouts from it.
+//
+// Each latency is measured from the point where the corresponding timeout is
+// scheduled, until the awaited proposal or votes are received, or until the
+// timeout fires. A round which timed out thus adds the time waited, which is a
+// lower bound of the latency, so that the timeouts grow while rounds keep
+// timing out instead of being derived from the successful rounds only.
+//
+// Each timeout is the highest latency in the window, multiplied by
+// adaptiveTimeoutFactor and bounded by the configured minimum and maximum.
+// Until a latency has been observed, the configured static timeout is used
+// (within the same bounds). The result only depends on the observed samples,
+// so that it is deterministic for a given sequence of observations.
+//
+// NOTE: not thread-safe, it must be used under the consensus state lock.
+type adaptiveTimeouts struct {
+	min, max time.Duration
+
+	// latency between entering the propose step and completing the proposal
+	proposal *latencyWindow
+	// latency between receiving +2/3 prevotes for anything and entering the
+	// precommit step
+	prevote *latencyWindow
+	// latency between receiving +2/3 precommits for anything and receiving
+	// +2/3 precommits for a block or nil
+	precommit *latencyWindow
+
+	// start of the step being measured, zero once measured (or if nothing is
+	// being measured)
+	round          int32
+	proposeStart   time.Time
+	prevoteStart   time.Time
+	precommitStart time.Time
+}
+
+func newAdaptiveTimeouts(config *cfg.ConsensusConfig) *adaptiveTimeouts {
+	window := config.AdaptiveTimeoutWindow
+	if window < 1 {
+		window = 1
+	}
+	return &adaptiveTimeouts{
+		min:       config.AdaptiveTimeoutMin,
+		max:       config.AdaptiveTimeoutMax,
+		proposal:  newLatencyWindow(window),
+		prevote:   newLatencyWindow(window),
+		precommit: newLatencyWindow(window),
+	}
+}
+
+// timeout returns the base timeout derived from the latency window, or from
+// the fallback if no latency was observed yet.
+func (at *adaptiveTimeouts) timeout(w *latencyWindow, fallback time.Duration) time.Duration {
+	d := fallback
+	if latency, ok := w.max(); ok {
+		d = latency * adaptiveTimeoutFactor
+	}
+	if d < at.min {
+		d = at.min
+	}
+	if d > at.max {
+		d = at.max
+	}
+	return d
+}
+
+// startRound resets the measurements when entering a new round.
+func (at *adaptiveTimeouts) startRound(round int32) {
+	at.round = round
+	at.proposeStart = time.Time{}
+	at.prevoteStart = time.Time{}
+	at.precommitStart = time.Time{}
+}
+
+// observe adds the time elapsed since *start to the window, if the
+// measurement was started for the given round, and stops it.
+func (at *adaptiveTimeouts) observe(w *latencyWindow, start *time.Time, round int32, now time.Time) {
+	if round != at.round || start.IsZero() {
+		return
+	}
+	if now.After(*start) {
+		w.add(now.Sub(*start))
+	}
+	*start = time.Time{}
+}
+
+// proposeTimeout returns the amount of time to wait for a proposal in the
+// given round.
+func (cs *State) proposeTimeout(round int32) time.Duration {
+	if !cs.config.AdaptiveTimeouts {
+		return cs.config.Propose(round)
+	}
+	base := cs.adaptiveTimeouts.timeout(cs.adaptiveTimeouts.proposal, cs.config.TimeoutPropose)
+	cs.metrics.AdaptiveTimeoutSeconds.With("step", "propose").Set(base.Seconds())
+	return base + cs.config.TimeoutProposeDelta*time.Duration(round)
+}
+
+// prevoteTimeout returns the amount of time to wait for straggler prevotes
+// after receiving any +2/3 prevotes in the given round.
+func (cs *State) prevoteTimeout(round int32) time.Duration {
+	if !cs.config.AdaptiveTimeouts {
+		return cs.config.Prevote(round)
+	}
+	base := cs.adaptiveTimeouts.timeout(cs.adaptiveTimeouts.prevote, cs.config.TimeoutPrevote)
+	cs.metrics.AdaptiveTimeoutSeconds.With("step", "prevote").Set(base.Seconds())
+	return base + cs.config.TimeoutPrevoteDelta*time.Duration(round)
+}
+
+// precommitTimeout returns the amount of time to wait for straggler
+// precommits after receiving any +2/3 precommits in the given round.
+func (cs *State) precommitTimeout(round int32) time.Duration {
+	if !cs.config.AdaptiveTimeouts {
+		return cs.config.Precommit(round)
+	}
+	base := cs.adaptiveTimeouts.timeout(cs.adaptiveTimeouts.precommit, cs.config.TimeoutPrecommit)
+	cs.metrics.AdaptiveTimeoutSeconds.With("step", "precommit").Set(base.Seconds())
+	return base + cs.config.TimeoutPrecommitDelta*time.Duration(round)
+}
+
+// startLatency starts measuring a latency in the current round, at the point
+// where the corresponding timeout is scheduled.
+func (cs *State) startLatency(start *time.Time) {
+	if cs.replayMode {
+		return
+	}
+	*start = cmttime.Now()
+}
+
+// observeLatency completes a latency measurement of the given round, either
+// because the awaited proposal or votes were received or because the timeout
+// fired.
+func (cs *State) observeLatency(w *latencyWindow, start *time.Time, round int32) {
+	if cs.replayMode {
+		return
+	}
+	cs.adaptiveTimeouts.observe(w, start, round, cmttime.Now())
+}
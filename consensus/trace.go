@@ -0,0 +1,206 @@
+package consensus
+
+import (
+	"fmt"
+	"time"
+
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// TraceEventType is the kind of an event recorded in a consensus timeline.
+type TraceEventType string
+
+const (
+	// TraceEventStep is recorded on every round step transition.
+	TraceEventStep TraceEventType = "step"
+	// TraceEventTimeout is recorded when a scheduled timeout fires and causes
+	// a state transition.
+	TraceEventTimeout TraceEventType = "timeout"
+	// TraceEventProposal is recorded when a proposal is accepted.
+	TraceEventProposal TraceEventType = "proposal"
+	// TraceEventBlockPart is recorded when a proposal block part is added.
+	TraceEventBlockPart TraceEventType = "block_part"
+	// TraceEventVote is recorded when a vote is added.
+	TraceEventVote TraceEventType = "vote"
+	// TraceEventPrepareProposal is recorded once the proposal block has been
+	// created, which includes the PrepareProposal call to the application.
+	TraceEventPrepareProposal TraceEventType = "prepare_proposal"
+	// TraceEventProcessProposal is recorded once the application has
+	// answered a ProcessProposal call.
+	TraceEventProcessProposal TraceEventType = "process_proposal"
+)
+
+// TraceEvent is a single entry of a consensus timeline.
+type TraceEvent struct {
+	Time  time.Time      `json:"time"`
+	Type  TraceEventType `json:"type"`
+	Round int32          `json:"round"`
+	// Step is the round step entered, or the step a timeout was scheduled
+	// for.
+	Step string `json:"step,omitempty"`
+	// PeerID is the peer a proposal, block part or vote was received from.
+	// It is empty for messages originating from this node.
+	PeerID p2p.ID `json:"peer_id,omitempty"`
+	// Duration is the timeout duration, or the time spent in
+	// PrepareProposal / ProcessProposal.
+	Duration time.Duration `json:"duration,omitempty"`
+	// Info holds a short, type dependent description of the event (e.g. the
+	// vote type and validator index).
+	Info string `json:"info,omitempty"`
+}
+
+// HeightTrace is the timeline of the events that happened while deciding a
+// single height.
+type HeightTrace struct {
+	Height int64        `json:"height"`
+	Events []TraceEvent `json:"events"`
+	// DroppedEvents is the number of events which were not recorded because
+	// the per height limit was reached.
+	DroppedEvents int `json:"dropped_events"`
+}
+
+// traceEvent is an event as recorded by the consensus state. It holds the raw
+// fields of the event, which are only formatted into a TraceEvent when the
+// timeline is served, to keep the recording cheap.
+type traceEvent struct {
+	time     time.Time
+	typ      TraceEventType
+	round    int32
+	step     cstypes.RoundStepType
+	peerID   p2p.ID
+	duration time.Duration
+
+	// type dependent fields, see info
+	voteType  cmtproto.SignedMsgType
+	valIndex  int32
+	polRound  int32
+	partIndex uint32
+	blockID   types.BlockID
+	txs       int
+	accepted  bool
+}
+
+// info returns the type dependent description of the event.
+func (e *traceEvent) info() string {
+	switch e.typ {
+	case TraceEventProposal:
+		return fmt.Sprintf("pol_round=%d block=%v", e.polRound, e.blockID)
+	case TraceEventBlockPart:
+		return fmt.Sprintf("index=%d", e.partIndex)
+	case TraceEventVote:
+		return fmt.Sprintf("%v validator_index=%d block=%v", e.voteType, e.valIndex, e.blockID)
+	case TraceEventPrepareProposal:
+		return fmt.Sprintf("txs=%d", e.txs)
+	case TraceEventProcessProposal:
+		return fmt.Sprintf("accepted=%t", e.accepted)
+	default:
+		return ""
+	}
+}
+
+func (e *traceEvent) toTraceEvent() TraceEvent {
+	event := TraceEvent{
+		Time:     e.time,
+		Type:     e.typ,
+		Round:    e.round,
+		PeerID:   e.peerID,
+		Duration: e.duration,
+		Info:     e.info(),
+	}
+	if e.step != 0 {
+		event.Step = e.step.String()
+	}
+	return event
+}
+
+// heightTrace is the timeline of a height, as recorded.
+type heightTrace struct {
+	events  []traceEvent
+	dropped int
+}
+
+// traceRecorder keeps the consensus timeline of the most recent heights.
+// It is safe for concurrent use. A nil traceRecorder records nothing.
+type traceRecorder struct {
+	mtx            cmtsync.Mutex
+	maxHeights     int
+	maxEvents      int
+	heights        []int64 // recorded heights, in increasing order
+	tracesByHeight map[int64]*heightTrace
+}
+
+// newTraceRecorder returns a recorder keeping the timeline of the maxHeights
+// most recent heights, with at most maxEvents events each. Events past this
+// limit are counted, but dropped. It returns nil if maxHeights is not
+// positive.
+func newTraceRecorder(maxHeights, maxEvents int) *traceRecorder {
+	if maxHeights <= 0 {
+		return nil
+	}
+	return &traceRecorder{
+		maxHeights:     maxHeights,
+		maxEvents:      maxEvents,
+		tracesByHeight: make(map[int64]*heightTrace),
+	}
+}
+
+// record appends the event to the timeline of the given height. Events for a
+// height older than every recorded height are dropped, so that late messages
+// do not resurrect evicted timelines.
+func (tr *traceRecorder) record(height int64, event traceEvent) {
+	if tr == nil {
+		return
+	}
+
+	tr.mtx.Lock()
+	defer tr.mtx.Unlock()
+
+	trace, ok := tr.tracesByHeight[height]
+	if !ok {
+		if n := len(tr.heights); n > 0 && height < tr.heights[n-1] {
+			return
+		}
+		trace = &heightTrace{}
+		tr.tracesByHeight[height] = trace
+		tr.heights = append(tr.heights, height)
+		for len(tr.heights) > tr.maxHeights {
+			delete(tr.tracesByHeight, tr.heights[0])
+			tr.heights = tr.heights[1:]
+		}
+	}
+
+	if len(trace.events) >= tr.maxEvents {
+		trace.dropped++
+		return
+	}
+	trace.events = append(trace.events, event)
+}
+
+// get returns the formatted timeline of the given height, if it is still
+// kept.
+func (tr *traceRecorder) get(height int64) (*HeightTrace, bool) {
+	if tr == nil {
+		return nil, false
+	}
+
+	tr.mtx.Lock()
+	defer tr.mtx.Unlock()
+
+	trace, ok := tr.tracesByHeight[height]
+	if !ok {
+		return nil, false
+	}
+	events := make([]TraceEvent, len(trace.events))
+	for i := range trace.events {
+		events[i] = trace.events[i].toTraceEvent()
+	}
+	return &HeightTrace{
+		Height:        height,
+		Events:        events,
+		DroppedEvents: trace.dropped,
+	}, true
+}
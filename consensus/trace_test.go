@@ -0,0 +1,77 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+)
+
+func TestTraceRecorder(t *testing.T) {
+	tr := newTraceRecorder(2, 3)
+
+	for i := 0; i < 5; i++ {
+		tr.record(1, traceEvent{typ: TraceEventVote, round: int32(i)})
+	}
+	trace, ok := tr.get(1)
+	require.True(t, ok)
+	assert.Len(t, trace.Events, 3)
+	assert.Equal(t, 2, trace.DroppedEvents)
+
+	// the returned trace is a copy
+	trace.Events[0].Round = 100
+	trace, _ = tr.get(1)
+	assert.EqualValues(t, 0, trace.Events[0].Round)
+
+	tr.record(2, traceEvent{typ: TraceEventStep})
+	tr.record(3, traceEvent{typ: TraceEventStep})
+
+	// only the 2 most recent heights are kept
+	_, ok = tr.get(1)
+	assert.False(t, ok)
+	_, ok = tr.get(2)
+	assert.True(t, ok)
+	_, ok = tr.get(3)
+	assert.True(t, ok)
+
+	// late events for an evicted height are dropped
+	tr.record(1, traceEvent{typ: TraceEventVote})
+	_, ok = tr.get(1)
+	assert.False(t, ok)
+	_, ok = tr.get(2)
+	assert.True(t, ok)
+
+	// but late events for a kept height are recorded
+	tr.record(2, traceEvent{typ: TraceEventVote})
+	trace, _ = tr.get(2)
+	assert.Len(t, trace.Events, 2)
+}
+
+func TestTraceRecorderFormatsEvents(t *testing.T) {
+	tr := newTraceRecorder(1, 10)
+	tr.record(1, traceEvent{typ: TraceEventStep, step: cstypes.RoundStepPrevote})
+	tr.record(1, traceEvent{typ: TraceEventVote, voteType: cmtproto.PrevoteType, valIndex: 3})
+	tr.record(1, traceEvent{typ: TraceEventBlockPart, partIndex: 2})
+	tr.record(1, traceEvent{typ: TraceEventProcessProposal, accepted: true})
+
+	trace, ok := tr.get(1)
+	require.True(t, ok)
+	require.Len(t, trace.Events, 4)
+	assert.Equal(t, cstypes.RoundStepPrevote.String(), trace.Events[0].Step)
+	assert.Empty(t, trace.Events[0].Info)
+	assert.Contains(t, trace.Events[1].Info, "validator_index=3")
+	assert.Empty(t, trace.Events[1].Step)
+	assert.Equal(t, "index=2", trace.Events[2].Info)
+	assert.Equal(t, "accepted=true", trace.Events[3].Info)
+}
+
+func TestTraceRecorderDisabled(t *testing.T) {
+	tr := newTraceRecorder(0, 10000)
+	assert.Nil(t, tr)
+	tr.record(1, traceEvent{typ: TraceEventStep})
+	_, ok := tr.get(1)
+	assert.False(t, ok)
+}
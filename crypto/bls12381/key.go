@@ -118,3 +118,28 @@ func (PubKey) Type() string {
 func (PubKey) Equals(crypto.PubKey) bool {
 	panic("bls12_381 is disabled")
 }
+
+// ===============================================================================================
+// Batch Verifier
+// ===============================================================================================
+
+// Compile-time type assertion.
+var _ crypto.BatchVerifier = &BatchVerifier{}
+
+// BatchVerifier represents a BLS batch verifier noop when blst is not set as a build flag and cgo is disabled.
+type BatchVerifier struct{}
+
+// NewBatchVerifier returns a noop batch verifier.
+func NewBatchVerifier() crypto.BatchVerifier {
+	return &BatchVerifier{}
+}
+
+// Add returns ErrDisabled.
+func (*BatchVerifier) Add(crypto.PubKey, []byte, []byte) error {
+	return ErrDisabled
+}
+
+// Verify always panics.
+func (*BatchVerifier) Verify() (bool, []bool) {
+	panic("bls12_381 is disabled")
+}
@@ -233,3 +233,100 @@ func (pubkey *PubKey) UnmarshalJSON(bz []byte) error {
 	pubkey.pk = pk.pk
 	return nil
 }
+
+// ===============================================================================================
+// Batch Verifier
+// ===============================================================================================
+
+var _ crypto.BatchVerifier = &BatchVerifier{}
+
+// randBits is the number of random bits used to blind every signature in
+// BatchVerifier.Verify.
+const randBits = 64
+
+// BatchVerifier implements batch verification for BLS12-381.
+//
+// All signatures are checked in one multi-pairing, after being multiplied by
+// random scalars. This is safe even if several entries share the same
+// message, which happens for instance when two validators sign a vote with
+// the same timestamp.
+type BatchVerifier struct {
+	pubKeys    []*blstPublicKey
+	messages   []blst.Message
+	signatures []*blstSignature
+}
+
+// NewBatchVerifier returns a new, empty BLS12-381 batch verifier.
+func NewBatchVerifier() crypto.BatchVerifier {
+	return &BatchVerifier{}
+}
+
+// Add appends an entry into the BatchVerifier. A signature that cannot be
+// decoded is recorded as invalid rather than rejected, so that Verify reports
+// its position.
+func (b *BatchVerifier) Add(key crypto.PubKey, msg, signature []byte) error {
+	var pk *blstPublicKey
+	switch k := key.(type) {
+	case PubKey:
+		pk = k.pk
+	case *PubKey:
+		pk = k.pk
+	default:
+		return errors.New("pubkey is not BLS12-381")
+	}
+	if pk == nil {
+		return ErrDeserialization
+	}
+
+	if len(signature) != SignatureLength {
+		return errors.New("invalid signature")
+	}
+
+	b.pubKeys = append(b.pubKeys, pk)
+	b.messages = append(b.messages, msg)
+	b.signatures = append(b.signatures, new(blstSignature).Uncompress(signature))
+
+	return nil
+}
+
+// Verify verifies all the entries in the BatchVerifier. If the batch as a
+// whole does not verify, every signature is verified individually to find
+// the invalid ones.
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	n := len(b.pubKeys)
+	valid := make([]bool, n)
+	if n == 0 {
+		return false, valid
+	}
+
+	decoded := true
+	for _, sig := range b.signatures {
+		if sig == nil {
+			decoded = false
+			break
+		}
+	}
+
+	if decoded && new(blstSignature).MultipleAggregateVerify(
+		b.signatures, true, b.pubKeys, false, b.messages, dstMinPk, randScalar, randBits) {
+		for i := range valid {
+			valid[i] = true
+		}
+		return true, valid
+	}
+
+	for i, sig := range b.signatures {
+		valid[i] = sig != nil && sig.Verify(true, b.pubKeys[i], false, b.messages[i], dstMinPk)
+	}
+	return false, valid
+}
+
+// randScalar fills s with random bytes. Only the lowest randBits bits are
+// used by blst.
+func randScalar(s *blst.Scalar) {
+	var rbytes [blst.BLST_SCALAR_BYTES]byte
+	if _, err := rand.Read(rbytes[:]); err != nil {
+		panic(err)
+	}
+	s.FromBEndian(rbytes[:])
+}
@@ -5,6 +5,7 @@ package bls12381_test
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -234,3 +235,82 @@ func TestPubKey_NewPublicKeyFromInvalidBytes(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchVerifier(t *testing.T) {
+	v := bls12381.NewBatchVerifier()
+
+	const n = 10
+	valid := make([]bool, n)
+	for i := 0; i < n; i++ {
+		privKey, err := bls12381.GenPrivKey()
+		require.NoError(t, err)
+		defer privKey.Zeroize()
+
+		// half of the entries share the same message
+		msg := []byte("easter")
+		if i%2 == 1 {
+			msg = crypto.CRandBytes(32)
+		}
+		sig, err := privKey.Sign(msg)
+		require.NoError(t, err)
+		require.NoError(t, v.Add(privKey.PubKey(), msg, sig))
+		valid[i] = true
+	}
+
+	ok, got := v.Verify()
+	assert.True(t, ok)
+	assert.Equal(t, valid, got)
+
+	// an invalid signature is reported at its position
+	privKey, err := bls12381.GenPrivKey()
+	require.NoError(t, err)
+	defer privKey.Zeroize()
+	sig, err := privKey.Sign([]byte("egg"))
+	require.NoError(t, err)
+	require.NoError(t, v.Add(privKey.PubKey(), []byte("easter"), sig))
+	// a signature which is not a curve point is also reported
+	require.NoError(t, v.Add(privKey.PubKey(), []byte("egg"), make([]byte, bls12381.SignatureLength)))
+
+	ok, got = v.Verify()
+	assert.False(t, ok)
+	assert.Equal(t, append(valid, false, false), got)
+
+	// wrong signature length is rejected on Add
+	require.Error(t, v.Add(privKey.PubKey(), []byte("egg"), sig[1:]))
+}
+
+func BenchmarkVerifyBatch(b *testing.B) {
+	msg := []byte("BatchVerifyTest")
+
+	for _, sigsCount := range []int{1, 8, 64, 1024} {
+		b.Run(fmt.Sprintf("sig-count-%d", sigsCount), func(b *testing.B) {
+			// Pre-generate all of the keys, and signatures, but do not
+			// benchmark key-generation and signing.
+			pubs := make([]crypto.PubKey, 0, sigsCount)
+			sigs := make([][]byte, 0, sigsCount)
+			for i := 0; i < sigsCount; i++ {
+				priv, err := bls12381.GenPrivKey()
+				require.NoError(b, err)
+				sig, err := priv.Sign(msg)
+				require.NoError(b, err)
+				pubs = append(pubs, priv.PubKey())
+				sigs = append(sigs, sig)
+			}
+			b.ResetTimer()
+
+			b.ReportAllocs()
+			// NOTE: dividing by n so that metrics are per-signature
+			for i := 0; i < b.N/sigsCount; i++ {
+				v := bls12381.NewBatchVerifier()
+				for i := 0; i < sigsCount; i++ {
+					err := v.Add(pubs[i], msg, sigs[i])
+					require.NoError(b, err)
+				}
+
+				if ok, _ := v.Verify(); !ok {
+					b.Fatal("signature set failed batch verification")
+				}
+			}
+		})
+	}
+}
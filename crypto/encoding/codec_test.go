@@ -51,6 +51,11 @@ func TestPubKeyToFromProto(t *testing.T) {
 		assert.Equal(t, pk.Bytes(), pubkey.Bytes())
 		assert.Equal(t, pk.Address(), pubkey.Address())
 		assert.Equal(t, pk.VerifySignature([]byte("msg"), []byte("sig")), pubkey.VerifySignature([]byte("msg"), []byte("sig")))
+
+		// keys decoded from proto can be encoded again
+		proto2, err := PubKeyToProto(pubkey)
+		require.NoError(t, err)
+		assert.Equal(t, proto, proto2)
 	} else {
 		_, err = PubKeyToProto(bls12381.PubKey{})
 		assert.Error(t, err)
@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"crypto/subtle"
+	"errors"
 	"fmt"
 	"io"
 	"math/big"
+	"runtime"
+	"sync"
 
 	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
@@ -223,3 +226,84 @@ func signatureFromBytes(sigStr []byte) *ecdsa.Signature {
 	s.SetByteSlice(sigStr[32:64])
 	return ecdsa.NewSignature(&r, &s)
 }
+
+//-------------------------------------
+
+var _ crypto.BatchVerifier = &BatchVerifier{}
+
+// parallelVerifyThreshold is the minimum number of signatures for which
+// BatchVerifier spreads the verification over multiple goroutines. Below it,
+// the goroutine overhead outweighs the gain.
+const parallelVerifyThreshold = 4
+
+// BatchVerifier implements crypto.BatchVerifier for secp256k1.
+//
+// ECDSA has no native batch verification, so the signatures are verified
+// individually, spread over up to GOMAXPROCS goroutines.
+type BatchVerifier struct {
+	pubKeys    []PubKey
+	messages   [][]byte
+	signatures [][]byte
+}
+
+func NewBatchVerifier() crypto.BatchVerifier {
+	return &BatchVerifier{}
+}
+
+func (b *BatchVerifier) Add(key crypto.PubKey, msg, signature []byte) error {
+	pk, ok := key.(PubKey)
+	if !ok {
+		return fmt.Errorf("pubkey is not secp256k1")
+	}
+
+	if l := len(pk); l != PubKeySize {
+		return fmt.Errorf("pubkey size is incorrect; expected: %d, got %d", PubKeySize, l)
+	}
+
+	// check that the signature is the correct length
+	if len(signature) != 64 {
+		return errors.New("invalid signature")
+	}
+
+	b.pubKeys = append(b.pubKeys, pk)
+	b.messages = append(b.messages, msg)
+	b.signatures = append(b.signatures, signature)
+
+	return nil
+}
+
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	n := len(b.pubKeys)
+	valid := make([]bool, n)
+	if n == 0 {
+		return false, valid
+	}
+
+	workers := runtime.GOMAXPROCS(0)
+	if n < parallelVerifyThreshold || workers < 2 {
+		workers = 1
+	} else if workers > n {
+		workers = n
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func(w int) {
+			defer wg.Done()
+			// each worker verifies every workers-th entry, so that no two
+			// workers write to the same element of valid.
+			for i := w; i < n; i += workers {
+				valid[i] = b.pubKeys[i].VerifySignature(b.messages[i], b.signatures[i])
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for _, ok := range valid {
+		if !ok {
+			return false, valid
+		}
+	}
+	return true, valid
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 )
 
@@ -113,3 +114,37 @@ func TestGenPrivKeySecp256k1(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchVerifier(t *testing.T) {
+	v := secp256k1.NewBatchVerifier()
+
+	msgs := make([][]byte, 0, 10)
+	for i := 0; i < 10; i++ {
+		priv := secp256k1.GenPrivKey()
+		msg := crypto.CRandBytes(32)
+		sig, err := priv.Sign(msg)
+		require.NoError(t, err)
+
+		// corrupt the 4th message
+		if i == 3 {
+			msg = crypto.CRandBytes(32)
+		}
+		require.NoError(t, v.Add(priv.PubKey(), msg, sig))
+		msgs = append(msgs, msg)
+	}
+
+	ok, valid := v.Verify()
+	assert.False(t, ok)
+	require.Len(t, valid, len(msgs))
+	for i, ok := range valid {
+		assert.Equal(t, i != 3, ok, "signature %d", i)
+	}
+
+	// wrong key type and signature length are rejected on Add
+	require.Error(t, v.Add(ed25519.GenPrivKey().PubKey(), msgs[0], make([]byte, 64)))
+	require.Error(t, v.Add(secp256k1.GenPrivKey().PubKey(), msgs[0], make([]byte, 63)))
+
+	// an empty batch does not verify
+	ok, _ = secp256k1.NewBatchVerifier().Verify()
+	assert.False(t, ok)
+}
@@ -5,7 +5,7 @@ import (
 	"strings"
 
 	"github.com/cometbft/cometbft/crypto"
-
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 )
@@ -21,6 +21,16 @@ func init() {
 			return secp256k1.GenPrivKey(), nil
 		},
 	}
+
+	if bls12381.Enabled {
+		keyTypes[bls12381.KeyType] = func() (crypto.PrivKey, error) {
+			pk, err := bls12381.GenPrivKey()
+			if err != nil {
+				return nil, fmt.Errorf("failed to generate BLS key: %w", err)
+			}
+			return pk, nil
+		}
+	}
 }
 
 func GenPrivKey(keyType string) (crypto.PrivKey, error) {
@@ -31,6 +41,12 @@ func GenPrivKey(keyType string) (crypto.PrivKey, error) {
 	return genF()
 }
 
+// IsSupported returns true if keys of the given type can be generated.
+func IsSupported(keyType string) bool {
+	_, ok := keyTypes[keyType]
+	return ok
+}
+
 func SupportedKeyTypesStr() string {
 	keyTypesSlice := make([]string, 0, len(keyTypes))
 	for k := range keyTypes {
@@ -2,16 +2,24 @@
 // subscriptions in CometBFT.
 //
 //	abci.invoice.number=22 AND abci.invoice.owner=Ivan
+//	abci.invoice.owner IN ('Ivan', 'Igor') AND NOT abci.invoice.paid EXISTS
+//	(abci.invoice.number > 20 OR abci.invoice.owner STARTS_WITH 'Iv')
 //
 // Query expressions can handle attribute values encoding numbers, strings,
 // dates, and timestamps.  The complete query grammar is described in the
 // query/syntax package.
+//
+// Each condition of a query is satisfied if any of the events satisfies it.
+// Conditions are combined with AND, OR and NOT: for instance, NOT c is
+// satisfied if none of the events satisfies c.
 package query
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -22,19 +30,24 @@ import (
 // All is a query that matches all events.
 var All *Query
 
+// maxConjunctions is the maximum number of conjunctions in the disjunctive
+// normal form of a query.
+const maxConjunctions = 64
+
 // A Query is the compiled form of a query.
 type Query struct {
-	ast   syntax.Query
-	conds []condition
+	ast   syntax.Query // nil if expr is not a conjunction of conditions
+	expr  *syntax.Expr
+	match func(events []types.Event) bool
 }
 
 // New parses and compiles the query expression into an executable query.
 func New(query string) (*Query, error) {
-	ast, err := syntax.Parse(query)
+	expr, err := syntax.ParseExpr(query)
 	if err != nil {
 		return nil, err
 	}
-	return Compile(ast)
+	return CompileExpr(expr)
 }
 
 // MustCompile compiles the query expression into an executable query.
@@ -52,15 +65,71 @@ func MustCompile(query string) *Query {
 
 // Compile compiles the given query AST so it can be used to match events.
 func Compile(ast syntax.Query) (*Query, error) {
-	conds := make([]condition, len(ast))
-	for i, q := range ast {
-		cond, err := compileCondition(q)
+	if len(ast) == 0 {
+		return nil, errors.New("empty query")
+	}
+	if len(ast) == 1 {
+		return CompileExpr(&syntax.Expr{Cond: &ast[0]})
+	}
+	expr := &syntax.Expr{Op: syntax.TAnd, Sub: make([]*syntax.Expr, len(ast))}
+	for i := range ast {
+		expr.Sub[i] = &syntax.Expr{Cond: &ast[i]}
+	}
+	return CompileExpr(expr)
+}
+
+// CompileExpr compiles the given query expression so it can be used to match
+// events.
+func CompileExpr(expr *syntax.Expr) (*Query, error) {
+	match, err := compileExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+	ast, _ := expr.Conjunction()
+	return &Query{ast: ast, expr: expr, match: match}, nil
+}
+
+func compileExpr(expr *syntax.Expr) (func([]types.Event) bool, error) {
+	if expr.Cond != nil {
+		cond, err := compileCondition(*expr.Cond)
 		if err != nil {
-			return nil, fmt.Errorf("compile %s: %w", q, err)
+			return nil, fmt.Errorf("compile %s: %w", expr.Cond, err)
 		}
-		conds[i] = cond
+		return cond.matchesAny, nil
+	}
+
+	subs := make([]func([]types.Event) bool, len(expr.Sub))
+	for i, sub := range expr.Sub {
+		match, err := compileExpr(sub)
+		if err != nil {
+			return nil, err
+		}
+		subs[i] = match
+	}
+	switch {
+	case expr.Op == syntax.TNot && len(subs) == 1:
+		return func(events []types.Event) bool { return !subs[0](events) }, nil
+	case expr.Op == syntax.TAnd:
+		return func(events []types.Event) bool {
+			for _, match := range subs {
+				if !match(events) {
+					return false
+				}
+			}
+			return true
+		}, nil
+	case expr.Op == syntax.TOr:
+		return func(events []types.Event) bool {
+			for _, match := range subs {
+				if match(events) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid expression %s", expr)
 	}
-	return &Query{ast: ast, conds: conds}, nil
 }
 
 func ExpandEvents(flattenedEvents map[string][]string) []types.Event {
@@ -100,10 +169,11 @@ func (q *Query) String() string {
 	if q == nil {
 		return "<empty>"
 	}
-	return q.ast.String()
+	return q.expr.String()
 }
 
-// Syntax returns the syntax tree representation of q.
+// Syntax returns the syntax tree representation of q, if q is a conjunction
+// of conditions, and nil otherwise. Use Expr or DNF for any query.
 func (q *Query) Syntax() syntax.Query {
 	if q == nil {
 		return nil
@@ -111,14 +181,32 @@ func (q *Query) Syntax() syntax.Query {
 	return q.ast
 }
 
-// matchesEvents reports whether all the conditions match the given events.
-func (q *Query) matchesEvents(events []types.Event) bool {
-	for _, cond := range q.conds {
-		if !cond.matchesAny(events) {
-			return false
-		}
+// Expr returns the syntax tree representation of the expression of q.
+func (q *Query) Expr() *syntax.Expr {
+	if q == nil {
+		return nil
 	}
-	return len(events) != 0
+	return q.expr
+}
+
+// DNF returns the disjunctive normal form of q: q matches a set of events if
+// any of the returned conjunctions does. A conjunction of conditions, without
+// IN conditions, is returned as is. An error is returned if the normal form
+// is too large.
+func (q *Query) DNF() ([]syntax.Conjunction, error) {
+	if q == nil {
+		return []syntax.Conjunction{{}}, nil
+	}
+	if q.ast != nil && !slices.ContainsFunc(q.ast, func(c syntax.Condition) bool { return c.Op == syntax.TIn }) {
+		return []syntax.Conjunction{{Conditions: q.ast}}, nil
+	}
+	return q.expr.DNF(maxConjunctions)
+}
+
+// matchesEvents reports whether the query expression matches the given
+// events.
+func (q *Query) matchesEvents(events []types.Event) bool {
+	return len(events) != 0 && q.match(events)
 }
 
 // A condition is a compiled match condition.  A condition matches an event if
@@ -190,6 +278,30 @@ func compileCondition(cond syntax.Condition) (condition, error) {
 		return out, nil
 	}
 
+	// An IN condition matches if any of the equalities it stands for does.
+	if cond.Op == syntax.TIn {
+		if len(cond.Args) == 0 {
+			return condition{}, fmt.Errorf("missing arguments for %v", cond.Op)
+		}
+		var eqs []func(string) bool
+		for _, eq := range cond.Disjuncts() {
+			c, err := compileCondition(eq)
+			if err != nil {
+				return condition{}, err
+			}
+			eqs = append(eqs, c.match)
+		}
+		out.match = func(s string) bool {
+			for _, match := range eqs {
+				if match(s) {
+					return true
+				}
+			}
+			return false
+		}
+		return out, nil
+	}
+
 	// All the other operators require an argument.
 	if cond.Arg == nil {
 		return condition{}, fmt.Errorf("missing argument for %v", cond.Op)
@@ -250,6 +362,13 @@ var opTypeMap = map[syntax.Token]map[syntax.Token]func(any) func(string) bool{
 			}
 		},
 	},
+	syntax.TStartsWith: {
+		syntax.TString: func(v any) func(string) bool {
+			return func(s string) bool {
+				return strings.HasPrefix(s, v.(string))
+			}
+		},
+	},
 	syntax.TEq: {
 		syntax.TString: func(v any) func(string) bool {
 			return func(s string) bool { return s == v.(string) }
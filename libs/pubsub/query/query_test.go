@@ -478,6 +478,68 @@ func TestCompiledMatches(t *testing.T) {
 			`tm.event = 'Tx' AND rewards.withdraw.source = 'W'`,
 			apiEvents, false,
 		},
+
+		// OR, NOT, IN, STARTS_WITH and grouping.
+		{
+			`tx.gas = 7 OR tx.gas = 8`,
+			newTestEvents(`tx|gas=8`),
+			true,
+		},
+		{
+			`tx.gas = 7 OR tx.fee = 8`,
+			newTestEvents(`tx|gas=8`),
+			false,
+		},
+		{
+			`NOT tx.gas = 8`,
+			newTestEvents(`tx|gas=8`),
+			false,
+		},
+		{
+			`NOT tx.fee EXISTS`,
+			newTestEvents(`tx|gas=8`),
+			true,
+		},
+		{
+			`tx.gas IN (7, 8, 9)`,
+			newTestEvents(`tx|gas=8`),
+			true,
+		},
+		{
+			`transfer.sender IN ('AddrA', 'AddrB')`,
+			newTestEvents(`transfer|sender=AddrC`),
+			false,
+		},
+		{
+			`transfer.sender STARTS_WITH 'Addr'`,
+			newTestEvents(`transfer|sender=AddrC`),
+			true,
+		},
+		{
+			`transfer.sender STARTS_WITH 'C'`,
+			newTestEvents(`transfer|sender=AddrC`),
+			false,
+		},
+		{
+			`transfer.sender = 'AddrC' AND (tx.gas < 5 OR tx.gas > 7)`,
+			newTestEvents(`transfer|sender=AddrC`, `tx|gas=8`),
+			true,
+		},
+		{
+			`transfer.sender = 'AddrC' AND NOT (tx.gas < 5 OR tx.gas > 7)`,
+			newTestEvents(`transfer|sender=AddrC`, `tx|gas=8`),
+			false,
+		},
+		{
+			`rewards.withdraw.address = 'AddrA' AND NOT rewards.withdraw.source = 'SrcY'`,
+			apiEvents,
+			false,
+		},
+		{
+			`rewards.withdraw.address = 'AddrZ' OR rewards.withdraw.source IN ('SrcW', 'SrcX')`,
+			apiEvents,
+			true,
+		},
 	}
 
 	// NOTE: The original implementation allowed arbitrary prefix matches on
@@ -584,3 +646,27 @@ func splitKV(s string) (key, value string) {
 	kv := strings.SplitN(s, "=", 2)
 	return kv[0], kv[1]
 }
+
+func TestQueryDNF(t *testing.T) {
+	q := query.MustCompile(`a.b = 1 AND c.d = 'x'`)
+	require.NotNil(t, q.Syntax())
+	conjs, err := q.DNF()
+	require.NoError(t, err)
+	require.Len(t, conjs, 1)
+	require.Equal(t, q.Syntax(), syntax.Query(conjs[0].Conditions))
+
+	q = query.MustCompile(`a.b IN (1, 2) AND NOT c.d = 'x'`)
+	require.Nil(t, q.Syntax())
+	conjs, err = q.DNF()
+	require.NoError(t, err)
+	require.Len(t, conjs, 2)
+	for _, c := range conjs {
+		require.Len(t, c.Conditions, 1)
+		require.Equal(t, syntax.Token(syntax.TEq), c.Conditions[0].Op)
+		require.Len(t, c.Negated, 1)
+	}
+
+	q = query.MustCompile(`a IN (1, 2, 3, 4) AND b IN (1, 2, 3, 4) AND c IN (1, 2, 3, 4, 5)`)
+	_, err = q.DNF()
+	require.Error(t, err)
+}
@@ -5,14 +5,23 @@
 //
 // The grammar of the query language is defined by the following EBNF:
 //
-//	query      = conditions EOF
-//	conditions = condition {"AND" condition}
-//	condition  = tag comparison
-//	comparison = equal / order / contains / "EXISTS"
-//	equal      = "=" (date / number / time / value)
-//	order      = cmp (date / number / time)
-//	contains   = "CONTAINS" value
-//	cmp        = "<" / "<=" / ">" / ">="
+//	query       = disjunction EOF
+//	disjunction = conjunction {"OR" conjunction}
+//	conjunction = factor {"AND" factor}
+//	factor      = "NOT" factor / "(" disjunction ")" / condition
+//	condition   = tag comparison
+//	comparison  = equal / order / contains / prefix / in / "EXISTS"
+//	equal       = "=" (date / number / time / value)
+//	order       = cmp (date / number / time)
+//	contains    = "CONTAINS" value
+//	prefix      = "STARTS_WITH" value
+//	in          = "IN" "(" operand {"," operand} ")"
+//	operand     = date / number / time / value
+//	cmp         = "<" / "<=" / ">" / ">="
+//
+// NOT binds more tightly than AND, which binds more tightly than OR. A query
+// that is a conjunction of conditions is represented as a Query; any query is
+// represented as an Expr.
 //
 // The lexical terms are defined here using RE2 regular expression notation:
 //
@@ -14,8 +14,15 @@ func Parse(s string) (Query, error) {
 	return NewParser(strings.NewReader(s)).Parse()
 }
 
-// Query is the root of the parse tree for a query.  A query is the conjunction
-// of one or more conditions.
+// ParseExpr parses the specified query expression. It is shorthand for
+// constructing a parser for s and calling its ParseExpr method.
+func ParseExpr(s string) (*Expr, error) {
+	return NewParser(strings.NewReader(s)).ParseExpr()
+}
+
+// Query is the root of the parse tree for a query that is the conjunction of
+// one or more conditions. Queries combining conditions with OR, NOT or
+// parentheses are represented by an Expr.
 type Query []Condition
 
 func (q Query) String() string {
@@ -28,23 +35,197 @@ func (q Query) String() string {
 
 // A Condition is a single conditional expression, consisting of a tag, a
 // comparison operator, and an optional argument. The type of the argument
-// depends on the operator.
+// depends on the operator. The IN operator takes a list of arguments, in Args,
+// instead of a single one.
 type Condition struct {
-	Tag string
-	Op  Token
-	Arg *Arg
+	Tag  string
+	Op   Token
+	Arg  *Arg
+	Args []*Arg
 
 	opText string
 }
 
 func (c Condition) String() string {
 	s := c.Tag + " " + c.opText
+	if c.Op == TIn {
+		ss := make([]string, len(c.Args))
+		for i, arg := range c.Args {
+			ss[i] = arg.String()
+		}
+		return s + " (" + strings.Join(ss, ", ") + ")"
+	}
 	if c.Arg != nil {
 		return s + " " + c.Arg.String()
 	}
 	return s
 }
 
+// Disjuncts returns conditions whose disjunction is equivalent to c: an
+// equality condition for each argument of an IN condition, and c itself
+// otherwise.
+func (c Condition) Disjuncts() []Condition {
+	if c.Op != TIn {
+		return []Condition{c}
+	}
+	conds := make([]Condition, len(c.Args))
+	for i, arg := range c.Args {
+		conds[i] = Condition{Tag: c.Tag, Op: TEq, Arg: arg, opText: "="}
+	}
+	return conds
+}
+
+// An Expr is a node of the parse tree of a query expression. It is either a
+// single condition, or the combination of one or more sub-expressions by a
+// logical operator: AND and OR have two or more operands, NOT has one.
+type Expr struct {
+	Op   Token      // TAnd, TOr or TNot; unused if Cond is set
+	Cond *Condition // the condition, if the expression is a single condition
+	Sub  []*Expr    // the operands of Op
+}
+
+func (e *Expr) String() string {
+	if e.Cond != nil {
+		return e.Cond.String()
+	}
+	switch e.Op {
+	case TNot:
+		return "NOT " + e.Sub[0].operandString(TNot)
+	case TAnd, TOr:
+		ss := make([]string, len(e.Sub))
+		for i, sub := range e.Sub {
+			ss[i] = sub.operandString(e.Op)
+		}
+		if e.Op == TAnd {
+			return strings.Join(ss, " AND ")
+		}
+		return strings.Join(ss, " OR ")
+	default:
+		return ""
+	}
+}
+
+// operandString returns the string of e as an operand of op, parenthesized
+// if op binds more tightly than the operator of e.
+func (e *Expr) operandString(op Token) string {
+	if e.Cond == nil && precedence(e.Op) < precedence(op) {
+		return "(" + e.String() + ")"
+	}
+	return e.String()
+}
+
+// precedence returns the binding strength of the logical operator op.
+func precedence(op Token) int {
+	switch op {
+	case TOr:
+		return 1
+	case TAnd:
+		return 2
+	case TNot:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Conjunction returns e as a query, and reports whether e is a single
+// condition or a conjunction of conditions.
+func (e *Expr) Conjunction() (Query, bool) {
+	if e.Cond != nil {
+		return Query{*e.Cond}, true
+	}
+	if e.Op != TAnd {
+		return nil, false
+	}
+	q := make(Query, len(e.Sub))
+	for i, sub := range e.Sub {
+		if sub.Cond == nil {
+			return nil, false
+		}
+		q[i] = *sub.Cond
+	}
+	return q, true
+}
+
+// A Conjunction is a term of the disjunctive normal form of an expression. It
+// is satisfied if all of its conditions are satisfied, and none of its
+// negated conditions is.
+type Conjunction struct {
+	Conditions []Condition
+	Negated    []Condition
+}
+
+// DNF returns the disjunctive normal form of e: e is satisfied if any of the
+// returned conjunctions is. IN conditions are expanded into equalities. An
+// error is returned if the normal form has more than limit conjunctions.
+func (e *Expr) DNF(limit int) ([]Conjunction, error) {
+	return e.dnf(false, limit)
+}
+
+func (e *Expr) dnf(negated bool, limit int) ([]Conjunction, error) {
+	if e.Cond != nil {
+		disjuncts := e.Cond.Disjuncts()
+		if negated {
+			// NOT (a OR b) = NOT a AND NOT b
+			return []Conjunction{{Negated: disjuncts}}, nil
+		}
+		conjs := make([]Conjunction, len(disjuncts))
+		for i, c := range disjuncts {
+			conjs[i] = Conjunction{Conditions: []Condition{c}}
+		}
+		return checkLimit(conjs, limit)
+	}
+
+	if e.Op == TNot {
+		return e.Sub[0].dnf(!negated, limit)
+	}
+
+	// By De Morgan's laws, a negated AND is an OR of negated operands, and
+	// vice versa.
+	if (e.Op == TOr) != negated {
+		var conjs []Conjunction
+		for _, sub := range e.Sub {
+			subConjs, err := sub.dnf(negated, limit)
+			if err != nil {
+				return nil, err
+			}
+			conjs = append(conjs, subConjs...)
+			if _, err := checkLimit(conjs, limit); err != nil {
+				return nil, err
+			}
+		}
+		return conjs, nil
+	}
+
+	conjs := []Conjunction{{}}
+	for _, sub := range e.Sub {
+		subConjs, err := sub.dnf(negated, limit)
+		if err != nil {
+			return nil, err
+		}
+		product := make([]Conjunction, 0, len(conjs)*len(subConjs))
+		for _, c1 := range conjs {
+			for _, c2 := range subConjs {
+				product = append(product, Conjunction{
+					Conditions: append(append([]Condition{}, c1.Conditions...), c2.Conditions...),
+					Negated:    append(append([]Condition{}, c1.Negated...), c2.Negated...),
+				})
+			}
+		}
+		if conjs, err = checkLimit(product, limit); err != nil {
+			return nil, err
+		}
+	}
+	return conjs, nil
+}
+
+func checkLimit(conjs []Conjunction, limit int) ([]Conjunction, error) {
+	if len(conjs) > limit {
+		return nil, fmt.Errorf("query expands to more than %d alternatives", limit)
+	}
+	return conjs, nil
+}
+
 // An Arg is the argument of a comparison operator.
 type Arg struct {
 	Type Token
@@ -129,6 +310,7 @@ func (a *Arg) Value() string {
 // defined in the syntax package documentation.
 type Parser struct {
 	scanner *Scanner
+	eof     bool // whether the scanner reached the end of the input
 }
 
 // NewParser constructs a new parser that reads the input from r.
@@ -136,34 +318,127 @@ func NewParser(r io.Reader) *Parser {
 	return &Parser{scanner: NewScanner(r)}
 }
 
-// Parse parses the complete input and returns the resulting query.
+// Parse parses the complete input and returns the resulting query. It reports
+// an error if the input is not a conjunction of conditions; use ParseExpr to
+// parse queries using OR, NOT or parentheses.
 func (p *Parser) Parse() (Query, error) {
-	cond, err := p.parseCond()
+	expr, err := p.ParseExpr()
 	if err != nil {
 		return nil, err
 	}
-	conds := []Condition{cond}
-	for p.scanner.Next() != io.EOF {
-		if tok := p.scanner.Token(); tok != TAnd {
-			return nil, fmt.Errorf("offset %d: got %v, want %v", p.scanner.Pos(), tok, TAnd)
+	q, ok := expr.Conjunction()
+	if !ok {
+		return nil, fmt.Errorf("query %q is not a conjunction of conditions", expr)
+	}
+	return q, nil
+}
+
+// ParseExpr parses the complete input and returns the resulting expression.
+func (p *Parser) ParseExpr() (*Expr, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.eof {
+		return nil, fmt.Errorf("offset %d: got %v, want %v", p.scanner.Pos(), p.scanner.Token(), tokLabel([]Token{TAnd, TOr}))
+	}
+	return expr, nil
+}
+
+// parseOr parses a disjunction: and {OR and}.
+func (p *Parser) parseOr() (*Expr, error) {
+	return p.parseBinary(TOr, p.parseAnd)
+}
+
+// parseAnd parses a conjunction: factor {AND factor}.
+func (p *Parser) parseAnd() (*Expr, error) {
+	return p.parseBinary(TAnd, p.parseFactor)
+}
+
+// parseBinary parses one or more operands joined by op. Operands that are
+// themselves joined by op, in parentheses, are flattened.
+func (p *Parser) parseBinary(op Token, parseOperand func() (*Expr, error)) (*Expr, error) {
+	var operands []*Expr
+	for {
+		operand, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		if operand.Cond == nil && operand.Op == op {
+			operands = append(operands, operand.Sub...)
+		} else {
+			operands = append(operands, operand)
+		}
+		if p.eof || p.scanner.Token() != op {
+			break
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return &Expr{Op: op, Sub: operands}, nil
+}
+
+// parseFactor parses a negation, a parenthesized expression or a condition.
+func (p *Parser) parseFactor() (*Expr, error) {
+	if p.eof {
+		return nil, fmt.Errorf("offset %d: unexpected end of input", p.scanner.Pos())
+	}
+	switch p.scanner.Token() {
+	case TNot:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		sub, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return &Expr{Op: TNot, Sub: []*Expr{sub}}, nil
+
+	case TLParen:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.eof || p.scanner.Token() != TRParen {
+			return nil, fmt.Errorf("offset %d: missing %v", p.scanner.Pos(), Token(TRParen))
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
 		}
+		return expr, nil
+
+	case TTag:
 		cond, err := p.parseCond()
 		if err != nil {
 			return nil, err
 		}
-		conds = append(conds, cond)
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		return &Expr{Cond: &cond}, nil
+
+	default:
+		return nil, fmt.Errorf("offset %d: got %v, wanted %s",
+			p.scanner.Pos(), p.scanner.Token(), tokLabel([]Token{TTag, TNot, TLParen}))
 	}
-	return conds, nil
 }
 
-// parseCond parses a conditional expression: tag OP value.
+// parseCond parses a conditional expression: tag OP value. The current token
+// is the tag.
 func (p *Parser) parseCond() (Condition, error) {
 	var cond Condition
-	if err := p.require(TTag); err != nil {
-		return cond, err
-	}
 	cond.Tag = p.scanner.Text()
-	if err := p.require(TLeq, TGeq, TLt, TGt, TEq, TContains, TExists); err != nil {
+	if err := p.require(TLeq, TGeq, TLt, TGt, TEq, TContains, TStartsWith, TIn, TExists); err != nil {
 		return cond, err
 	}
 	cond.Op = p.scanner.Token()
@@ -175,8 +450,11 @@ func (p *Parser) parseCond() (Condition, error) {
 		err = p.require(TNumber, TTime, TDate)
 	case TEq:
 		err = p.require(TNumber, TTime, TDate, TString)
-	case TContains:
+	case TContains, TStartsWith:
 		err = p.require(TString)
+	case TIn:
+		cond.Args, err = p.parseArgList()
+		return cond, err
 	case TExists:
 		// no argument
 		return cond, nil
@@ -190,6 +468,41 @@ func (p *Parser) parseCond() (Condition, error) {
 	return cond, nil
 }
 
+// parseArgList parses the parenthesized list of arguments of an IN
+// condition: "(" value {"," value} ")".
+func (p *Parser) parseArgList() ([]*Arg, error) {
+	if err := p.require(TLParen); err != nil {
+		return nil, err
+	}
+	var args []*Arg
+	for {
+		if err := p.require(TNumber, TTime, TDate, TString); err != nil {
+			return nil, err
+		}
+		args = append(args, &Arg{Type: p.scanner.Token(), text: p.scanner.Text()})
+		if err := p.require(TComma, TRParen); err != nil {
+			return nil, err
+		}
+		if p.scanner.Token() == TRParen {
+			return args, nil
+		}
+	}
+}
+
+// advance advances the scanner to the next token, recording whether the end
+// of the input was reached.
+func (p *Parser) advance() error {
+	err := p.scanner.Next()
+	if err == io.EOF {
+		p.eof = true
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("offset %d: %w", p.scanner.Pos(), err)
+	}
+	return nil
+}
+
 // require advances the scanner and requires that the resulting token is one of
 // the specified token types.
 func (p *Parser) require(tokens ...Token) error {
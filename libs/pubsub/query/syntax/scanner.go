@@ -30,6 +30,14 @@ const (
 	TGeq             // operator: >=
 
 	// Do not reorder these values without updating the scanner code.
+
+	TOr         // operator: OR
+	TNot        // operator: NOT
+	TIn         // operator: IN
+	TStartsWith // operator: STARTS_WITH
+	TLParen     // delimiter: (
+	TRParen     // delimiter: )
+	TComma      // delimiter: ,
 )
 
 var tString = [...]string{
@@ -47,6 +55,14 @@ var tString = [...]string{
 	TLeq:      "<= operator",
 	TGt:       "> operator",
 	TGeq:      ">= operator",
+
+	TOr:         "OR operator",
+	TNot:        "NOT operator",
+	TIn:         "IN operator",
+	TStartsWith: "STARTS_WITH operator",
+	TLParen:     "(",
+	TRParen:     ")",
+	TComma:      ",",
 }
 
 func (t Token) String() string {
@@ -107,6 +123,8 @@ func (s *Scanner) Next() error {
 			return s.scanString(ch)
 		case '<', '>', '=':
 			return s.scanCompare(ch)
+		case '(', ')', ',':
+			return s.scanDelimiter(ch)
 		default:
 			return s.invalid(ch)
 		}
@@ -193,6 +211,21 @@ func (s *Scanner) scanCompare(first rune) error {
 	return nil
 }
 
+func (s *Scanner) scanDelimiter(ch rune) error {
+	s.buf.WriteRune(ch)
+	switch ch {
+	case '(':
+		s.tok = TLParen
+	case ')':
+		s.tok = TRParen
+	case ',':
+		s.tok = TComma
+	default:
+		return s.invalid(ch)
+	}
+	return nil
+}
+
 func (s *Scanner) scanTagLike(first rune) error {
 	s.buf.WriteRune(first)
 	var hasSpace bool
@@ -224,6 +257,14 @@ func (s *Scanner) scanTagLike(first rune) error {
 		s.tok = TTag
 	case "AND":
 		s.tok = TAnd
+	case "OR":
+		s.tok = TOr
+	case "NOT":
+		s.tok = TNot
+	case "IN":
+		s.tok = TIn
+	case "STARTS_WITH":
+		s.tok = TStartsWith
 	case "EXISTS":
 		s.tok = TExists
 	case "CONTAINS":
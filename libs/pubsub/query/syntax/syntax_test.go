@@ -42,6 +42,12 @@ func TestScanner(t *testing.T) {
 		{`x.y CONTAINS 'z'`, []syntax.Token{syntax.TTag, syntax.TContains, syntax.TString}},
 		{`foo EXISTS`, []syntax.Token{syntax.TTag, syntax.TExists}},
 		{`and AND`, []syntax.Token{syntax.TTag, syntax.TAnd}},
+		{`x OR NOT y`, []syntax.Token{syntax.TTag, syntax.TOr, syntax.TNot, syntax.TTag}},
+		{`x STARTS_WITH 'z'`, []syntax.Token{syntax.TTag, syntax.TStartsWith, syntax.TString}},
+		{`x IN ('a',1)`, []syntax.Token{
+			syntax.TTag, syntax.TIn, syntax.TLParen, syntax.TString, syntax.TComma, syntax.TNumber, syntax.TRParen,
+		}},
+		{`(x EXISTS)`, []syntax.Token{syntax.TLParen, syntax.TTag, syntax.TExists, syntax.TRParen}},
 
 		// Timestamp
 		{`TIME 2021-11-23T15:16:17Z`, []syntax.Token{syntax.TTime}},
@@ -192,3 +198,128 @@ func TestParseValid(t *testing.T) {
 		}
 	}
 }
+
+func TestParseExprValid(t *testing.T) {
+	tests := []struct {
+		input string
+		valid bool
+	}{
+		{"a.b = 1 OR a.c = 2", true},
+		{"a.b = 1 OR", false},
+		{"OR a.b = 1", false},
+		{"NOT a.b EXISTS", true},
+		{"NOT NOT a.b EXISTS", true},
+		{"a.b = 1 AND NOT (a.c = 2 OR a.d < 3)", true},
+		{"(a.b = 1", false},
+		{"a.b = 1)", false},
+		{"()", false},
+		{"((a.b = 1) AND (a.c CONTAINS 'x'))", true},
+		{"a.b IN ('x', 'y', 3)", true},
+		{"a.b IN ('x')", true},
+		{"a.b IN ()", false},
+		{"a.b IN ('x',)", false},
+		{"a.b IN 'x'", false},
+		{"a.b IN ('x' 'y')", false},
+		{"a.b IN (DATE 2013-05-03, TIME 2013-05-03T14:45:00Z)", true},
+		{"a.b STARTS_WITH 'x'", true},
+		{"a.b STARTS_WITH 1", false},
+		{"a.b = 1 OR a.c = 2 AND NOT a.d EXISTS", true},
+	}
+
+	for _, test := range tests {
+		e, err := syntax.ParseExpr(test.input)
+		if test.valid != (err == nil) {
+			t.Errorf("ParseExpr %#q: valid %v got err=%v", test.input, test.valid, err)
+		}
+
+		// For valid expressions, check that the expression round-trips.
+		if test.valid {
+			estr := e.String()
+			r, err := syntax.ParseExpr(estr)
+			if err != nil {
+				t.Errorf("Reparse %#q failed: %v", estr, err)
+				continue
+			}
+			if rstr := r.String(); rstr != estr {
+				t.Errorf("Reparse diff\nold: %#q\nnew: %#q", estr, rstr)
+			}
+		}
+	}
+}
+
+func TestParseRejectsExpressions(t *testing.T) {
+	for _, input := range []string{
+		"a.b = 1 OR a.c = 2",
+		"NOT a.b EXISTS",
+	} {
+		if _, err := syntax.Parse(input); err == nil {
+			t.Errorf("Parse %#q: got nil error, want error", input)
+		}
+	}
+
+	// Grouping a conjunction does not change its meaning.
+	q, err := syntax.Parse("(a.b = 1 AND a.c = 2) AND a.d EXISTS")
+	if err != nil {
+		t.Fatalf("Parse: unexpected error: %v", err)
+	}
+	if got, want := q.String(), "a.b = 1 AND a.c = 2 AND a.d EXISTS"; got != want {
+		t.Errorf("Parse: got %#q, want %#q", got, want)
+	}
+}
+
+func TestDNF(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string // one string per conjunction: conditions, then negated
+	}{
+		{"a = 1", []string{"a = 1"}},
+		{"a = 1 AND b = 2", []string{"a = 1 AND b = 2"}},
+		{"a = 1 OR b = 2", []string{"a = 1", "b = 2"}},
+		{"a IN (1, 2) AND b = 3", []string{"a = 1 AND b = 3", "a = 2 AND b = 3"}},
+		{"a = 1 AND NOT b = 2", []string{"a = 1 AND NOT b = 2"}},
+		{"a = 1 AND NOT (b = 2 OR c = 3)", []string{"a = 1 AND NOT b = 2 AND NOT c = 3"}},
+		{"a = 1 AND NOT (b = 2 AND c = 3)", []string{"a = 1 AND NOT b = 2", "a = 1 AND NOT c = 3"}},
+		{"a = 1 AND NOT b IN (2, 3)", []string{"a = 1 AND NOT b = 2 AND NOT b = 3"}},
+		{"(a = 1 OR b = 2) AND (c = 3 OR d = 4)", []string{
+			"a = 1 AND c = 3", "a = 1 AND d = 4", "b = 2 AND c = 3", "b = 2 AND d = 4",
+		}},
+	}
+
+	for _, test := range tests {
+		e, err := syntax.ParseExpr(test.input)
+		if err != nil {
+			t.Fatalf("ParseExpr %#q: unexpected error: %v", test.input, err)
+		}
+		conjs, err := e.DNF(16)
+		if err != nil {
+			t.Fatalf("DNF %#q: unexpected error: %v", test.input, err)
+		}
+		var got []string
+		for _, c := range conjs {
+			parts := []string{}
+			for _, cond := range c.Conditions {
+				parts = append(parts, cond.String())
+			}
+			for _, cond := range c.Negated {
+				parts = append(parts, "NOT "+cond.String())
+			}
+			got = append(got, strings.Join(parts, " AND "))
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("DNF %#q:\ngot:  %q\nwant: %q", test.input, got, test.want)
+		}
+	}
+}
+
+func TestDNFLimit(t *testing.T) {
+	e, err := syntax.ParseExpr("a IN (1, 2, 3) AND b IN (1, 2, 3) AND c IN (1, 2, 3)")
+	if err != nil {
+		t.Fatalf("ParseExpr: unexpected error: %v", err)
+	}
+	if _, err := e.DNF(27); err != nil {
+		t.Errorf("DNF(27): unexpected error: %v", err)
+	}
+	if _, err := e.DNF(26); err == nil {
+		t.Error("DNF(26): got nil error, want error")
+	}
+}
@@ -125,6 +125,18 @@ func MaxBlockLag(d time.Duration) Option {
 	}
 }
 
+// SignatureCache option sets the cache of verified signatures used by the
+// client. It allows sharing the cache with other components of a node
+// verifying commits (consensus, blocksync). Default: a cache private to the
+// client.
+func SignatureCache(cache *types.SignatureCache) Option {
+	return func(c *Client) {
+		if cache != nil {
+			c.signatureCache = cache
+		}
+	}
+}
+
 // Client represents a light client, connected to a single chain, which gets
 // light blocks from a primary provider, verifies them either sequentially or by
 // skipping some and stores them in a trusted store (usually, a local FS).
@@ -155,6 +167,8 @@ type Client struct {
 	pruningSize uint16
 	// See ConfirmationFunction option
 	confirmationFn func(action string) bool
+	// See SignatureCache option
+	signatureCache *types.SignatureCache
 
 	quit chan struct{}
 
@@ -230,6 +244,7 @@ func NewClientFromTrustedStore(
 		trustedStore:     trustedStore,
 		pruningSize:      defaultPruningSize,
 		confirmationFn:   func(action string) bool { return true },
+		signatureCache:   types.NewSignatureCache(),
 		quit:             make(chan struct{}),
 		logger:           log.NewNopLogger(),
 	}
@@ -641,8 +656,8 @@ func (c *Client) verifySequential(
 			"newHeight", interimBlock.Height,
 			"newHash", interimBlock.Hash())
 
-		err = VerifyAdjacent(verifiedBlock.SignedHeader, interimBlock.SignedHeader, interimBlock.ValidatorSet,
-			c.trustingPeriod, now, c.maxClockDrift)
+		err = verifyAdjacent(verifiedBlock.SignedHeader, interimBlock.SignedHeader, interimBlock.ValidatorSet,
+			c.trustingPeriod, now, c.maxClockDrift, c.signatureCache)
 		if err != nil {
 			err := ErrVerificationFailed{From: verifiedBlock.Height, To: interimBlock.Height, Reason: err}
 
@@ -725,8 +740,8 @@ func (c *Client) verifySkipping(
 			"newHeight", blockCache[depth].Height,
 			"newHash", blockCache[depth].Hash())
 
-		err := Verify(verifiedBlock.SignedHeader, verifiedBlock.ValidatorSet, blockCache[depth].SignedHeader,
-			blockCache[depth].ValidatorSet, c.trustingPeriod, now, c.maxClockDrift, c.trustLevel)
+		err := verify(verifiedBlock.SignedHeader, verifiedBlock.ValidatorSet, blockCache[depth].SignedHeader,
+			blockCache[depth].ValidatorSet, c.trustingPeriod, now, c.maxClockDrift, c.trustLevel, c.signatureCache)
 		switch err.(type) {
 		case nil:
 			// Have we verified the last header
@@ -1,6 +1,8 @@
 package proxy
 
 import (
+	"errors"
+
 	"github.com/cometbft/cometbft/libs/bytes"
 	lrpc "github.com/cometbft/cometbft/light/rpc"
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
@@ -28,26 +30,36 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"header":               rpcserver.NewRPCFunc(makeHeaderFunc(c), "height", rpcserver.Cacheable("height")),
 		"header_by_hash":       rpcserver.NewRPCFunc(makeHeaderByHashFunc(c), "hash", rpcserver.Cacheable()),
 		"block_by_hash":        rpcserver.NewRPCFunc(makeBlockByHashFunc(c), "hash", rpcserver.Cacheable()),
-		"block_results":        rpcserver.NewRPCFunc(makeBlockResultsFunc(c), "height", rpcserver.Cacheable("height")),
+		"block_results":        rpcserver.NewRPCFunc(makeBlockResultsFunc(c), "height,prove", rpcserver.Cacheable("height")),
 		"commit":               rpcserver.NewRPCFunc(makeCommitFunc(c), "height", rpcserver.Cacheable("height")),
 		"tx":                   rpcserver.NewRPCFunc(makeTxFunc(c), "hash,prove", rpcserver.Cacheable()),
-		"tx_search":            rpcserver.NewRPCFunc(makeTxSearchFunc(c), "query,prove,page,per_page,order_by"),
-		"block_search":         rpcserver.NewRPCFunc(makeBlockSearchFunc(c), "query,page,per_page,order_by"),
+		"tx_result_proof":      rpcserver.NewRPCFunc(makeTxResultProofFunc(c), "hash", rpcserver.Cacheable()),
+		"tx_search":            rpcserver.NewRPCFunc(makeTxSearchFunc(c), "query,prove,page,per_page,order_by,cursor"),
+		"block_search":         rpcserver.NewRPCFunc(makeBlockSearchFunc(c), "query,page,per_page,order_by,cursor"),
 		"validators":           rpcserver.NewRPCFunc(makeValidatorsFunc(c), "height,page,per_page", rpcserver.Cacheable("height")),
 		"dump_consensus_state": rpcserver.NewRPCFunc(makeDumpConsensusStateFunc(c), ""),
 		"consensus_state":      rpcserver.NewRPCFunc(makeConsensusStateFunc(c), ""),
+		"consensus_trace":      rpcserver.NewRPCFunc(makeConsensusTraceFunc(c), "height"),
 		"consensus_params":     rpcserver.NewRPCFunc(makeConsensusParamsFunc(c), "height", rpcserver.Cacheable("height")),
 		"unconfirmed_txs":      rpcserver.NewRPCFunc(makeUnconfirmedTxsFunc(c), "limit"),
 		"num_unconfirmed_txs":  rpcserver.NewRPCFunc(makeNumUnconfirmedTxsFunc(c), ""),
 
+		// history API
+		"validator_set_changes":    rpcserver.NewRPCFunc(makeValidatorSetChangesFunc(c), "from,to"),
+		"consensus_params_changes": rpcserver.NewRPCFunc(makeConsensusParamsChangesFunc(c), "from,to"),
+
 		// tx broadcast API
 		"broadcast_tx_commit": rpcserver.NewRPCFunc(makeBroadcastTxCommitFunc(c), "tx"),
 		"broadcast_tx_sync":   rpcserver.NewRPCFunc(makeBroadcastTxSyncFunc(c), "tx"),
 		"broadcast_tx_async":  rpcserver.NewRPCFunc(makeBroadcastTxAsyncFunc(c), "tx"),
+		"broadcast_txs":       rpcserver.NewRPCFunc(makeBroadcastTxsFunc(c), "txs,async"),
+		"broadcast_tx_track":  rpcserver.NewRPCFunc(makeBroadcastTxTrackFunc(c), "tx,webhook"),
+		"tx_track":            rpcserver.NewRPCFunc(makeTxTrackFunc(c), "id"),
 
 		// abci API
-		"abci_query": rpcserver.NewRPCFunc(makeABCIQueryFunc(c), "path,data,height,prove"),
-		"abci_info":  rpcserver.NewRPCFunc(makeABCIInfoFunc(c), "", rpcserver.Cacheable()),
+		"abci_query":  rpcserver.NewRPCFunc(makeABCIQueryFunc(c), "path,data,height,prove"),
+		"abci_info":   rpcserver.NewRPCFunc(makeABCIInfoFunc(c), "", rpcserver.Cacheable()),
+		"simulate_tx": rpcserver.NewRPCFunc(makeSimulateTxFunc(c), "tx"),
 
 		// evidence API
 		"broadcast_evidence": rpcserver.NewRPCFunc(makeBroadcastEvidenceFunc(c), "evidence"),
@@ -134,10 +146,13 @@ func makeBlockByHashFunc(c *lrpc.Client) rpcBlockByHashFunc {
 	}
 }
 
-type rpcBlockResultsFunc func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultBlockResults, error)
+type rpcBlockResultsFunc func(ctx *rpctypes.Context, height *int64, prove bool) (*ctypes.ResultBlockResults, error)
 
 func makeBlockResultsFunc(c *lrpc.Client) rpcBlockResultsFunc {
-	return func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	return func(ctx *rpctypes.Context, height *int64, prove bool) (*ctypes.ResultBlockResults, error) {
+		if prove {
+			return c.BlockResultsWithProofs(ctx.Context(), height)
+		}
 		return c.BlockResults(ctx.Context(), height)
 	}
 }
@@ -158,12 +173,21 @@ func makeTxFunc(c *lrpc.Client) rpcTxFunc {
 	}
 }
 
+type rpcTxResultProofFunc func(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultTxResultProof, error)
+
+func makeTxResultProofFunc(c *lrpc.Client) rpcTxResultProofFunc {
+	return func(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultTxResultProof, error) {
+		return c.TxResultProof(ctx.Context(), hash)
+	}
+}
+
 type rpcTxSearchFunc func(
 	ctx *rpctypes.Context,
 	query string,
 	prove bool,
 	page, perPage *int,
 	orderBy string,
+	cursor string,
 ) (*ctypes.ResultTxSearch, error)
 
 func makeTxSearchFunc(c *lrpc.Client) rpcTxSearchFunc {
@@ -173,7 +197,14 @@ func makeTxSearchFunc(c *lrpc.Client) rpcTxSearchFunc {
 		prove bool,
 		page, perPage *int,
 		orderBy string,
+		cursor string,
 	) (*ctypes.ResultTxSearch, error) {
+		if cursor != "" {
+			if page != nil {
+				return nil, errors.New("page and cursor cannot be used together")
+			}
+			return c.TxSearchAfter(ctx.Context(), query, prove, cursor, perPage, orderBy)
+		}
 		return c.TxSearch(ctx.Context(), query, prove, page, perPage, orderBy)
 	}
 }
@@ -181,19 +212,25 @@ func makeTxSearchFunc(c *lrpc.Client) rpcTxSearchFunc {
 type rpcBlockSearchFunc func(
 	ctx *rpctypes.Context,
 	query string,
-	prove bool,
 	page, perPage *int,
 	orderBy string,
+	cursor string,
 ) (*ctypes.ResultBlockSearch, error)
 
 func makeBlockSearchFunc(c *lrpc.Client) rpcBlockSearchFunc {
 	return func(
 		ctx *rpctypes.Context,
 		query string,
-		prove bool,
 		page, perPage *int,
 		orderBy string,
+		cursor string,
 	) (*ctypes.ResultBlockSearch, error) {
+		if cursor != "" {
+			if page != nil {
+				return nil, errors.New("page and cursor cannot be used together")
+			}
+			return c.BlockSearchAfter(ctx.Context(), query, cursor, perPage, orderBy)
+		}
 		return c.BlockSearch(ctx.Context(), query, page, perPage, orderBy)
 	}
 }
@@ -207,6 +244,14 @@ func makeValidatorsFunc(c *lrpc.Client) rpcValidatorsFunc {
 	}
 }
 
+type rpcValidatorSetChangesFunc func(ctx *rpctypes.Context, from, to *int64) (*ctypes.ResultValidatorSetChanges, error)
+
+func makeValidatorSetChangesFunc(c *lrpc.Client) rpcValidatorSetChangesFunc {
+	return func(ctx *rpctypes.Context, from, to *int64) (*ctypes.ResultValidatorSetChanges, error) {
+		return c.ValidatorSetChanges(ctx.Context(), from, to)
+	}
+}
+
 type rpcDumpConsensusStateFunc func(ctx *rpctypes.Context) (*ctypes.ResultDumpConsensusState, error)
 
 func makeDumpConsensusStateFunc(c *lrpc.Client) rpcDumpConsensusStateFunc {
@@ -223,6 +268,14 @@ func makeConsensusStateFunc(c *lrpc.Client) rpcConsensusStateFunc {
 	}
 }
 
+type rpcConsensusTraceFunc func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultConsensusTrace, error)
+
+func makeConsensusTraceFunc(c *lrpc.Client) rpcConsensusTraceFunc {
+	return func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultConsensusTrace, error) {
+		return c.ConsensusTrace(ctx.Context(), height)
+	}
+}
+
 type rpcConsensusParamsFunc func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultConsensusParams, error)
 
 func makeConsensusParamsFunc(c *lrpc.Client) rpcConsensusParamsFunc {
@@ -231,6 +284,14 @@ func makeConsensusParamsFunc(c *lrpc.Client) rpcConsensusParamsFunc {
 	}
 }
 
+type rpcConsensusParamsChangesFunc func(ctx *rpctypes.Context, from, to *int64) (*ctypes.ResultConsensusParamsChanges, error)
+
+func makeConsensusParamsChangesFunc(c *lrpc.Client) rpcConsensusParamsChangesFunc {
+	return func(ctx *rpctypes.Context, from, to *int64) (*ctypes.ResultConsensusParamsChanges, error) {
+		return c.ConsensusParamsChanges(ctx.Context(), from, to)
+	}
+}
+
 type rpcUnconfirmedTxsFunc func(ctx *rpctypes.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error)
 
 func makeUnconfirmedTxsFunc(c *lrpc.Client) rpcUnconfirmedTxsFunc {
@@ -271,6 +332,30 @@ func makeBroadcastTxAsyncFunc(c *lrpc.Client) rpcBroadcastTxAsyncFunc {
 	}
 }
 
+type rpcBroadcastTxsFunc func(ctx *rpctypes.Context, txs []types.Tx, async bool) (*ctypes.ResultBroadcastTxs, error)
+
+func makeBroadcastTxsFunc(c *lrpc.Client) rpcBroadcastTxsFunc {
+	return func(ctx *rpctypes.Context, txs []types.Tx, async bool) (*ctypes.ResultBroadcastTxs, error) {
+		return c.BroadcastTxs(ctx.Context(), txs, async)
+	}
+}
+
+type rpcBroadcastTxTrackFunc func(ctx *rpctypes.Context, tx types.Tx, webhook string) (*ctypes.ResultBroadcastTxTrack, error)
+
+func makeBroadcastTxTrackFunc(c *lrpc.Client) rpcBroadcastTxTrackFunc {
+	return func(ctx *rpctypes.Context, tx types.Tx, webhook string) (*ctypes.ResultBroadcastTxTrack, error) {
+		return c.BroadcastTxTrack(ctx.Context(), tx, webhook)
+	}
+}
+
+type rpcTxTrackFunc func(ctx *rpctypes.Context, id string) (*ctypes.ResultTxTrack, error)
+
+func makeTxTrackFunc(c *lrpc.Client) rpcTxTrackFunc {
+	return func(ctx *rpctypes.Context, id string) (*ctypes.ResultTxTrack, error) {
+		return c.TxTrack(ctx.Context(), id)
+	}
+}
+
 type rpcABCIQueryFunc func(ctx *rpctypes.Context, path string,
 	data bytes.HexBytes, height int64, prove bool) (*ctypes.ResultABCIQuery, error)
 
@@ -293,6 +378,14 @@ func makeABCIInfoFunc(c *lrpc.Client) rpcABCIInfoFunc {
 	}
 }
 
+type rpcSimulateTxFunc func(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultSimulateTx, error)
+
+func makeSimulateTxFunc(c *lrpc.Client) rpcSimulateTxFunc {
+	return func(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultSimulateTx, error) {
+		return c.SimulateTx(ctx.Context(), tx)
+	}
+}
+
 type rpcBroadcastEvidenceFunc func(ctx *rpctypes.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error)
 
 func makeBroadcastEvidenceFunc(c *lrpc.Client) rpcBroadcastEvidenceFunc {
@@ -190,6 +190,12 @@ func (c *Client) ABCIQueryWithOptions(ctx context.Context, path string, data cmt
 	return &ctypes.ResultABCIQuery{Response: resp}, nil
 }
 
+// SimulateTx calls the SimulateTx method of the underlying client. Its result
+// is not verified, since the simulations are not part of the chain.
+func (c *Client) SimulateTx(ctx context.Context, tx types.Tx) (*ctypes.ResultSimulateTx, error) {
+	return c.next.SimulateTx(ctx, tx)
+}
+
 func (c *Client) BroadcastTxCommit(ctx context.Context, tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
 	return c.next.BroadcastTxCommit(ctx, tx)
 }
@@ -202,6 +208,20 @@ func (c *Client) BroadcastTxSync(ctx context.Context, tx types.Tx) (*ctypes.Resu
 	return c.next.BroadcastTxSync(ctx, tx)
 }
 
+func (c *Client) BroadcastTxs(ctx context.Context, txs []types.Tx, async bool) (*ctypes.ResultBroadcastTxs, error) {
+	return c.next.BroadcastTxs(ctx, txs, async)
+}
+
+func (c *Client) BroadcastTxTrack(ctx context.Context, tx types.Tx, webhook string) (*ctypes.ResultBroadcastTxTrack, error) {
+	return c.next.BroadcastTxTrack(ctx, tx, webhook)
+}
+
+// TxTrack calls the TxTrack method of the underlying client. The status of the
+// tx is not verified.
+func (c *Client) TxTrack(ctx context.Context, id string) (*ctypes.ResultTxTrack, error) {
+	return c.next.TxTrack(ctx, id)
+}
+
 func (c *Client) UnconfirmedTxs(ctx context.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error) {
 	return c.next.UnconfirmedTxs(ctx, limit)
 }
@@ -226,6 +246,10 @@ func (c *Client) ConsensusState(ctx context.Context) (*ctypes.ResultConsensusSta
 	return c.next.ConsensusState(ctx)
 }
 
+func (c *Client) ConsensusTrace(ctx context.Context, height *int64) (*ctypes.ResultConsensusTrace, error) {
+	return c.next.ConsensusTrace(ctx, height)
+}
+
 func (c *Client) ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	res, err := c.next.ConsensusParams(ctx, height)
 	if err != nil {
@@ -255,6 +279,43 @@ func (c *Client) ConsensusParams(ctx context.Context, height *int64) (*ctypes.Re
 	return res, nil
 }
 
+// ConsensusParamsChanges calls rpcclient#ConsensusParamsChanges and then
+// verifies the params of every change against the trusted header of its
+// height. The absence of changes at the other heights is not verified.
+func (c *Client) ConsensusParamsChanges(ctx context.Context, from, to *int64) (*ctypes.ResultConsensusParamsChanges, error) {
+	to, err := c.latestTrustedHeightIfNil(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.next.ConsensusParamsChanges(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, change := range res.Changes {
+		if err := change.ConsensusParams.ValidateBasic(); err != nil {
+			return nil, err
+		}
+		if change.Height < res.From || change.Height > res.To {
+			return nil, fmt.Errorf("change at height %d out of range [%d, %d]", change.Height, res.From, res.To)
+		}
+
+		// Update the light client if we're behind.
+		l, err := c.updateLightClientIfNeededTo(ctx, &change.Height)
+		if err != nil {
+			return nil, err
+		}
+
+		// Verify hash.
+		if cH, tH := change.ConsensusParams.Hash(), l.ConsensusHash; !bytes.Equal(cH, tH) {
+			return nil, fmt.Errorf("params hash %X at height %d does not match trusted hash %X",
+				cH, change.Height, tH)
+		}
+	}
+
+	return res, nil
+}
+
 func (c *Client) Health(ctx context.Context) (*ctypes.ResultHealth, error) {
 	return c.next.Health(ctx)
 }
@@ -380,6 +441,16 @@ func (c *Client) BlockByHash(ctx context.Context, hash []byte) (*ctypes.ResultBl
 // provided, the results of the block preceding the latest are returned.
 // NOTE: Light client only verifies the tx results
 func (c *Client) BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	return c.blockResults(ctx, height, false)
+}
+
+// BlockResultsWithProofs is like BlockResults, but also returns the proofs of
+// the tx results, verified against the trusted LastResultsHash.
+func (c *Client) BlockResultsWithProofs(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	return c.blockResults(ctx, height, true)
+}
+
+func (c *Client) blockResults(ctx context.Context, height *int64, prove bool) (*ctypes.ResultBlockResults, error) {
 	var h int64
 	if height == nil {
 		res, err := c.next.Status(ctx)
@@ -393,7 +464,15 @@ func (c *Client) BlockResults(ctx context.Context, height *int64) (*ctypes.Resul
 		h = *height
 	}
 
-	res, err := c.next.BlockResults(ctx, &h)
+	var (
+		res *ctypes.ResultBlockResults
+		err error
+	)
+	if prove {
+		res, err = c.next.BlockResultsWithProofs(ctx, &h)
+	} else {
+		res, err = c.next.BlockResults(ctx, &h)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -419,6 +498,22 @@ func (c *Client) BlockResults(ctx context.Context, height *int64) (*ctypes.Resul
 			rH, trustedBlock.LastResultsHash)
 	}
 
+	// Verify the proofs of the results.
+	if prove {
+		if len(res.TxsResultsProofs) != len(res.TxsResults) {
+			return nil, fmt.Errorf("expected %d tx results proofs, got %d",
+				len(res.TxsResults), len(res.TxsResultsProofs))
+		}
+		for i, proof := range res.TxsResultsProofs {
+			if proof.Proof.Index != int64(i) {
+				return nil, fmt.Errorf("tx result proof #%d has index %d", i, proof.Proof.Index)
+			}
+			if err := proof.Validate(trustedBlock.LastResultsHash, res.TxsResults[i]); err != nil {
+				return nil, fmt.Errorf("invalid tx result proof #%d: %w", i, err)
+			}
+		}
+	}
+
 	return res, nil
 }
 
@@ -493,6 +588,57 @@ func (c *Client) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.Resul
 	return res, res.Proof.Validate(l.DataHash)
 }
 
+// TxResultProof calls rpcclient#TxResultProof and verifies the proof of the tx
+// against the trusted header of its block, and the proof of its result
+// against the trusted header of the next block.
+func (c *Client) TxResultProof(ctx context.Context, hash []byte) (*ctypes.ResultTxResultProof, error) {
+	res, err := c.next.TxResultProof(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate res.
+	if res.Height <= 0 {
+		return nil, errNegOrZeroHeight
+	}
+	if !bytes.Equal(res.Tx.Hash(), hash) {
+		return nil, fmt.Errorf("tx hash %X does not match requested hash %X", res.Tx.Hash(), hash)
+	}
+	if !bytes.Equal(res.TxProof.Data, res.Tx) {
+		return nil, errors.New("tx proof does not match the tx")
+	}
+	// The proofs are bound to the same tx by their index, among as many txs as
+	// results.
+	if res.TxProof.Proof.Index != int64(res.Index) || res.TxResultProof.Proof.Index != int64(res.Index) {
+		return nil, fmt.Errorf("proof indexes %d and %d do not match tx index %d",
+			res.TxProof.Proof.Index, res.TxResultProof.Proof.Index, res.Index)
+	}
+	if res.TxProof.Proof.Total != res.TxResultProof.Proof.Total {
+		return nil, fmt.Errorf("tx proof total %d does not match tx result proof total %d",
+			res.TxProof.Proof.Total, res.TxResultProof.Proof.Total)
+	}
+
+	// Update the light client if we're behind.
+	l, err := c.updateLightClientIfNeededTo(ctx, &res.Height)
+	if err != nil {
+		return nil, err
+	}
+	if err := res.TxProof.Validate(l.DataHash); err != nil {
+		return nil, fmt.Errorf("invalid tx proof: %w", err)
+	}
+
+	nextHeight := res.Height + 1
+	nextBlock, err := c.updateLightClientIfNeededTo(ctx, &nextHeight)
+	if err != nil {
+		return nil, err
+	}
+	if err := res.TxResultProof.Validate(nextBlock.LastResultsHash, &res.TxResult); err != nil {
+		return nil, fmt.Errorf("invalid tx result proof: %w", err)
+	}
+
+	return res, nil
+}
+
 func (c *Client) TxSearch(
 	ctx context.Context,
 	query string,
@@ -503,6 +649,17 @@ func (c *Client) TxSearch(
 	return c.next.TxSearch(ctx, query, prove, page, perPage, orderBy)
 }
 
+func (c *Client) TxSearchAfter(
+	ctx context.Context,
+	query string,
+	prove bool,
+	cursor string,
+	perPage *int,
+	orderBy string,
+) (*ctypes.ResultTxSearch, error) {
+	return c.next.TxSearchAfter(ctx, query, prove, cursor, perPage, orderBy)
+}
+
 func (c *Client) BlockSearch(
 	ctx context.Context,
 	query string,
@@ -512,6 +669,16 @@ func (c *Client) BlockSearch(
 	return c.next.BlockSearch(ctx, query, page, perPage, orderBy)
 }
 
+func (c *Client) BlockSearchAfter(
+	ctx context.Context,
+	query string,
+	cursor string,
+	perPage *int,
+	orderBy string,
+) (*ctypes.ResultBlockSearch, error) {
+	return c.next.BlockSearchAfter(ctx, query, cursor, perPage, orderBy)
+}
+
 // Validators fetches and verifies validators.
 func (c *Client) Validators(
 	ctx context.Context,
@@ -543,6 +710,98 @@ func (c *Client) Validators(
 	}, nil
 }
 
+// ValidatorSetChanges calls rpcclient#ValidatorSetChanges and then verifies
+// every change against the trusted validator sets of its height and of the
+// previous height. The absence of changes at the other heights is not
+// verified.
+func (c *Client) ValidatorSetChanges(ctx context.Context, from, to *int64) (*ctypes.ResultValidatorSetChanges, error) {
+	to, err := c.latestTrustedHeightIfNil(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.next.ValidatorSetChanges(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, change := range res.Changes {
+		if change.Height < res.From || change.Height > res.To {
+			return nil, fmt.Errorf("change at height %d out of range [%d, %d]", change.Height, res.From, res.To)
+		}
+
+		l, err := c.updateLightClientIfNeededTo(ctx, &change.Height)
+		if err != nil {
+			return nil, err
+		}
+		prevPowers := make(map[string]int64)
+		if change.Height > 1 {
+			prevHeight := change.Height - 1
+			prev, err := c.updateLightClientIfNeededTo(ctx, &prevHeight)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range prev.ValidatorSet.Validators {
+				prevPowers[string(v.Address)] = v.VotingPower
+			}
+		}
+		powers := make(map[string]int64, len(l.ValidatorSet.Validators))
+		for _, v := range l.ValidatorSet.Validators {
+			powers[string(v.Address)] = v.VotingPower
+		}
+
+		// Every reported change must match the trusted sets...
+		seen := make(map[string]struct{}, len(change.Changes))
+		for _, vc := range change.Changes {
+			addr := string(vc.Address)
+			if _, ok := seen[addr]; ok {
+				return nil, fmt.Errorf("duplicate change of validator %X at height %d", vc.Address, change.Height)
+			}
+			seen[addr] = struct{}{}
+			if vc.PreviousPower != prevPowers[addr] || vc.Power != powers[addr] {
+				return nil, fmt.Errorf("change of validator %X at height %d does not match the trusted validator sets",
+					vc.Address, change.Height)
+			}
+		}
+		// ...and no change may be missing.
+		if n := countPowerChanges(prevPowers, powers); n != len(change.Changes) {
+			return nil, fmt.Errorf("expected %d validator changes at height %d, got %d",
+				n, change.Height, len(change.Changes))
+		}
+	}
+
+	return res, nil
+}
+
+// countPowerChanges returns the number of validators whose voting power
+// differs between two validator sets.
+func countPowerChanges(prevPowers, powers map[string]int64) int {
+	n := 0
+	for addr, power := range powers {
+		if prevPowers[addr] != power {
+			n++
+		}
+	}
+	for addr := range prevPowers {
+		if _, ok := powers[addr]; !ok {
+			n++
+		}
+	}
+	return n
+}
+
+// latestTrustedHeightIfNil returns height, or the latest trusted height,
+// after updating the light client, if it is nil.
+func (c *Client) latestTrustedHeightIfNil(ctx context.Context, height *int64) (*int64, error) {
+	if height != nil {
+		return height, nil
+	}
+	l, err := c.updateLightClientIfNeededTo(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &l.Height, nil
+}
+
 func (c *Client) BroadcastEvidence(ctx context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
 	return c.next.BroadcastEvidence(ctx, ev)
 }
@@ -0,0 +1,124 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	lcmock "github.com/cometbft/cometbft/light/rpc/mocks"
+	rpcmock "github.com/cometbft/cometbft/rpc/client/mocks"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// proofsTestBlocks returns the txs and results of the block at height 5, and
+// a light client trusting the headers at heights 5 and 6.
+func proofsTestBlocks() (types.Txs, []*abci.ExecTxResult, *lcmock.LightClient) {
+	txs := types.Txs{types.Tx("a=1"), types.Tx("b=2"), types.Tx("c=3")}
+	results := []*abci.ExecTxResult{
+		{Code: 0, Data: []byte("one"), Events: []abci.Event{{Type: "app"}}},
+		{Code: 1, Log: "failed"},
+		{Code: 0, GasUsed: 10},
+	}
+
+	lc := &lcmock.LightClient{}
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(5), mock.Anything).Return(&types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: &types.Header{Height: 5, DataHash: txs.Hash()}},
+	}, nil)
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(6), mock.Anything).Return(&types.LightBlock{
+		SignedHeader: &types.SignedHeader{Header: &types.Header{
+			Height:          6,
+			LastResultsHash: types.NewResults(results).Hash(),
+		}},
+	}, nil)
+	return txs, results, lc
+}
+
+func TestBlockResultsWithProofs(t *testing.T) {
+	_, results, lc := proofsTestBlocks()
+	height := int64(5)
+
+	next := &rpcmock.Client{}
+	next.On("BlockResultsWithProofs", mock.Anything, &height).Return(&ctypes.ResultBlockResults{
+		Height:           height,
+		TxsResults:       results,
+		TxsResultsProofs: types.NewResults(results).Proofs(),
+	}, nil).Once()
+	c := NewClient(next, lc)
+
+	res, err := c.BlockResultsWithProofs(context.Background(), &height)
+	require.NoError(t, err)
+	assert.Len(t, res.TxsResultsProofs, len(results))
+
+	// The proofs must be in the order of the results.
+	proofs := types.NewResults(results).Proofs()
+	proofs[0], proofs[1] = proofs[1], proofs[0]
+	next.On("BlockResultsWithProofs", mock.Anything, &height).Return(&ctypes.ResultBlockResults{
+		Height:           height,
+		TxsResults:       results,
+		TxsResultsProofs: proofs,
+	}, nil).Once()
+	_, err = c.BlockResultsWithProofs(context.Background(), &height)
+	require.ErrorContains(t, err, "tx result proof #0")
+
+	next.On("BlockResultsWithProofs", mock.Anything, &height).Return(&ctypes.ResultBlockResults{
+		Height:     height,
+		TxsResults: results,
+	}, nil).Once()
+	_, err = c.BlockResultsWithProofs(context.Background(), &height)
+	require.ErrorContains(t, err, "tx results proofs")
+}
+
+func TestTxResultProof(t *testing.T) {
+	txs, results, lc := proofsTestBlocks()
+
+	newResult := func(i int) *ctypes.ResultTxResultProof {
+		return &ctypes.ResultTxResultProof{
+			Hash:          txs[i].Hash(),
+			Height:        5,
+			Index:         uint32(i),
+			Tx:            txs[i],
+			TxProof:       txs.Proof(i),
+			TxResult:      *results[i],
+			TxResultProof: types.NewResults(results).Proofs()[i],
+		}
+	}
+
+	testCases := map[string]struct {
+		malleate func(*ctypes.ResultTxResultProof)
+		wantErr  string
+	}{
+		"valid":              {func(*ctypes.ResultTxResultProof) {}, ""},
+		"unproven fields":    {func(res *ctypes.ResultTxResultProof) { res.TxResult.Log = "other" }, ""},
+		"other result":       {func(res *ctypes.ResultTxResultProof) { res.TxResult.Code = 2 }, "invalid tx result proof"},
+		"other tx":           {func(res *ctypes.ResultTxResultProof) { res.Tx = txs[2] }, "does not match requested hash"},
+		"other tx proof":     {func(res *ctypes.ResultTxResultProof) { res.TxProof = txs.Proof(2) }, "tx proof does not match"},
+		"other result proof": {func(res *ctypes.ResultTxResultProof) { res.TxResultProof = types.NewResults(results).Proofs()[2] }, "proof indexes"},
+		"other result and its proof": {
+			func(res *ctypes.ResultTxResultProof) {
+				res.TxResult = *results[2]
+				res.TxResultProof = types.NewResults(results).Proofs()[2]
+			},
+			"proof indexes",
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			res := newResult(1)
+			tc.malleate(res)
+			next := &rpcmock.Client{}
+			next.On("TxResultProof", mock.Anything, []byte(txs[1].Hash())).Return(res, nil)
+
+			_, err := NewClient(next, lc).TxResultProof(context.Background(), txs[1].Hash())
+			if tc.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+}
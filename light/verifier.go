@@ -36,6 +36,23 @@ func VerifyNonAdjacent(
 	now time.Time,
 	maxClockDrift time.Duration,
 	trustLevel cmtmath.Fraction,
+) error {
+	return verifyNonAdjacent(trustedHeader, trustedVals, untrustedHeader, untrustedVals,
+		trustingPeriod, now, maxClockDrift, trustLevel, types.NewSignatureCache())
+}
+
+// verifyNonAdjacent is VerifyNonAdjacent using the given cache of verified
+// signatures.
+func verifyNonAdjacent(
+	trustedHeader *types.SignedHeader,
+	trustedVals *types.ValidatorSet,
+	untrustedHeader *types.SignedHeader,
+	untrustedVals *types.ValidatorSet,
+	trustingPeriod time.Duration,
+	now time.Time,
+	maxClockDrift time.Duration,
+	trustLevel cmtmath.Fraction,
+	verifiedSignatureCache *types.SignatureCache,
 ) error {
 	if untrustedHeader.Height == trustedHeader.Height+1 {
 		return errors.New("headers must be non adjacent in height")
@@ -52,7 +69,6 @@ func VerifyNonAdjacent(
 		return ErrInvalidHeader{err}
 	}
 
-	verifiedSignatureCache := types.NewSignatureCache()
 	// Ensure that +`trustLevel` (default 1/3) or more of last trusted validators signed correctly.
 	err := trustedVals.VerifyCommitLightTrustingWithCache(trustedHeader.ChainID, untrustedHeader.Commit, trustLevel, verifiedSignatureCache)
 	if err != nil {
@@ -96,6 +112,21 @@ func VerifyAdjacent(
 	trustingPeriod time.Duration,
 	now time.Time,
 	maxClockDrift time.Duration,
+) error {
+	return verifyAdjacent(trustedHeader, untrustedHeader, untrustedVals,
+		trustingPeriod, now, maxClockDrift, types.NewSignatureCache())
+}
+
+// verifyAdjacent is VerifyAdjacent using the given cache of verified
+// signatures.
+func verifyAdjacent(
+	trustedHeader *types.SignedHeader,
+	untrustedHeader *types.SignedHeader,
+	untrustedVals *types.ValidatorSet,
+	trustingPeriod time.Duration,
+	now time.Time,
+	maxClockDrift time.Duration,
+	verifiedSignatureCache *types.SignatureCache,
 ) error {
 	if untrustedHeader.Height != trustedHeader.Height+1 {
 		return errors.New("headers must be adjacent in height")
@@ -122,8 +153,8 @@ func VerifyAdjacent(
 	}
 
 	// Ensure that +2/3 of new validators signed correctly.
-	if err := untrustedVals.VerifyCommitLight(trustedHeader.ChainID, untrustedHeader.Commit.BlockID,
-		untrustedHeader.Height, untrustedHeader.Commit); err != nil {
+	if err := untrustedVals.VerifyCommitLightWithCache(trustedHeader.ChainID, untrustedHeader.Commit.BlockID,
+		untrustedHeader.Height, untrustedHeader.Commit, verifiedSignatureCache); err != nil {
 		return ErrInvalidHeader{err}
 	}
 
@@ -140,13 +171,30 @@ func Verify(
 	now time.Time,
 	maxClockDrift time.Duration,
 	trustLevel cmtmath.Fraction,
+) error {
+	return verify(trustedHeader, trustedVals, untrustedHeader, untrustedVals,
+		trustingPeriod, now, maxClockDrift, trustLevel, types.NewSignatureCache())
+}
+
+// verify is Verify using the given cache of verified signatures.
+func verify(
+	trustedHeader *types.SignedHeader,
+	trustedVals *types.ValidatorSet,
+	untrustedHeader *types.SignedHeader,
+	untrustedVals *types.ValidatorSet,
+	trustingPeriod time.Duration,
+	now time.Time,
+	maxClockDrift time.Duration,
+	trustLevel cmtmath.Fraction,
+	verifiedSignatureCache *types.SignatureCache,
 ) error {
 	if untrustedHeader.Height != trustedHeader.Height+1 {
-		return VerifyNonAdjacent(trustedHeader, trustedVals, untrustedHeader, untrustedVals,
-			trustingPeriod, now, maxClockDrift, trustLevel)
+		return verifyNonAdjacent(trustedHeader, trustedVals, untrustedHeader, untrustedVals,
+			trustingPeriod, now, maxClockDrift, trustLevel, verifiedSignatureCache)
 	}
 
-	return VerifyAdjacent(trustedHeader, untrustedHeader, untrustedVals, trustingPeriod, now, maxClockDrift)
+	return verifyAdjacent(trustedHeader, untrustedHeader, untrustedVals,
+		trustingPeriod, now, maxClockDrift, verifiedSignatureCache)
 }
 
 func verifyNewHeaderAndVals(
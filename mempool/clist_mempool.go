@@ -102,6 +102,12 @@ func (mem *CListMempool) getCElement(txKey types.TxKey) (*clist.CElement, bool)
 	return nil, false
 }
 
+// Contains returns true if the tx of the given key is in the mempool.
+func (mem *CListMempool) Contains(txKey types.TxKey) bool {
+	_, ok := mem.getCElement(txKey)
+	return ok
+}
+
 func (mem *CListMempool) getMemTx(txKey types.TxKey) *mempoolTx {
 	if e, ok := mem.getCElement(txKey); ok {
 		return e.Value.(*mempoolTx)
@@ -659,10 +659,12 @@ func TestMempoolTxsBytes(t *testing.T) {
 	err = mp.CheckTx(tx1, nil, TxInfo{})
 	require.NoError(t, err)
 	assert.EqualValues(t, 20, mp.SizeBytes())
+	assert.True(t, mp.Contains(types.Tx(tx1).Key()))
 	assert.Error(t, mp.RemoveTxByKey(types.Tx([]byte{0x07}).Key()))
 	assert.EqualValues(t, 20, mp.SizeBytes())
 	assert.NoError(t, mp.RemoveTxByKey(types.Tx(tx1).Key()))
 	assert.EqualValues(t, 10, mp.SizeBytes())
+	assert.False(t, mp.Contains(types.Tx(tx1).Key()))
 }
 
 func TestMempoolNoCacheOverflow(t *testing.T) {
@@ -12,6 +12,9 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rs/cors"
+	"google.golang.org/grpc"
+
+	dbm "github.com/cometbft/cometbft-db"
 
 	bc "github.com/cometbft/cometbft/blocksync"
 	cfg "github.com/cometbft/cometbft/config"
@@ -29,6 +32,8 @@ import (
 	"github.com/cometbft/cometbft/proxy"
 	rpccore "github.com/cometbft/cometbft/rpc/core"
 	grpccore "github.com/cometbft/cometbft/rpc/grpc"
+	grpcserver "github.com/cometbft/cometbft/rpc/grpc/server"
+	"github.com/cometbft/cometbft/rpc/grpc/server/privileged"
 	rpcserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
@@ -74,6 +79,7 @@ type Node struct {
 	consensusState    *cs.State               // latest consensus state
 	consensusReactor  *cs.Reactor             // for participating in the consensus
 	evidencePool      *evidence.Pool          // tracking evidence
+	signatureCache    *types.SignatureCache   // verified signatures, shared by consensus, blocksync and light client
 	proxyApp          proxy.AppConns          // connection to the application
 	rpcListeners      []net.Listener          // rpc servers
 	txIndexer         txindex.TxIndexer
@@ -81,6 +87,8 @@ type Node struct {
 	indexerService    *txindex.IndexerService
 	prometheusSrv     *http.Server
 	pprofSrv          *http.Server
+	privilegedSrv     *grpc.Server
+	dbs               map[string]dbm.DB // databases by ID, for compaction and storage stats
 }
 
 type waitSyncReactor interface {
@@ -311,6 +319,9 @@ func NewNodeWithContext(
 	logger log.Logger,
 	options ...Option,
 ) (*Node, error) {
+	dbs := make(map[string]dbm.DB)
+	dbProvider = recordDBs(dbProvider, dbs)
+
 	blockStore, stateDB, err := initDBs(config, dbProvider)
 	if err != nil {
 		return nil, err
@@ -418,6 +429,24 @@ func NewNodeWithContext(
 	}
 
 	// make block executor for consensus and blocksync reactors to execute blocks
+	// The verified signature cache is shared by consensus, blocksync and the
+	// state sync light client, so that a signature is verified only once.
+	signatureCache := types.NewSignatureCache(
+		types.SignatureCacheSize(config.Consensus.SignatureCacheSize),
+		types.SignatureCacheMetrics(smMetrics.SignatureCacheHits, smMetrics.SignatureCacheMisses),
+	)
+
+	blockExecOpts := []sm.BlockExecutorOption{
+		sm.BlockExecutorWithMetrics(smMetrics),
+		sm.BlockExecutorWithBlockTimeTolerance(config.Consensus.BlockTimeTolerance),
+		sm.BlockExecutorWithSignatureCache(signatureCache),
+	}
+	if config.Storage.DataCompanion {
+		blockExecOpts = append(blockExecOpts, sm.BlockExecutorWithDataCompanion())
+	}
+	if n := config.Storage.ABCIResponsesRetainBlocks; n > 0 {
+		blockExecOpts = append(blockExecOpts, sm.BlockExecutorWithABCIResponsesRetainBlocks(n))
+	}
 	blockExec := sm.NewBlockExecutor(
 		stateStore,
 		logger.With("module", "state"),
@@ -425,8 +454,7 @@ func NewNodeWithContext(
 		mempool,
 		evidencePool,
 		blockStore,
-		sm.BlockExecutorWithMetrics(smMetrics),
-		sm.BlockExecutorWithBlockTimeTolerance(config.Consensus.BlockTimeTolerance),
+		blockExecOpts...,
 	)
 
 	offlineStateSyncHeight := int64(0)
@@ -575,6 +603,7 @@ func NewNodeWithContext(
 	}
 
 	node := &Node{
+		dbs:           dbs,
 		config:        config,
 		genesisDoc:    genDoc,
 		privValidator: privValidator,
@@ -601,6 +630,7 @@ func NewNodeWithContext(
 		indexerService:   indexerService,
 		blockIndexer:     blockIndexer,
 		eventBus:         eventBus,
+		signatureCache:   signatureCache,
 	}
 
 	node.BaseService = *service.NewBaseService(logger, "Node", node)
@@ -641,6 +671,15 @@ func (n *Node) OnStart() error {
 		n.rpcListeners = listeners
 	}
 
+	// The privileged gRPC server is started independently of the RPC server.
+	if n.config.RPC.GRPCPrivilegedListenAddress != "" {
+		srv, err := n.startPrivilegedGRPCServer()
+		if err != nil {
+			return err
+		}
+		n.privilegedSrv = srv
+	}
+
 	// Start the transport.
 	addr, err := p2p.NewNetAddressString(p2p.IDAddressString(n.nodeKey.ID(), n.config.P2P.ListenAddress))
 	if err != nil {
@@ -713,6 +752,11 @@ func (n *Node) OnStop() {
 		}
 	}
 
+	if n.privilegedSrv != nil {
+		n.Logger.Info("Stopping privileged gRPC server")
+		n.privilegedSrv.GracefulStop()
+	}
+
 	if pvsc, ok := n.privValidator.(service.Service); ok {
 		if err := pvsc.Stop(); err != nil {
 			n.Logger.Error("Error closing private validator", "err", err)
@@ -777,6 +821,9 @@ func (n *Node) ConfigureRPC() (*rpccore.Environment, error) {
 		Mempool:          n.mempool,
 		IsAdaptiveSync:   n.config.BlockSync.AdaptiveSync,
 
+		Databases: n.dbs,
+		DBDir:     n.config.DBDir(),
+
 		Logger: n.Logger.With("module", "rpc"),
 
 		Config: *n.config.RPC,
@@ -799,6 +846,9 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 	if n.config.RPC.Unsafe {
 		env.AddUnsafeRoutes(routes)
 	}
+	if n.config.RPC.SimulateTx {
+		env.AddSimulateTxRoute(routes)
+	}
 
 	config := rpcserver.DefaultConfig()
 	config.MaxRequestBatchSize = n.config.RPC.MaxRequestBatchSize
@@ -811,6 +861,16 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 	if config.WriteTimeout <= n.config.RPC.TimeoutBroadcastTxCommit {
 		config.WriteTimeout = n.config.RPC.TimeoutBroadcastTxCommit + 1*time.Second
 	}
+	config.Auth, config.TLSClientCAs, err = createRPCAuthConfig(n.config.RPC)
+	if err != nil {
+		return nil, err
+	}
+	// The limits and the cache are shared by all the listeners.
+	rpcMetrics := createRPCServerMetrics(n.config, n.genesisDoc.ChainID)
+	config.RateLimiter = createRPCRateLimiter(n.config.RPC, rpcMetrics)
+	if n.config.RPC.ResponseCacheBytes > 0 {
+		config.ResponseCache = rpcserver.NewResponseCache(int(n.config.RPC.ResponseCacheBytes), rpcMetrics)
+	}
 
 	// we may expose the rpc over both a unix and tcp socket
 	listeners := make([]net.Listener, len(listenAddrs))
@@ -830,7 +890,20 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		)
 		wm.SetLogger(wmLogger)
 		mux.HandleFunc("/websocket", wm.WebsocketHandler)
+		em := rpcserver.NewEventStreamManager(routes,
+			rpcserver.EventStreamOnDisconnect(func(remoteAddr string) {
+				err := n.eventBus.UnsubscribeAll(context.Background(), remoteAddr)
+				if err != nil && err != cmtpubsub.ErrSubscriptionNotFound {
+					rpcLogger.Error("Failed to unsubscribe event stream from events", "addr", remoteAddr, "err", err)
+				}
+			}),
+			rpcserver.EventStreamWriteChanCapacity(n.config.RPC.WebSocketWriteBufferSize),
+			rpcserver.EventStreamMaxStreamsPerClient(n.config.RPC.MaxSubscriptionsPerClient),
+		)
+		em.SetLogger(rpcLogger.With("protocol", "sse"))
+		mux.HandleFunc("/events", em.EventStreamHandler)
 		rpcserver.RegisterRPCFuncs(mux, routes, rpcLogger)
+		rpcserver.RegisterRESTRoutes(mux, rpccore.RESTPrefix, rpccore.RESTRoutes(), routes, rpcLogger.With("protocol", "rest"))
 		listener, err := rpcserver.Listen(
 			listenAddr,
 			config.MaxOpenConnections,
@@ -895,9 +968,18 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		if err != nil {
 			return nil, err
 		}
+		var grpcOpts []grpc.ServerOption
+		if n.config.RPC.MaxRequestBatchSize > 0 {
+			// Leave room for a BroadcastTxs request of max_request_batch_size
+			// txs of max_tx_bytes, if above the default limit.
+			maxMsgSize := n.config.RPC.MaxRequestBatchSize * (n.config.Mempool.MaxTxBytes + 16)
+			if maxMsgSize > 4<<20 { // the default limit
+				grpcOpts = append(grpcOpts, grpc.MaxRecvMsgSize(maxMsgSize))
+			}
+		}
 		go func() {
 			//nolint:staticcheck // SA1019: core_grpc.StartGRPCClient is deprecated: A new gRPC API will be introduced after v0.38.
-			if err := grpccore.StartGRPCServer(env, listener); err != nil {
+			if err := grpccore.StartGRPCServer(env, listener, grpcOpts...); err != nil {
 				n.Logger.Error("Error starting gRPC server", "err", err)
 			}
 		}()
@@ -905,9 +987,53 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 
 	}
 
+	// the block and block results gRPC services
+	grpcServicesListenAddr := n.config.RPC.GRPCServicesListenAddress
+	if grpcServicesListenAddr != "" {
+		listener, err := rpcserver.Listen(grpcServicesListenAddr, n.config.RPC.GRPCMaxOpenConnections)
+		if err != nil {
+			return nil, err
+		}
+		logger := n.Logger.With("module", "grpc-server")
+		go func() {
+			err := grpcserver.Serve(
+				listener,
+				grpcserver.WithLogger(logger),
+				grpcserver.WithBlockService(env, logger),
+				grpcserver.WithBlockResultsService(env, logger),
+			)
+			if err != nil {
+				logger.Error("Error starting gRPC server", "err", err)
+			}
+		}()
+		listeners = append(listeners, listener)
+	}
+
 	return listeners, nil
 }
 
+// startPrivilegedGRPCServer starts the privileged gRPC server, which serves
+// the data companion pruning service if the data companion is enabled.
+func (n *Node) startPrivilegedGRPCServer() (*grpc.Server, error) {
+	listener, err := rpcserver.Listen(n.config.RPC.GRPCPrivilegedListenAddress, n.config.RPC.GRPCMaxOpenConnections)
+	if err != nil {
+		return nil, err
+	}
+	logger := n.Logger.With("module", "grpc-privileged-server")
+	opts := []privileged.Option{privileged.WithLogger(logger)}
+	if n.config.Storage.DataCompanion {
+		opts = append(opts, privileged.WithPruningService(n.blockStore, n.stateStore, logger))
+	}
+	srv := privileged.NewServer(opts...)
+	go func() {
+		logger.Info("serve", "msg", "Starting privileged gRPC server")
+		if err := srv.Serve(listener); err != nil {
+			logger.Error("Error starting privileged gRPC server", "err", err)
+		}
+	}()
+	return srv, nil
+}
+
 // startPrometheusServer starts a Prometheus HTTP server, listening for metrics
 // collectors on addr.
 func (n *Node) startPrometheusServer() *http.Server {
@@ -12,6 +12,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	dbm "github.com/cometbft/cometbft-db"
 
@@ -27,6 +31,7 @@ import (
 	"github.com/cometbft/cometbft/p2p/conn"
 	p2pmock "github.com/cometbft/cometbft/p2p/mock"
 	"github.com/cometbft/cometbft/privval"
+	pruningsvc "github.com/cometbft/cometbft/proto/tendermint/services/pruning"
 	"github.com/cometbft/cometbft/proxy"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/store"
@@ -136,6 +141,21 @@ func TestNodeSetAppVersion(t *testing.T) {
 	assert.Equal(t, n.nodeInfo.(p2p.DefaultNodeInfo).ProtocolVersion.App, appVersion)
 }
 
+func TestNodeStorageRPCDatabases(t *testing.T) {
+	config := test.ResetTestRoot("node_storage_rpc_test")
+	defer os.RemoveAll(config.RootDir)
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+
+	env, err := n.ConfigureRPC()
+	require.NoError(t, err)
+	require.Equal(t, config.DBDir(), env.DBDir)
+	for _, id := range []string{"blockstore", "state", "tx_index", "evidence"} {
+		assert.Contains(t, env.Databases, id)
+	}
+}
+
 func TestPprofServer(t *testing.T) {
 	config := test.ResetTestRoot("node_pprof_test")
 	defer os.RemoveAll(config.RootDir)
@@ -160,6 +180,76 @@ func TestPprofServer(t *testing.T) {
 	assert.Equal(t, 200, resp.StatusCode)
 }
 
+func TestNodePrivilegedGRPCServer(t *testing.T) {
+	config := test.ResetTestRoot("node_privileged_grpc_test")
+	defer os.RemoveAll(config.RootDir)
+	config.RPC.GRPCListenAddress = ""
+	addr := testFreeAddr(t)
+	config.RPC.GRPCPrivilegedListenAddress = "tcp://" + addr
+	config.Storage.DataCompanion = true
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	defer func() {
+		require.NoError(t, n.Stop())
+	}()
+
+	// wait for the node to produce a block
+	blocksSub, err := n.EventBus().Subscribe(context.Background(), "node_test", types.EventQueryNewBlock)
+	require.NoError(t, err)
+	select {
+	case <-blocksSub.Out():
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the node to produce a block")
+	}
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := pruningsvc.NewPruningServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	block, err := client.GetBlock(ctx, &pruningsvc.GetBlockRequest{Height: 1})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, block.Block.Header.Height)
+
+	_, err = client.SetBlockRetainHeight(ctx, &pruningsvc.SetBlockRetainHeightRequest{Height: 1})
+	require.NoError(t, err)
+	resp, err := client.GetBlockRetainHeight(ctx, &pruningsvc.GetBlockRetainHeightRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, resp.CompanionRetainHeight)
+}
+
+func TestNodePrivilegedGRPCServerWithoutDataCompanion(t *testing.T) {
+	config := test.ResetTestRoot("node_privileged_grpc_no_companion_test")
+	defer os.RemoveAll(config.RootDir)
+	config.RPC.GRPCListenAddress = ""
+	addr := testFreeAddr(t)
+	config.RPC.GRPCPrivilegedListenAddress = "tcp://" + addr
+
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+	require.NoError(t, n.Start())
+	defer func() {
+		require.NoError(t, n.Stop())
+	}()
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	client := pruningsvc.NewPruningServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// the pruning service is only registered for a data companion
+	_, err = client.GetBlockRetainHeight(ctx, &pruningsvc.GetBlockRetainHeightRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
 func TestNodeSetPrivValTCP(t *testing.T) {
 	addr := "tcp://" + testFreeAddr(t)
 
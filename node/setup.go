@@ -3,9 +3,11 @@ package node
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net"
+	"os"
 	"strings"
 	"time"
 
@@ -29,6 +31,7 @@ import (
 	"github.com/cometbft/cometbft/p2p/pex"
 	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
+	rpcserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/indexer/block"
@@ -100,6 +103,72 @@ func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
 
 //------------------------------------------------------------------------------
 
+// createRPCAuthConfig returns the authentication config of the RPC server and
+// the certificate authorities of its client certificates, or nil if they are
+// disabled.
+func createRPCAuthConfig(config *cfg.RPCConfig) (*rpcserver.AuthConfig, *x509.CertPool, error) {
+	if !config.Auth.Enabled() {
+		return nil, nil, nil
+	}
+	authConfig := &rpcserver.AuthConfig{RoleMethods: config.Auth.RoleMethods()}
+	if file := config.AuthJWTSecretFile(); file != "" {
+		authenticator, err := rpcserver.LoadJWTAuthenticator(file)
+		if err != nil {
+			return nil, nil, err
+		}
+		authConfig.Authenticators = append(authConfig.Authenticators, authenticator)
+	}
+
+	var clientCAs *x509.CertPool
+	if file := config.AuthClientCAFile(); file != "" {
+		pem, err := os.ReadFile(file)
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading RPC client CAs: %w", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return nil, nil, fmt.Errorf("no certificate found in %s", file)
+		}
+		authConfig.Authenticators = append(authConfig.Authenticators, rpcserver.CertAuthenticator{})
+	}
+	return authConfig, clientCAs, nil
+}
+
+// createRPCServerMetrics returns the metrics of the RPC server.
+func createRPCServerMetrics(config *cfg.Config, chainID string) *rpcserver.Metrics {
+	if config.Instrumentation.Prometheus {
+		return rpcserver.PrometheusMetrics(config.Instrumentation.Namespace, "chain_id", chainID)
+	}
+	return rpcserver.NopMetrics()
+}
+
+// createRPCRateLimiter returns the rate limiter of the RPC server, or nil if
+// the rate limits are disabled.
+func createRPCRateLimiter(config *cfg.RPCConfig, metrics *rpcserver.Metrics) *rpcserver.RateLimiter {
+	if !config.RateLimit.Enabled() {
+		return nil
+	}
+	return rpcserver.NewRateLimiter(rpcserver.RateLimitConfig{
+		Rate:     config.RateLimit.Rate,
+		Burst:    config.RateLimit.Burst,
+		KeyRate:  config.RateLimit.APIKeyRate,
+		KeyBurst: config.RateLimit.APIKeyBurst,
+		Costs:    config.RateLimit.MethodCosts(),
+	}, metrics)
+}
+
+// recordDBs returns a DBProvider which adds the databases opened by provider
+// to dbs, by their ID.
+func recordDBs(provider cfg.DBProvider, dbs map[string]dbm.DB) cfg.DBProvider {
+	return func(ctx *cfg.DBContext) (dbm.DB, error) {
+		db, err := provider(ctx)
+		if err == nil {
+			dbs[ctx.ID] = db
+		}
+		return db, err
+	}
+}
+
 func initDBs(config *cfg.Config, dbProvider cfg.DBProvider) (blockStore *store.BlockStore, stateDB dbm.DB, err error) {
 	var blockStoreDB dbm.DB
 	blockStoreDB, err = dbProvider(&cfg.DBContext{ID: "blockstore", Config: config})
@@ -157,7 +226,25 @@ func createAndStartIndexerService(
 	txIndexer.SetLogger(logger.With("module", "txindex"))
 	blockIndexer.SetLogger(logger.With("module", "txindex"))
 
-	indexerService := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus, false)
+	if retainHeight := config.TxIndex.RetainHeight; retainHeight > 0 {
+		for _, idxr := range []interface {
+			GetRetainHeight() (int64, error)
+			SetRetainHeight(int64) error
+		}{txIndexer, blockIndexer} {
+			current, err := idxr.GetRetainHeight()
+			if err != nil {
+				return nil, nil, nil, fmt.Errorf("failed to load indexer retain height: %w", err)
+			}
+			if retainHeight > current {
+				if err := idxr.SetRetainHeight(retainHeight); err != nil {
+					return nil, nil, nil, fmt.Errorf("failed to set indexer retain height: %w", err)
+				}
+			}
+		}
+	}
+
+	indexerService := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus, false,
+		txindex.WithEventFilter(txindex.NewEventFilter(config.TxIndex.IncludeEvents, config.TxIndex.ExcludeEvents)))
 	indexerService.SetLogger(logger.With("module", "txindex"))
 	if err := indexerService.Start(); err != nil {
 		return nil, nil, nil, err
@@ -346,6 +433,7 @@ func createConsensusReactor(
 		evidencePool,
 		cs.StateMetrics(csMetrics),
 		cs.OfflineStateSyncHeight(offlineStateSyncHeight),
+		cs.TraceSize(config.Consensus.TraceHeights, config.Consensus.TraceEventsPerHeight),
 	)
 	consensusState.SetLogger(logger)
 	if privValidator != nil {
@@ -607,6 +695,7 @@ func (n *Node) performStateSync() error {
 				Hash:   config.TrustHashBytes(),
 			},
 			logger.With("module", "light"),
+			light.SignatureCache(n.signatureCache),
 		)
 
 		if err != nil {
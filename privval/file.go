@@ -11,6 +11,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/internal/keytypes"
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmtos "github.com/cometbft/cometbft/libs/os"
@@ -180,6 +181,17 @@ func GenFilePV(keyFilePath, stateFilePath string) *FilePV {
 	return NewFilePV(ed25519.GenPrivKey(), keyFilePath, stateFilePath)
 }
 
+// GenFilePVWithKeyType generates a new validator with a randomly generated
+// private key of the given type (see internal/keytypes) and sets the
+// filePaths, but does not call Save().
+func GenFilePVWithKeyType(keyFilePath, stateFilePath, keyType string) (*FilePV, error) {
+	privKey, err := keytypes.GenPrivKey(keyType)
+	if err != nil {
+		return nil, err
+	}
+	return NewFilePV(privKey, keyFilePath, stateFilePath), nil
+}
+
 // LoadFilePV loads a FilePV from the filePaths.  The FilePV handles double
 // signing prevention by persisting data to the stateFilePath.  If either file path
 // does not exist, the program will exit.
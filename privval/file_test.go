@@ -10,7 +10,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cometbft/cometbft/crypto/bls12381"
 	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
@@ -79,6 +81,41 @@ func TestLoadOrGenValidator(t *testing.T) {
 	assert.Equal(addr, privVal.GetAddress(), "expected privval addr to be the same")
 }
 
+func TestGenLoadValidatorWithKeyType(t *testing.T) {
+	keyTypes := []string{ed25519.KeyType, secp256k1.KeyType}
+	if bls12381.Enabled {
+		keyTypes = append(keyTypes, bls12381.KeyType)
+	}
+
+	for _, keyType := range keyTypes {
+		t.Run(keyType, func(t *testing.T) {
+			tempKeyFile, err := os.CreateTemp("", "priv_validator_key_")
+			require.NoError(t, err)
+			tempStateFile, err := os.CreateTemp("", "priv_validator_state_")
+			require.NoError(t, err)
+
+			privVal, err := GenFilePVWithKeyType(tempKeyFile.Name(), tempStateFile.Name(), keyType)
+			require.NoError(t, err)
+			privVal.Save()
+
+			loaded := LoadFilePV(tempKeyFile.Name(), tempStateFile.Name())
+			assert.Equal(t, keyType, loaded.Key.PubKey.Type())
+			assert.Equal(t, privVal.GetAddress(), loaded.GetAddress())
+			assert.True(t, privVal.Key.PrivKey.Equals(loaded.Key.PrivKey))
+
+			vote := newVote(loaded.Key.Address, 0, 1, 0, cmtproto.PrevoteType, types.BlockID{}, nil)
+			v := vote.ToProto()
+			require.NoError(t, loaded.SignVote("mychainid", v))
+			pubKey, err := loaded.GetPubKey()
+			require.NoError(t, err)
+			assert.True(t, pubKey.VerifySignature(types.VoteSignBytes("mychainid", v), v.Signature))
+		})
+	}
+
+	_, err := GenFilePVWithKeyType("", "", "unknown")
+	require.Error(t, err)
+}
+
 func TestUnmarshalValidatorState(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
 
@@ -0,0 +1,867 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/services/block_results/block_results.proto
+
+package block_results
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cometbft/cometbft/abci/types"
+	types1 "github.com/cometbft/cometbft/proto/tendermint/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GetBlockResultsRequest is a request for the results of the block at the
+// given height. If the height is 0, the results of the latest block are
+// returned.
+type GetBlockResultsRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetBlockResultsRequest) Reset()         { *m = GetBlockResultsRequest{} }
+func (m *GetBlockResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockResultsRequest) ProtoMessage()    {}
+func (*GetBlockResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9635bcd1259bd5c1, []int{0}
+}
+func (m *GetBlockResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockResultsRequest.Merge(m, src)
+}
+func (m *GetBlockResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockResultsRequest proto.InternalMessageInfo
+
+func (m *GetBlockResultsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// GetBlockResultsResponse contains the results of the block at the requested
+// height.
+type GetBlockResultsResponse struct {
+	Height                int64                   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	TxResults             []*types.ExecTxResult   `protobuf:"bytes,2,rep,name=tx_results,json=txResults,proto3" json:"tx_results,omitempty"`
+	FinalizeBlockEvents   []types.Event           `protobuf:"bytes,3,rep,name=finalize_block_events,json=finalizeBlockEvents,proto3" json:"finalize_block_events"`
+	ValidatorUpdates      []types.ValidatorUpdate `protobuf:"bytes,4,rep,name=validator_updates,json=validatorUpdates,proto3" json:"validator_updates"`
+	ConsensusParamUpdates *types1.ConsensusParams `protobuf:"bytes,5,opt,name=consensus_param_updates,json=consensusParamUpdates,proto3" json:"consensus_param_updates,omitempty"`
+	AppHash               []byte                  `protobuf:"bytes,6,opt,name=app_hash,json=appHash,proto3" json:"app_hash,omitempty"`
+}
+
+func (m *GetBlockResultsResponse) Reset()         { *m = GetBlockResultsResponse{} }
+func (m *GetBlockResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockResultsResponse) ProtoMessage()    {}
+func (*GetBlockResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9635bcd1259bd5c1, []int{1}
+}
+func (m *GetBlockResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockResultsResponse.Merge(m, src)
+}
+func (m *GetBlockResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockResultsResponse proto.InternalMessageInfo
+
+func (m *GetBlockResultsResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetBlockResultsResponse) GetTxResults() []*types.ExecTxResult {
+	if m != nil {
+		return m.TxResults
+	}
+	return nil
+}
+
+func (m *GetBlockResultsResponse) GetFinalizeBlockEvents() []types.Event {
+	if m != nil {
+		return m.FinalizeBlockEvents
+	}
+	return nil
+}
+
+func (m *GetBlockResultsResponse) GetValidatorUpdates() []types.ValidatorUpdate {
+	if m != nil {
+		return m.ValidatorUpdates
+	}
+	return nil
+}
+
+func (m *GetBlockResultsResponse) GetConsensusParamUpdates() *types1.ConsensusParams {
+	if m != nil {
+		return m.ConsensusParamUpdates
+	}
+	return nil
+}
+
+func (m *GetBlockResultsResponse) GetAppHash() []byte {
+	if m != nil {
+		return m.AppHash
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GetBlockResultsRequest)(nil), "tendermint.services.block_results.GetBlockResultsRequest")
+	proto.RegisterType((*GetBlockResultsResponse)(nil), "tendermint.services.block_results.GetBlockResultsResponse")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/services/block_results/block_results.proto", fileDescriptor_9635bcd1259bd5c1)
+}
+
+var fileDescriptor_9635bcd1259bd5c1 = []byte{
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xb3, 0xa4, 0x04, 0xd8, 0x22, 0x01, 0x2e, 0x4d, 0x4d, 0x50, 0x8d, 0xdb, 0x53, 0x4e,
+	0x36, 0x0a, 0xe2, 0x00, 0xe2, 0x14, 0x40, 0x70, 0xac, 0x5c, 0x40, 0x82, 0x03, 0xd6, 0x7a, 0x33,
+	0x8d, 0x57, 0x24, 0xde, 0xc5, 0x3b, 0x8e, 0x02, 0x4f, 0xc0, 0x81, 0x03, 0x8f, 0xc0, 0xe3, 0xf4,
+	0xd8, 0x23, 0x27, 0x84, 0x92, 0x17, 0x41, 0xde, 0xb5, 0xa9, 0xd3, 0x80, 0x22, 0x71, 0x9b, 0x9d,
+	0x99, 0xff, 0x9b, 0xdd, 0x99, 0x59, 0xfa, 0x10, 0x21, 0x1b, 0x41, 0x3e, 0x15, 0x19, 0x86, 0x1a,
+	0xf2, 0x99, 0xe0, 0xa0, 0xc3, 0x64, 0x22, 0xf9, 0x87, 0x38, 0x07, 0x5d, 0x4c, 0xf0, 0xc2, 0x29,
+	0x50, 0xb9, 0x44, 0xe9, 0x1c, 0x9c, 0xcb, 0x82, 0x5a, 0x16, 0xac, 0x24, 0xf6, 0x6e, 0x8f, 0xe5,
+	0x58, 0x9a, 0xec, 0xb0, 0xb4, 0xac, 0xb0, 0x77, 0xb7, 0x51, 0x8f, 0x25, 0x5c, 0x84, 0xf8, 0x49,
+	0x41, 0x45, 0xed, 0xed, 0x37, 0x82, 0xc6, 0x1f, 0x2a, 0x96, 0xb3, 0x69, 0x15, 0x3e, 0xbc, 0x4f,
+	0xbb, 0x2f, 0x00, 0x87, 0x65, 0x95, 0xc8, 0x16, 0x89, 0xe0, 0x63, 0x01, 0x1a, 0x9d, 0x2e, 0xed,
+	0xa4, 0x20, 0xc6, 0x29, 0xba, 0xc4, 0x27, 0xfd, 0x76, 0x54, 0x9d, 0x0e, 0xbf, 0xb6, 0xe9, 0xde,
+	0x9a, 0x44, 0x2b, 0x99, 0x69, 0xf8, 0x97, 0xc6, 0x79, 0x42, 0x29, 0xce, 0xeb, 0x57, 0xb8, 0x97,
+	0xfc, 0x76, 0x7f, 0x7b, 0xb0, 0x1f, 0x34, 0xde, 0x5b, 0x5e, 0x3b, 0x78, 0x3e, 0x07, 0xfe, 0x6a,
+	0x6e, 0x99, 0xd1, 0x35, 0xac, 0x2c, 0xed, 0x1c, 0xd1, 0xdd, 0x13, 0x91, 0xb1, 0x89, 0xf8, 0x0c,
+	0xb1, 0xed, 0x07, 0xcc, 0x20, 0x43, 0xed, 0xb6, 0x0d, 0xa8, 0xbb, 0x0e, 0x2a, 0xc3, 0xc3, 0xad,
+	0xd3, 0x9f, 0xf7, 0x5a, 0xd1, 0x4e, 0x2d, 0x35, 0x17, 0x36, 0x11, 0xed, 0x1c, 0xd3, 0x5b, 0x33,
+	0x36, 0x11, 0x23, 0x86, 0x32, 0x8f, 0x0b, 0x35, 0x62, 0x08, 0xda, 0xdd, 0x32, 0x34, 0x7f, 0x8d,
+	0xf6, 0xa6, 0xce, 0x7c, 0x6d, 0x12, 0x2b, 0xee, 0xcd, 0xd9, 0xaa, 0x5b, 0x3b, 0x6f, 0xe9, 0x1e,
+	0x2f, 0xbb, 0x90, 0xe9, 0x42, 0xc7, 0xa6, 0xc9, 0x7f, 0xd0, 0x97, 0x7d, 0xd2, 0xdf, 0x1e, 0x1c,
+	0x34, 0xd1, 0x76, 0x46, 0x4f, 0x6b, 0xc1, 0x91, 0x19, 0x4a, 0xb4, 0xcb, 0x57, 0x1c, 0x35, 0xfa,
+	0x0e, 0xbd, 0xca, 0x94, 0x8a, 0x53, 0xa6, 0x53, 0xb7, 0xe3, 0x93, 0xfe, 0xf5, 0xe8, 0x0a, 0x53,
+	0xea, 0x25, 0xd3, 0xe9, 0xe0, 0x3b, 0xa1, 0x3b, 0xcd, 0x59, 0x1c, 0xdb, 0xcd, 0x71, 0xbe, 0x10,
+	0x7a, 0xe3, 0xc2, 0x98, 0x9c, 0x47, 0xc1, 0xc6, 0x15, 0x0b, 0xfe, 0xbe, 0x0d, 0xbd, 0xc7, 0xff,
+	0x23, 0xb5, 0x5b, 0x31, 0x7c, 0x7f, 0xba, 0xf0, 0xc8, 0xd9, 0xc2, 0x23, 0xbf, 0x16, 0x1e, 0xf9,
+	0xb6, 0xf4, 0x5a, 0x67, 0x4b, 0xaf, 0xf5, 0x63, 0xe9, 0xb5, 0xde, 0x3d, 0x1b, 0x0b, 0x4c, 0x8b,
+	0x24, 0xe0, 0x72, 0x1a, 0x72, 0x39, 0x05, 0x4c, 0x4e, 0xf0, 0xdc, 0xb0, 0x8b, 0xbe, 0xf1, 0x33,
+	0x25, 0x1d, 0x93, 0xf8, 0xe0, 0xf7, 0x00, 0xbc, 0x92, 0x6d, 0x29, 0x78, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BlockResultsServiceClient is the client API for BlockResultsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlockResultsServiceClient interface {
+	// GetBlockResults returns the results of the block at the given height.
+	GetBlockResults(ctx context.Context, in *GetBlockResultsRequest, opts ...grpc.CallOption) (*GetBlockResultsResponse, error)
+}
+
+type blockResultsServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewBlockResultsServiceClient(cc grpc1.ClientConn) BlockResultsServiceClient {
+	return &blockResultsServiceClient{cc}
+}
+
+func (c *blockResultsServiceClient) GetBlockResults(ctx context.Context, in *GetBlockResultsRequest, opts ...grpc.CallOption) (*GetBlockResultsResponse, error) {
+	out := new(GetBlockResultsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.block_results.BlockResultsService/GetBlockResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlockResultsServiceServer is the server API for BlockResultsService service.
+type BlockResultsServiceServer interface {
+	// GetBlockResults returns the results of the block at the given height.
+	GetBlockResults(context.Context, *GetBlockResultsRequest) (*GetBlockResultsResponse, error)
+}
+
+// UnimplementedBlockResultsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedBlockResultsServiceServer struct {
+}
+
+func (*UnimplementedBlockResultsServiceServer) GetBlockResults(ctx context.Context, req *GetBlockResultsRequest) (*GetBlockResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockResults not implemented")
+}
+
+func RegisterBlockResultsServiceServer(s grpc1.Server, srv BlockResultsServiceServer) {
+	s.RegisterService(&_BlockResultsService_serviceDesc, srv)
+}
+
+func _BlockResultsService_GetBlockResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockResultsServiceServer).GetBlockResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.block_results.BlockResultsService/GetBlockResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockResultsServiceServer).GetBlockResults(ctx, req.(*GetBlockResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var BlockResultsService_serviceDesc = _BlockResultsService_serviceDesc
+var _BlockResultsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.services.block_results.BlockResultsService",
+	HandlerType: (*BlockResultsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBlockResults",
+			Handler:    _BlockResultsService_GetBlockResults_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/services/block_results/block_results.proto",
+}
+
+func (m *GetBlockResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintBlockResults(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AppHash) > 0 {
+		i -= len(m.AppHash)
+		copy(dAtA[i:], m.AppHash)
+		i = encodeVarintBlockResults(dAtA, i, uint64(len(m.AppHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ConsensusParamUpdates != nil {
+		{
+			size, err := m.ConsensusParamUpdates.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBlockResults(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ValidatorUpdates) > 0 {
+		for iNdEx := len(m.ValidatorUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlockResults(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FinalizeBlockEvents) > 0 {
+		for iNdEx := len(m.FinalizeBlockEvents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalizeBlockEvents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlockResults(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TxResults) > 0 {
+		for iNdEx := len(m.TxResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlockResults(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintBlockResults(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlockResults(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlockResults(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetBlockResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlockResults(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetBlockResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlockResults(uint64(m.Height))
+	}
+	if len(m.TxResults) > 0 {
+		for _, e := range m.TxResults {
+			l = e.Size()
+			n += 1 + l + sovBlockResults(uint64(l))
+		}
+	}
+	if len(m.FinalizeBlockEvents) > 0 {
+		for _, e := range m.FinalizeBlockEvents {
+			l = e.Size()
+			n += 1 + l + sovBlockResults(uint64(l))
+		}
+	}
+	if len(m.ValidatorUpdates) > 0 {
+		for _, e := range m.ValidatorUpdates {
+			l = e.Size()
+			n += 1 + l + sovBlockResults(uint64(l))
+		}
+	}
+	if m.ConsensusParamUpdates != nil {
+		l = m.ConsensusParamUpdates.Size()
+		n += 1 + l + sovBlockResults(uint64(l))
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovBlockResults(uint64(l))
+	}
+	return n
+}
+
+func sovBlockResults(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlockResults(x uint64) (n int) {
+	return sovBlockResults(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetBlockResultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlockResults
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockResultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlockResults(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlockResults
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlockResults
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlockResults
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxResults = append(m.TxResults, &types.ExecTxResult{})
+			if err := m.TxResults[len(m.TxResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeBlockEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlockResults
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizeBlockEvents = append(m.FinalizeBlockEvents, types.Event{})
+			if err := m.FinalizeBlockEvents[len(m.FinalizeBlockEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlockResults
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorUpdates = append(m.ValidatorUpdates, types.ValidatorUpdate{})
+			if err := m.ValidatorUpdates[len(m.ValidatorUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusParamUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlockResults
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusParamUpdates == nil {
+				m.ConsensusParamUpdates = &types1.ConsensusParams{}
+			}
+			if err := m.ConsensusParamUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockResults
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlockResults
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockResults
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlockResults(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlockResults
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlockResults(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlockResults
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlockResults
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlockResults
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlockResults
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlockResults
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlockResults
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlockResults        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlockResults          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlockResults = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1724 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/services/pruning/pruning.proto
+
+package pruning
+
+import (
+	context "context"
+	fmt "fmt"
+	types1 "github.com/cometbft/cometbft/abci/types"
+	types "github.com/cometbft/cometbft/proto/tendermint/types"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GetBlockRequest is a request for the block at the given height.
+type GetBlockRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetBlockRequest) Reset()         { *m = GetBlockRequest{} }
+func (m *GetBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockRequest) ProtoMessage()    {}
+func (*GetBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8853fa47c89379ea, []int{0}
+}
+func (m *GetBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockRequest.Merge(m, src)
+}
+func (m *GetBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockRequest proto.InternalMessageInfo
+
+func (m *GetBlockRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// GetBlockResponse contains the block at the requested height.
+type GetBlockResponse struct {
+	BlockId *types.BlockID `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Block   *types.Block   `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (m *GetBlockResponse) Reset()         { *m = GetBlockResponse{} }
+func (m *GetBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockResponse) ProtoMessage()    {}
+func (*GetBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8853fa47c89379ea, []int{1}
+}
+func (m *GetBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockResponse.Merge(m, src)
+}
+func (m *GetBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockResponse proto.InternalMessageInfo
+
+func (m *GetBlockResponse) GetBlockId() *types.BlockID {
+	if m != nil {
+		return m.BlockId
+	}
+	return nil
+}
+
+func (m *GetBlockResponse) GetBlock() *types.Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+// GetBlockResultsRequest is a request for the results of the block at the
+// given height.
+type GetBlockResultsRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetBlockResultsRequest) Reset()         { *m = GetBlockResultsRequest{} }
+func (m *GetBlockResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockResultsRequest) ProtoMessage()    {}
+func (*GetBlockResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8853fa47c89379ea, []int{2}
+}
+func (m *GetBlockResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockResultsRequest.Merge(m, src)
+}
+func (m *GetBlockResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockResultsRequest proto.InternalMessageInfo
+
+func (m *GetBlockResultsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// GetBlockResultsResponse contains the FinalizeBlock response of the
+// application for the block at the requested height.
+type GetBlockResultsResponse struct {
+	Height        int64                         `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	FinalizeBlock *types1.ResponseFinalizeBlock `protobuf:"bytes,2,opt,name=finalize_block,json=finalizeBlock,proto3" json:"finalize_block,omitempty"`
+}
+
+func (m *GetBlockResultsResponse) Reset()         { *m = GetBlockResultsResponse{} }
+func (m *GetBlockResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockResultsResponse) ProtoMessage()    {}
+func (*GetBlockResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8853fa47c89379ea, []int{3}
+}
+func (m *GetBlockResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockResultsResponse.Merge(m, src)
+}
+func (m *GetBlockResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockResultsResponse proto.InternalMessageInfo
+
+func (m *GetBlockResultsResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetBlockResultsResponse) GetFinalizeBlock() *types1.ResponseFinalizeBlock {
+	if m != nil {
+		return m.FinalizeBlock
+	}
+	return nil
+}
+
+// SetBlockRetainHeightRequest sets the height below which the data companion
+// allows blocks to be pruned.
+type SetBlockRetainHeightRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *SetBlockRetainHeightRequest) Reset()         { *m = SetBlockRetainHeightRequest{} }
+func (m *SetBlockRetainHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBlockRetainHeightRequest) ProtoMessage()    {}
+func (*SetBlockRetainHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8853fa47c89379ea, []int{4}
+}
+func (m *SetBlockRetainHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetBlockRetainHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetBlockRetainHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetBlockRetainHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBlockRetainHeightRequest.Merge(m, src)
+}
+func (m *SetBlockRetainHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetBlockRetainHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBlockRetainHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBlockRetainHeightRequest proto.InternalMessageInfo
+
+func (m *SetBlockRetainHeightRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type SetBlockRetainHeightResponse struct {
+}
+
+func (m *SetBlockRetainHeightResponse) Reset()         { *m = SetBlockRetainHeightResponse{} }
+func (m *SetBlockRetainHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*SetBlockRetainHeightResponse) ProtoMessage()    {}
+func (*SetBlockRetainHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8853fa47c89379ea, []int{5}
+}
+func (m *SetBlockRetainHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetBlockRetainHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetBlockRetainHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetBlockRetainHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBlockRetainHeightResponse.Merge(m, src)
+}
+func (m *SetBlockRetainHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetBlockRetainHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBlockRetainHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBlockRetainHeightResponse proto.InternalMessageInfo
+
+type GetBlockRetainHeightRequest struct {
+}
+
+func (m *GetBlockRetainHeightRequest) Reset()         { *m = GetBlockRetainHeightRequest{} }
+func (m *GetBlockRetainHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockRetainHeightRequest) ProtoMessage()    {}
+func (*GetBlockRetainHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8853fa47c89379ea, []int{6}
+}
+func (m *GetBlockRetainHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockRetainHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockRetainHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockRetainHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockRetainHeightRequest.Merge(m, src)
+}
+func (m *GetBlockRetainHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockRetainHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockRetainHeightRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockRetainHeightRequest proto.InternalMessageInfo
+
+// GetBlockRetainHeightResponse contains the retain height set by the data
+// companion, and the lowest height still stored by the node.
+type GetBlockRetainHeightResponse struct {
+	CompanionRetainHeight int64 `protobuf:"varint,1,opt,name=companion_retain_height,json=companionRetainHeight,proto3" json:"companion_retain_height,omitempty"`
+	Base                  int64 `protobuf:"varint,2,opt,name=base,proto3" json:"base,omitempty"`
+}
+
+func (m *GetBlockRetainHeightResponse) Reset()         { *m = GetBlockRetainHeightResponse{} }
+func (m *GetBlockRetainHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockRetainHeightResponse) ProtoMessage()    {}
+func (*GetBlockRetainHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8853fa47c89379ea, []int{7}
+}
+func (m *GetBlockRetainHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBlockRetainHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBlockRetainHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBlockRetainHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockRetainHeightResponse.Merge(m, src)
+}
+func (m *GetBlockRetainHeightResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBlockRetainHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockRetainHeightResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockRetainHeightResponse proto.InternalMessageInfo
+
+func (m *GetBlockRetainHeightResponse) GetCompanionRetainHeight() int64 {
+	if m != nil {
+		return m.CompanionRetainHeight
+	}
+	return 0
+}
+
+func (m *GetBlockRetainHeightResponse) GetBase() int64 {
+	if m != nil {
+		return m.Base
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GetBlockRequest)(nil), "tendermint.services.pruning.GetBlockRequest")
+	proto.RegisterType((*GetBlockResponse)(nil), "tendermint.services.pruning.GetBlockResponse")
+	proto.RegisterType((*GetBlockResultsRequest)(nil), "tendermint.services.pruning.GetBlockResultsRequest")
+	proto.RegisterType((*GetBlockResultsResponse)(nil), "tendermint.services.pruning.GetBlockResultsResponse")
+	proto.RegisterType((*SetBlockRetainHeightRequest)(nil), "tendermint.services.pruning.SetBlockRetainHeightRequest")
+	proto.RegisterType((*SetBlockRetainHeightResponse)(nil), "tendermint.services.pruning.SetBlockRetainHeightResponse")
+	proto.RegisterType((*GetBlockRetainHeightRequest)(nil), "tendermint.services.pruning.GetBlockRetainHeightRequest")
+	proto.RegisterType((*GetBlockRetainHeightResponse)(nil), "tendermint.services.pruning.GetBlockRetainHeightResponse")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/services/pruning/pruning.proto", fileDescriptor_8853fa47c89379ea)
+}
+
+var fileDescriptor_8853fa47c89379ea = []byte{
+	// 468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
+	0x18, 0x6d, 0xd8, 0x18, 0xd3, 0x87, 0xd8, 0x90, 0x05, 0xeb, 0x48, 0x4b, 0x84, 0x72, 0x40, 0x4c,
+	0x62, 0x0e, 0xda, 0x06, 0x82, 0x0b, 0x87, 0x09, 0x11, 0x76, 0x40, 0x42, 0xe9, 0x05, 0x71, 0x89,
+	0x92, 0xd4, 0x4d, 0x0d, 0x8d, 0x1d, 0x12, 0x07, 0x04, 0xe2, 0xc0, 0x9d, 0x0b, 0x12, 0xff, 0x14,
+	0xc7, 0x1e, 0x39, 0xa2, 0xf6, 0x1f, 0x41, 0x75, 0x92, 0xd6, 0x74, 0x4d, 0xfa, 0xe3, 0x94, 0xcf,
+	0xf9, 0xde, 0xfb, 0xde, 0xb3, 0xfd, 0x64, 0x38, 0x12, 0x84, 0x75, 0x49, 0x12, 0x51, 0x26, 0xac,
+	0x94, 0x24, 0x9f, 0x68, 0x40, 0x52, 0x2b, 0x4e, 0x32, 0x46, 0x59, 0x58, 0x7e, 0x71, 0x9c, 0x70,
+	0xc1, 0x51, 0x6b, 0x06, 0xc5, 0x25, 0x14, 0x17, 0x10, 0x5d, 0x69, 0x5a, 0x9e, 0x1f, 0x50, 0x4b,
+	0x7c, 0x89, 0x65, 0x9b, 0x0b, 0xae, 0xb7, 0x95, 0xa6, 0xfc, 0x6f, 0xf9, 0x03, 0x1e, 0x7c, 0xa8,
+	0xec, 0x2a, 0x5c, 0xf3, 0x08, 0xf6, 0x6d, 0x22, 0xce, 0x27, 0x78, 0x87, 0x7c, 0xcc, 0x48, 0x2a,
+	0xd0, 0x01, 0xec, 0xf4, 0x09, 0x0d, 0xfb, 0xe2, 0x50, 0xbb, 0xa7, 0x3d, 0xd8, 0x72, 0x8a, 0x95,
+	0xf9, 0x19, 0x6e, 0xce, 0xa0, 0x69, 0xcc, 0x59, 0x4a, 0xd0, 0x19, 0xec, 0x4a, 0x2d, 0x97, 0x76,
+	0x25, 0xfa, 0xfa, 0xc9, 0x1d, 0xac, 0xec, 0x23, 0x57, 0x92, 0x94, 0x8b, 0x17, 0xce, 0x35, 0x09,
+	0xbd, 0xe8, 0xa2, 0x63, 0xb8, 0x2a, 0xcb, 0xc3, 0x2b, 0x92, 0xd2, 0xac, 0xa0, 0x38, 0x39, 0xca,
+	0x7c, 0x04, 0x07, 0x8a, 0x70, 0x36, 0x10, 0xe9, 0x32, 0xab, 0xdf, 0x35, 0x68, 0x5e, 0xa2, 0x14,
+	0x96, 0x2b, 0x38, 0xe8, 0x35, 0xec, 0xf5, 0x28, 0xf3, 0x06, 0xf4, 0x2b, 0x71, 0x55, 0x77, 0xf7,
+	0x55, 0x77, 0x93, 0xb3, 0xc7, 0xe5, 0xa8, 0x97, 0x05, 0x3c, 0x97, 0xb9, 0xd1, 0x53, 0x97, 0xe6,
+	0x63, 0x68, 0x75, 0xa6, 0x0e, 0x84, 0x47, 0xd9, 0x2b, 0x29, 0xb3, 0xcc, 0xb9, 0x01, 0xed, 0xc5,
+	0xb4, 0x5c, 0xd2, 0xbc, 0x0b, 0x2d, 0xbb, 0x7a, 0xac, 0xf9, 0x1e, 0xda, 0x76, 0x0d, 0x1d, 0x3d,
+	0x81, 0x66, 0xc0, 0xa3, 0xd8, 0x63, 0x94, 0x33, 0x37, 0x91, 0x08, 0xf7, 0x3f, 0x1f, 0xb7, 0xa7,
+	0x6d, 0x95, 0x8f, 0x10, 0x6c, 0xfb, 0x5e, 0x4a, 0xe4, 0x91, 0x6c, 0x39, 0xb2, 0x3e, 0xf9, 0xb5,
+	0x0d, 0x7b, 0x6f, 0xf2, 0x7c, 0x76, 0xf2, 0xbc, 0xa2, 0x10, 0x76, 0x4b, 0x79, 0xf4, 0x10, 0xd7,
+	0x04, 0x1a, 0xcf, 0x85, 0x4e, 0x3f, 0x5e, 0x11, 0x5d, 0xec, 0xe3, 0x1b, 0xec, 0xcf, 0xdd, 0x2f,
+	0x3a, 0x5d, 0x75, 0x82, 0x12, 0x20, 0xfd, 0x6c, 0x3d, 0x52, 0xa1, 0xfe, 0x43, 0x83, 0x5b, 0x8b,
+	0x6e, 0x09, 0x3d, 0xad, 0x1d, 0x57, 0x93, 0x07, 0xfd, 0xd9, 0x06, 0x4c, 0xc5, 0x8d, 0xbd, 0xbe,
+	0x1b, 0x7b, 0x63, 0x37, 0x75, 0x09, 0x3b, 0x7f, 0xfb, 0x7b, 0x64, 0x68, 0xc3, 0x91, 0xa1, 0xfd,
+	0x1d, 0x19, 0xda, 0xcf, 0xb1, 0xd1, 0x18, 0x8e, 0x8d, 0xc6, 0x9f, 0xb1, 0xd1, 0x78, 0xf7, 0x3c,
+	0xa4, 0xa2, 0x9f, 0xf9, 0x38, 0xe0, 0x91, 0x15, 0xf0, 0x88, 0x08, 0xbf, 0x27, 0x66, 0x85, 0x7c,
+	0x8e, 0xac, 0x9a, 0xe7, 0xd2, 0xdf, 0x91, 0x90, 0xd3, 0x7f, 0x03, 0x00, 0x6d, 0xbe, 0x9b, 0xdf,
+	0x54, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PruningServiceClient is the client API for PruningService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PruningServiceClient interface {
+	// GetBlock returns the block at the given height.
+	GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error)
+	// GetBlockResults returns the results of the block at the given height.
+	GetBlockResults(ctx context.Context, in *GetBlockResultsRequest, opts ...grpc.CallOption) (*GetBlockResultsResponse, error)
+	// SetBlockRetainHeight sets the data companion retain height. It can only
+	// be increased.
+	SetBlockRetainHeight(ctx context.Context, in *SetBlockRetainHeightRequest, opts ...grpc.CallOption) (*SetBlockRetainHeightResponse, error)
+	// GetBlockRetainHeight returns the data companion retain height.
+	GetBlockRetainHeight(ctx context.Context, in *GetBlockRetainHeightRequest, opts ...grpc.CallOption) (*GetBlockRetainHeightResponse, error)
+}
+
+type pruningServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewPruningServiceClient(cc grpc1.ClientConn) PruningServiceClient {
+	return &pruningServiceClient{cc}
+}
+
+func (c *pruningServiceClient) GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error) {
+	out := new(GetBlockResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.pruning.PruningService/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pruningServiceClient) GetBlockResults(ctx context.Context, in *GetBlockResultsRequest, opts ...grpc.CallOption) (*GetBlockResultsResponse, error) {
+	out := new(GetBlockResultsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.pruning.PruningService/GetBlockResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pruningServiceClient) SetBlockRetainHeight(ctx context.Context, in *SetBlockRetainHeightRequest, opts ...grpc.CallOption) (*SetBlockRetainHeightResponse, error) {
+	out := new(SetBlockRetainHeightResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.pruning.PruningService/SetBlockRetainHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pruningServiceClient) GetBlockRetainHeight(ctx context.Context, in *GetBlockRetainHeightRequest, opts ...grpc.CallOption) (*GetBlockRetainHeightResponse, error) {
+	out := new(GetBlockRetainHeightResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.services.pruning.PruningService/GetBlockRetainHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PruningServiceServer is the server API for PruningService service.
+type PruningServiceServer interface {
+	// GetBlock returns the block at the given height.
+	GetBlock(context.Context, *GetBlockRequest) (*GetBlockResponse, error)
+	// GetBlockResults returns the results of the block at the given height.
+	GetBlockResults(context.Context, *GetBlockResultsRequest) (*GetBlockResultsResponse, error)
+	// SetBlockRetainHeight sets the data companion retain height. It can only
+	// be increased.
+	SetBlockRetainHeight(context.Context, *SetBlockRetainHeightRequest) (*SetBlockRetainHeightResponse, error)
+	// GetBlockRetainHeight returns the data companion retain height.
+	GetBlockRetainHeight(context.Context, *GetBlockRetainHeightRequest) (*GetBlockRetainHeightResponse, error)
+}
+
+// UnimplementedPruningServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedPruningServiceServer struct {
+}
+
+func (*UnimplementedPruningServiceServer) GetBlock(ctx context.Context, req *GetBlockRequest) (*GetBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (*UnimplementedPruningServiceServer) GetBlockResults(ctx context.Context, req *GetBlockResultsRequest) (*GetBlockResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockResults not implemented")
+}
+func (*UnimplementedPruningServiceServer) SetBlockRetainHeight(ctx context.Context, req *SetBlockRetainHeightRequest) (*SetBlockRetainHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBlockRetainHeight not implemented")
+}
+func (*UnimplementedPruningServiceServer) GetBlockRetainHeight(ctx context.Context, req *GetBlockRetainHeightRequest) (*GetBlockRetainHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockRetainHeight not implemented")
+}
+
+func RegisterPruningServiceServer(s grpc1.Server, srv PruningServiceServer) {
+	s.RegisterService(&_PruningService_serviceDesc, srv)
+}
+
+func _PruningService_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PruningServiceServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.pruning.PruningService/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PruningServiceServer).GetBlock(ctx, req.(*GetBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PruningService_GetBlockResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PruningServiceServer).GetBlockResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.pruning.PruningService/GetBlockResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PruningServiceServer).GetBlockResults(ctx, req.(*GetBlockResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PruningService_SetBlockRetainHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBlockRetainHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PruningServiceServer).SetBlockRetainHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.pruning.PruningService/SetBlockRetainHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PruningServiceServer).SetBlockRetainHeight(ctx, req.(*SetBlockRetainHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PruningService_GetBlockRetainHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRetainHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PruningServiceServer).GetBlockRetainHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.services.pruning.PruningService/GetBlockRetainHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PruningServiceServer).GetBlockRetainHeight(ctx, req.(*GetBlockRetainHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var PruningService_serviceDesc = _PruningService_serviceDesc
+var _PruningService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.services.pruning.PruningService",
+	HandlerType: (*PruningServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBlock",
+			Handler:    _PruningService_GetBlock_Handler,
+		},
+		{
+			MethodName: "GetBlockResults",
+			Handler:    _PruningService_GetBlockResults_Handler,
+		},
+		{
+			MethodName: "SetBlockRetainHeight",
+			Handler:    _PruningService_SetBlockRetainHeight_Handler,
+		},
+		{
+			MethodName: "GetBlockRetainHeight",
+			Handler:    _PruningService_GetBlockRetainHeight_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/services/pruning/pruning.proto",
+}
+
+func (m *GetBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPruning(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPruning(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockId != nil {
+		{
+			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPruning(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPruning(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinalizeBlock != nil {
+		{
+			size, err := m.FinalizeBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPruning(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintPruning(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetBlockRetainHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetBlockRetainHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetBlockRetainHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintPruning(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetBlockRetainHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetBlockRetainHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetBlockRetainHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockRetainHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockRetainHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockRetainHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockRetainHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockRetainHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockRetainHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Base != 0 {
+		i = encodeVarintPruning(dAtA, i, uint64(m.Base))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CompanionRetainHeight != 0 {
+		i = encodeVarintPruning(dAtA, i, uint64(m.CompanionRetainHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPruning(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPruning(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovPruning(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockId != nil {
+		l = m.BlockId.Size()
+		n += 1 + l + sovPruning(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovPruning(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBlockResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovPruning(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetBlockResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovPruning(uint64(m.Height))
+	}
+	if m.FinalizeBlock != nil {
+		l = m.FinalizeBlock.Size()
+		n += 1 + l + sovPruning(uint64(l))
+	}
+	return n
+}
+
+func (m *SetBlockRetainHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovPruning(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *SetBlockRetainHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetBlockRetainHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetBlockRetainHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompanionRetainHeight != 0 {
+		n += 1 + sovPruning(uint64(m.CompanionRetainHeight))
+	}
+	if m.Base != 0 {
+		n += 1 + sovPruning(uint64(m.Base))
+	}
+	return n
+}
+
+func sovPruning(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPruning(x uint64) (n int) {
+	return sovPruning(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPruning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPruning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPruning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPruning
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockId == nil {
+				m.BlockId = &types.BlockID{}
+			}
+			if err := m.BlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPruning
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &types.Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPruning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockResultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPruning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockResultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPruning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPruning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPruning
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalizeBlock == nil {
+				m.FinalizeBlock = &types1.ResponseFinalizeBlock{}
+			}
+			if err := m.FinalizeBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPruning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetBlockRetainHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPruning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetBlockRetainHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetBlockRetainHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPruning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetBlockRetainHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPruning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetBlockRetainHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetBlockRetainHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPruning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockRetainHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPruning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockRetainHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockRetainHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPruning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBlockRetainHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPruning
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBlockRetainHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBlockRetainHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompanionRetainHeight", wireType)
+			}
+			m.CompanionRetainHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompanionRetainHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			m.Base = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Base |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPruning(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPruning
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPruning(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPruning
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPruning
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPruning
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPruning
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPruning
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPruning        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPruning          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPruning = fmt.Errorf("proto: unexpected end of group")
+)
@@ -43,6 +43,7 @@ type AppConnQuery interface {
 	Echo(context.Context, string) (*types.ResponseEcho, error)
 	Info(context.Context, *types.RequestInfo) (*types.ResponseInfo, error)
 	Query(context.Context, *types.RequestQuery) (*types.ResponseQuery, error)
+	SimulateTx(context.Context, *types.RequestSimulateTx) (*types.ResponseSimulateTx, error)
 }
 
 type AppConnSnapshot interface {
@@ -194,6 +195,11 @@ func (app *appConnQuery) Query(ctx context.Context, req *types.RequestQuery) (*t
 	return app.appConn.Query(ctx, req)
 }
 
+func (app *appConnQuery) SimulateTx(ctx context.Context, req *types.RequestSimulateTx) (*types.ResponseSimulateTx, error) {
+	defer addTimeSample(app.metrics.MethodTimingSeconds.With("method", "simulate_tx", "type", "sync"))()
+	return app.appConn.SimulateTx(ctx, req)
+}
+
 //------------------------------------------------
 // Implements AppConnSnapshot (subset of abcicli.Client)
 
@@ -1,9 +1,13 @@
 package client_test
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,9 +15,11 @@ import (
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	"github.com/cometbft/cometbft/rpc/client"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctest "github.com/cometbft/cometbft/rpc/test"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -96,6 +102,76 @@ func TestBlockEvents(t *testing.T) {
 	}
 }
 
+// subscribe to new blocks from a past height and make sure they are replayed
+// in order, before the live ones
+func TestBlockEventsFromHeight(t *testing.T) {
+	c := getHTTPClient()
+	require.NoError(t, c.Start())
+	t.Cleanup(func() {
+		if err := c.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	require.NoError(t, client.WaitForHeight(c, 3, nil))
+	status, err := c.Status(context.Background())
+	require.NoError(t, err)
+	fromHeight := status.SyncInfo.LatestBlockHeight - 2
+
+	const subscriber = "TestBlockEventsFromHeight"
+	eventCh, err := c.SubscribeFromHeight(context.Background(), subscriber,
+		types.QueryForEvent(types.EventNewBlock).String(), fromHeight, 10)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := c.UnsubscribeAll(context.Background(), subscriber); err != nil {
+			t.Error(err)
+		}
+	})
+
+	for i := int64(0); i < 5; i++ {
+		select {
+		case event := <-eventCh:
+			blockEvent, ok := event.Data.(types.EventDataNewBlock)
+			require.True(t, ok)
+			require.Equal(t, fromHeight+i, blockEvent.Block.Height)
+			require.Equal(t, fmt.Sprintf("%d/block", fromHeight+i), event.ID)
+		case <-time.After(waitForEventTimeout):
+			t.Fatal("timed out waiting for event")
+		}
+	}
+}
+
+// subscribe to new blocks via Server-Sent Events
+func TestEventStream(t *testing.T) {
+	remote := strings.ReplaceAll(rpctest.GetConfig().RPC.ListenAddress, "tcp", "http")
+	query := url.Values{"query": {types.QueryForEvent(types.EventNewBlock).String()}}
+	resp, err := http.Get(remote + "/events?" + query.Encode())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var firstBlockHeight int64
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(nil, 1<<20)
+	for i := int64(0); i < 3 && scanner.Scan(); {
+		data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+		if !ok {
+			continue
+		}
+		var event ctypes.ResultEvent
+		require.NoError(t, cmtjson.Unmarshal([]byte(data), &event))
+		blockEvent, ok := event.Data.(types.EventDataNewBlock)
+		require.True(t, ok)
+
+		if firstBlockHeight == 0 {
+			firstBlockHeight = blockEvent.Block.Height
+		}
+		require.Equal(t, firstBlockHeight+i, blockEvent.Block.Height)
+		i++
+	}
+	require.NoError(t, scanner.Err())
+}
+
 func TestTxEventsSentWithBroadcastTxAsync(t *testing.T) { testTxEventsSent(t, "async") }
 func TestTxEventsSentWithBroadcastTxSync(t *testing.T)  { testTxEventsSent(t, "sync") }
 
@@ -248,6 +248,15 @@ func (c *baseRPCClient) ABCIQueryWithOptions(
 	return result, nil
 }
 
+func (c *baseRPCClient) SimulateTx(ctx context.Context, tx types.Tx) (*ctypes.ResultSimulateTx, error) {
+	result := new(ctypes.ResultSimulateTx)
+	_, err := c.caller.Call(ctx, "simulate_tx", map[string]any{"tx": tx}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) BroadcastTxCommit(
 	ctx context.Context,
 	tx types.Tx,
@@ -274,6 +283,41 @@ func (c *baseRPCClient) BroadcastTxSync(
 	return c.broadcastTX(ctx, "broadcast_tx_sync", tx)
 }
 
+func (c *baseRPCClient) BroadcastTxs(
+	ctx context.Context,
+	txs []types.Tx,
+	async bool,
+) (*ctypes.ResultBroadcastTxs, error) {
+	result := new(ctypes.ResultBroadcastTxs)
+	_, err := c.caller.Call(ctx, "broadcast_txs", map[string]any{"txs": txs, "async": async}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) BroadcastTxTrack(
+	ctx context.Context,
+	tx types.Tx,
+	webhook string,
+) (*ctypes.ResultBroadcastTxTrack, error) {
+	result := new(ctypes.ResultBroadcastTxTrack)
+	_, err := c.caller.Call(ctx, "broadcast_tx_track", map[string]any{"tx": tx, "webhook": webhook}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (c *baseRPCClient) TxTrack(ctx context.Context, id string) (*ctypes.ResultTxTrack, error) {
+	result := new(ctypes.ResultTxTrack)
+	_, err := c.caller.Call(ctx, "tx_track", map[string]any{"id": id}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) broadcastTX(
 	ctx context.Context,
 	route string,
@@ -348,6 +392,22 @@ func (c *baseRPCClient) ConsensusState(ctx context.Context) (*ctypes.ResultConse
 	return result, nil
 }
 
+func (c *baseRPCClient) ConsensusTrace(
+	ctx context.Context,
+	height *int64,
+) (*ctypes.ResultConsensusTrace, error) {
+	result := new(ctypes.ResultConsensusTrace)
+	params := make(map[string]any)
+	if height != nil {
+		params["height"] = height
+	}
+	_, err := c.caller.Call(ctx, "consensus_trace", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) ConsensusParams(
 	ctx context.Context,
 	height *int64,
@@ -364,6 +424,19 @@ func (c *baseRPCClient) ConsensusParams(
 	return result, nil
 }
 
+func (c *baseRPCClient) ConsensusParamsChanges(
+	ctx context.Context,
+	from,
+	to *int64,
+) (*ctypes.ResultConsensusParamsChanges, error) {
+	result := new(ctypes.ResultConsensusParamsChanges)
+	_, err := c.caller.Call(ctx, "consensus_params_changes", changesParams(from, to), result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) Health(ctx context.Context) (*ctypes.ResultHealth, error) {
 	result := new(ctypes.ResultHealth)
 	_, err := c.caller.Call(ctx, "health", map[string]any{}, result)
@@ -434,9 +507,24 @@ func (c *baseRPCClient) BlockByHash(ctx context.Context, hash []byte) (*ctypes.R
 func (c *baseRPCClient) BlockResults(
 	ctx context.Context,
 	height *int64,
+) (*ctypes.ResultBlockResults, error) {
+	return c.blockResults(ctx, height, false)
+}
+
+func (c *baseRPCClient) BlockResultsWithProofs(
+	ctx context.Context,
+	height *int64,
+) (*ctypes.ResultBlockResults, error) {
+	return c.blockResults(ctx, height, true)
+}
+
+func (c *baseRPCClient) blockResults(
+	ctx context.Context,
+	height *int64,
+	prove bool,
 ) (*ctypes.ResultBlockResults, error) {
 	result := new(ctypes.ResultBlockResults)
-	params := make(map[string]any)
+	params := map[string]any{"prove": prove}
 	if height != nil {
 		params["height"] = height
 	}
@@ -498,6 +586,15 @@ func (c *baseRPCClient) Tx(ctx context.Context, hash []byte, prove bool) (*ctype
 	return result, nil
 }
 
+func (c *baseRPCClient) TxResultProof(ctx context.Context, hash []byte) (*ctypes.ResultTxResultProof, error) {
+	result := new(ctypes.ResultTxResultProof)
+	_, err := c.caller.Call(ctx, "tx_result_proof", map[string]any{"hash": hash}, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) TxSearch(
 	ctx context.Context,
 	query string,
@@ -555,6 +652,60 @@ func (c *baseRPCClient) BlockSearch(
 	return result, nil
 }
 
+func (c *baseRPCClient) TxSearchAfter(
+	ctx context.Context,
+	query string,
+	prove bool,
+	cursor string,
+	perPage *int,
+	orderBy string,
+) (*ctypes.ResultTxSearch, error) {
+	result := new(ctypes.ResultTxSearch)
+	params := map[string]any{
+		"query":    query,
+		"prove":    prove,
+		"cursor":   cursor,
+		"order_by": orderBy,
+	}
+
+	if perPage != nil {
+		params["per_page"] = perPage
+	}
+
+	_, err := c.caller.Call(ctx, "tx_search", params, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *baseRPCClient) BlockSearchAfter(
+	ctx context.Context,
+	query string,
+	cursor string,
+	perPage *int,
+	orderBy string,
+) (*ctypes.ResultBlockSearch, error) {
+	result := new(ctypes.ResultBlockSearch)
+	params := map[string]any{
+		"query":    query,
+		"cursor":   cursor,
+		"order_by": orderBy,
+	}
+
+	if perPage != nil {
+		params["per_page"] = perPage
+	}
+
+	_, err := c.caller.Call(ctx, "block_search", params, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (c *baseRPCClient) Validators(
 	ctx context.Context,
 	height *int64,
@@ -579,6 +730,30 @@ func (c *baseRPCClient) Validators(
 	return result, nil
 }
 
+func (c *baseRPCClient) ValidatorSetChanges(
+	ctx context.Context,
+	from,
+	to *int64,
+) (*ctypes.ResultValidatorSetChanges, error) {
+	result := new(ctypes.ResultValidatorSetChanges)
+	_, err := c.caller.Call(ctx, "validator_set_changes", changesParams(from, to), result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func changesParams(from, to *int64) map[string]any {
+	params := make(map[string]any)
+	if from != nil {
+		params["from"] = from
+	}
+	if to != nil {
+		params["to"] = to
+	}
+	return params
+}
+
 func (c *baseRPCClient) BroadcastEvidence(
 	ctx context.Context,
 	ev types.Evidence,
@@ -604,14 +779,43 @@ type WSEvents struct {
 	ws       *jsonrpcclient.WSClient
 
 	mtx           cmtsync.RWMutex
-	subscriptions map[string]chan ctypes.ResultEvent // query -> chan
+	subscriptions map[string]*wsSubscription // query -> subscription
+}
+
+// wsSubscription is a subscription of WSEvents.
+type wsSubscription struct {
+	out chan ctypes.ResultEvent
+	// height of the latest event with an ID received, and IDs of the events
+	// received at that height, to resume the subscription from there
+	height int64
+	ids    map[string]struct{}
+}
+
+// isDuplicate reports whether the event with the given ID was already
+// received, and records it otherwise.
+func (s *wsSubscription) isDuplicate(id string) bool {
+	height, ok := ctypes.EventHeight(id)
+	switch {
+	case !ok:
+		return false
+	case height < s.height:
+		return true
+	case height > s.height:
+		s.height = height
+		s.ids = make(map[string]struct{})
+	}
+	if _, ok := s.ids[id]; ok {
+		return true
+	}
+	s.ids[id] = struct{}{}
+	return false
 }
 
 func newWSEvents(remote, endpoint string) (*WSEvents, error) {
 	w := &WSEvents{
 		endpoint:      endpoint,
 		remote:        remote,
-		subscriptions: make(map[string]chan ctypes.ResultEvent),
+		subscriptions: make(map[string]*wsSubscription),
 	}
 	w.BaseService = *service.NewBaseService(nil, "WSEvents", w)
 
@@ -652,15 +856,33 @@ func (w *WSEvents) OnStop() {
 //
 // Channel is never closed to prevent clients from seeing an erroneous event.
 //
+// After a reconnection, the subscription is resumed from the height of the
+// latest NewBlock, NewBlockEvents or Tx event received, so that these events
+// are neither lost nor received twice, as long as the server has them.
+//
 // It returns an error if WSEvents is not running.
-func (w *WSEvents) Subscribe(ctx context.Context, _, query string,
+func (w *WSEvents) Subscribe(ctx context.Context, subscriber, query string,
+	outCapacity ...int,
+) (out <-chan ctypes.ResultEvent, err error) {
+	return w.SubscribeFromHeight(ctx, subscriber, query, 0, outCapacity...)
+}
+
+// SubscribeFromHeight is like Subscribe, but first replays the NewBlock,
+// NewBlockEvents and Tx events of the committed heights from fromHeight
+// onwards, unless it is 0.
+func (w *WSEvents) SubscribeFromHeight(ctx context.Context, _, query string, fromHeight int64,
 	outCapacity ...int,
 ) (out <-chan ctypes.ResultEvent, err error) {
 	if !w.IsRunning() {
 		return nil, errNotRunning
 	}
 
-	if err := w.ws.Subscribe(ctx, query); err != nil {
+	if fromHeight > 0 {
+		err = w.ws.SubscribeFromHeight(ctx, query, fromHeight)
+	} else {
+		err = w.ws.Subscribe(ctx, query)
+	}
+	if err != nil {
 		return nil, err
 	}
 
@@ -673,7 +895,7 @@ func (w *WSEvents) Subscribe(ctx context.Context, _, query string,
 	w.mtx.Lock()
 	// subscriber param is ignored because CometBFT will override it with
 	// remote IP anyway.
-	w.subscriptions[query] = outc
+	w.subscriptions[query] = &wsSubscription{out: outc, ids: make(map[string]struct{})}
 	w.mtx.Unlock()
 
 	return outc, nil
@@ -716,7 +938,7 @@ func (w *WSEvents) UnsubscribeAll(ctx context.Context, _ string) error {
 	}
 
 	w.mtx.Lock()
-	w.subscriptions = make(map[string]chan ctypes.ResultEvent)
+	w.subscriptions = make(map[string]*wsSubscription)
 	w.mtx.Unlock()
 
 	return nil
@@ -729,8 +951,13 @@ func (w *WSEvents) redoSubscriptionsAfter(d time.Duration) {
 
 	w.mtx.RLock()
 	defer w.mtx.RUnlock()
-	for q := range w.subscriptions {
-		err := w.ws.Subscribe(context.Background(), q)
+	for q, sub := range w.subscriptions {
+		var err error
+		if sub.height > 0 {
+			err = w.ws.SubscribeFromHeight(context.Background(), q, sub.height)
+		} else {
+			err = w.ws.Subscribe(context.Background(), q)
+		}
 		if err != nil {
 			w.Logger.Error("Failed to resubscribe", "err", err)
 		}
@@ -770,8 +997,10 @@ func (w *WSEvents) eventListener() {
 				continue
 			}
 
-			w.mtx.RLock()
-			if out, ok := w.subscriptions[result.Query]; ok {
+			// Lock for writing, to record the received events.
+			w.mtx.Lock()
+			if sub, ok := w.subscriptions[result.Query]; ok && !sub.isDuplicate(result.ID) {
+				out := sub.out
 				if cap(out) == 0 {
 					out <- *result
 				} else {
@@ -782,7 +1011,7 @@ func (w *WSEvents) eventListener() {
 					}
 				}
 			}
-			w.mtx.RUnlock()
+			w.mtx.Unlock()
 		case <-w.Quit():
 			return
 		}
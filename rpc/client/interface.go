@@ -54,11 +54,15 @@ type ABCIClient interface {
 	ABCIQuery(ctx context.Context, path string, data bytes.HexBytes) (*ctypes.ResultABCIQuery, error)
 	ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes,
 		opts ABCIQueryOptions) (*ctypes.ResultABCIQuery, error)
+	SimulateTx(context.Context, types.Tx) (*ctypes.ResultSimulateTx, error)
 
 	// Writing to abci app
 	BroadcastTxCommit(context.Context, types.Tx) (*ctypes.ResultBroadcastTxCommit, error)
 	BroadcastTxAsync(context.Context, types.Tx) (*ctypes.ResultBroadcastTx, error)
 	BroadcastTxSync(context.Context, types.Tx) (*ctypes.ResultBroadcastTx, error)
+	BroadcastTxs(ctx context.Context, txs []types.Tx, async bool) (*ctypes.ResultBroadcastTxs, error)
+	BroadcastTxTrack(ctx context.Context, tx types.Tx, webhook string) (*ctypes.ResultBroadcastTxTrack, error)
+	TxTrack(ctx context.Context, id string) (*ctypes.ResultTxTrack, error)
 }
 
 // SignClient groups together the functionality needed to get valid signatures
@@ -67,11 +71,14 @@ type SignClient interface {
 	Block(ctx context.Context, height *int64) (*ctypes.ResultBlock, error)
 	BlockByHash(ctx context.Context, hash []byte) (*ctypes.ResultBlock, error)
 	BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error)
+	BlockResultsWithProofs(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error)
 	Header(ctx context.Context, height *int64) (*ctypes.ResultHeader, error)
 	HeaderByHash(ctx context.Context, hash bytes.HexBytes) (*ctypes.ResultHeader, error)
 	Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error)
 	Validators(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error)
+	ValidatorSetChanges(ctx context.Context, from, to *int64) (*ctypes.ResultValidatorSetChanges, error)
 	Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error)
+	TxResultProof(ctx context.Context, hash []byte) (*ctypes.ResultTxResultProof, error)
 
 	// TxSearch defines a method to search for a paginated set of transactions by
 	// transaction event search criteria.
@@ -83,6 +90,17 @@ type SignClient interface {
 		orderBy string,
 	) (*ctypes.ResultTxSearch, error)
 
+	// TxSearchAfter returns the transactions matching the search criteria that
+	// follow a cursor returned with a previous page of results.
+	TxSearchAfter(
+		ctx context.Context,
+		query string,
+		prove bool,
+		cursor string,
+		perPage *int,
+		orderBy string,
+	) (*ctypes.ResultTxSearch, error)
+
 	// BlockSearch defines a method to search for a paginated set of blocks based
 	// from FinalizeBlock event search criteria.
 	BlockSearch(
@@ -91,6 +109,16 @@ type SignClient interface {
 		page, perPage *int,
 		orderBy string,
 	) (*ctypes.ResultBlockSearch, error)
+
+	// BlockSearchAfter returns the blocks matching the search criteria that
+	// follow a cursor returned with a previous page of results.
+	BlockSearchAfter(
+		ctx context.Context,
+		query string,
+		cursor string,
+		perPage *int,
+		orderBy string,
+	) (*ctypes.ResultBlockSearch, error)
 }
 
 // HistoryClient provides access to data from genesis to now in large chunks.
@@ -111,7 +139,9 @@ type NetworkClient interface {
 	NetInfo(context.Context) (*ctypes.ResultNetInfo, error)
 	DumpConsensusState(context.Context) (*ctypes.ResultDumpConsensusState, error)
 	ConsensusState(context.Context) (*ctypes.ResultConsensusState, error)
+	ConsensusTrace(ctx context.Context, height *int64) (*ctypes.ResultConsensusTrace, error)
 	ConsensusParams(ctx context.Context, height *int64) (*ctypes.ResultConsensusParams, error)
+	ConsensusParamsChanges(ctx context.Context, from, to *int64) (*ctypes.ResultConsensusParamsChanges, error)
 	Health(context.Context) (*ctypes.ResultHealth, error)
 }
 
@@ -86,6 +86,10 @@ func (c *Local) ABCIQueryWithOptions(
 	return c.env.ABCIQuery(c.ctx, path, data, opts.Height, opts.Prove)
 }
 
+func (c *Local) SimulateTx(_ context.Context, tx types.Tx) (*ctypes.ResultSimulateTx, error) {
+	return c.env.SimulateTx(c.ctx, tx)
+}
+
 func (c *Local) BroadcastTxCommit(_ context.Context, tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
 	return c.env.BroadcastTxCommit(c.ctx, tx)
 }
@@ -98,6 +102,18 @@ func (c *Local) BroadcastTxSync(_ context.Context, tx types.Tx) (*ctypes.ResultB
 	return c.env.BroadcastTxSync(c.ctx, tx)
 }
 
+func (c *Local) BroadcastTxs(_ context.Context, txs []types.Tx, async bool) (*ctypes.ResultBroadcastTxs, error) {
+	return c.env.BroadcastTxs(c.ctx, txs, async)
+}
+
+func (c *Local) BroadcastTxTrack(_ context.Context, tx types.Tx, webhook string) (*ctypes.ResultBroadcastTxTrack, error) {
+	return c.env.BroadcastTxTrack(c.ctx, tx, webhook)
+}
+
+func (c *Local) TxTrack(_ context.Context, id string) (*ctypes.ResultTxTrack, error) {
+	return c.env.TxTrack(c.ctx, id)
+}
+
 func (c *Local) UnconfirmedTxs(_ context.Context, limit *int) (*ctypes.ResultUnconfirmedTxs, error) {
 	return c.env.UnconfirmedTxs(c.ctx, limit)
 }
@@ -122,10 +138,18 @@ func (c *Local) ConsensusState(context.Context) (*ctypes.ResultConsensusState, e
 	return c.env.GetConsensusState(c.ctx)
 }
 
+func (c *Local) ConsensusTrace(_ context.Context, height *int64) (*ctypes.ResultConsensusTrace, error) {
+	return c.env.ConsensusTrace(c.ctx, height)
+}
+
 func (c *Local) ConsensusParams(_ context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	return c.env.ConsensusParams(c.ctx, height)
 }
 
+func (c *Local) ConsensusParamsChanges(_ context.Context, from, to *int64) (*ctypes.ResultConsensusParamsChanges, error) {
+	return c.env.ConsensusParamsChanges(c.ctx, from, to)
+}
+
 func (c *Local) Health(context.Context) (*ctypes.ResultHealth, error) {
 	return c.env.Health(c.ctx)
 }
@@ -165,7 +189,11 @@ func (c *Local) BlockByHash(_ context.Context, hash []byte) (*ctypes.ResultBlock
 }
 
 func (c *Local) BlockResults(_ context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
-	return c.env.BlockResults(c.ctx, height)
+	return c.env.BlockResults(c.ctx, height, false)
+}
+
+func (c *Local) BlockResultsWithProofs(_ context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	return c.env.BlockResults(c.ctx, height, true)
 }
 
 func (c *Local) Header(_ context.Context, height *int64) (*ctypes.ResultHeader, error) {
@@ -184,10 +212,18 @@ func (c *Local) Validators(_ context.Context, height *int64, page, perPage *int)
 	return c.env.Validators(c.ctx, height, page, perPage)
 }
 
+func (c *Local) ValidatorSetChanges(_ context.Context, from, to *int64) (*ctypes.ResultValidatorSetChanges, error) {
+	return c.env.ValidatorSetChanges(c.ctx, from, to)
+}
+
 func (c *Local) Tx(_ context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
 	return c.env.Tx(c.ctx, hash, prove)
 }
 
+func (c *Local) TxResultProof(_ context.Context, hash []byte) (*ctypes.ResultTxResultProof, error) {
+	return c.env.TxResultProof(c.ctx, hash)
+}
+
 func (c *Local) TxSearch(
 	_ context.Context,
 	query string,
@@ -196,7 +232,18 @@ func (c *Local) TxSearch(
 	perPage *int,
 	orderBy string,
 ) (*ctypes.ResultTxSearch, error) {
-	return c.env.TxSearch(c.ctx, query, prove, page, perPage, orderBy)
+	return c.env.TxSearch(c.ctx, query, prove, page, perPage, orderBy, "")
+}
+
+func (c *Local) TxSearchAfter(
+	_ context.Context,
+	query string,
+	prove bool,
+	cursor string,
+	perPage *int,
+	orderBy string,
+) (*ctypes.ResultTxSearch, error) {
+	return c.env.TxSearch(c.ctx, query, prove, nil, perPage, orderBy, cursor)
 }
 
 func (c *Local) BlockSearch(
@@ -205,7 +252,17 @@ func (c *Local) BlockSearch(
 	page, perPage *int,
 	orderBy string,
 ) (*ctypes.ResultBlockSearch, error) {
-	return c.env.BlockSearch(c.ctx, query, page, perPage, orderBy)
+	return c.env.BlockSearch(c.ctx, query, page, perPage, orderBy, "")
+}
+
+func (c *Local) BlockSearchAfter(
+	_ context.Context,
+	query string,
+	cursor string,
+	perPage *int,
+	orderBy string,
+) (*ctypes.ResultBlockSearch, error) {
+	return c.env.BlockSearch(c.ctx, query, nil, perPage, orderBy, cursor)
 }
 
 func (c *Local) BroadcastEvidence(_ context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
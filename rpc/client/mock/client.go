@@ -104,6 +104,10 @@ func (c Client) ABCIQueryWithOptions(
 	return c.env.ABCIQuery(&rpctypes.Context{}, path, data, opts.Height, opts.Prove)
 }
 
+func (c Client) SimulateTx(_ context.Context, tx types.Tx) (*ctypes.ResultSimulateTx, error) {
+	return c.env.SimulateTx(&rpctypes.Context{}, tx)
+}
+
 func (c Client) BroadcastTxCommit(_ context.Context, tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
 	return c.env.BroadcastTxCommit(&rpctypes.Context{}, tx)
 }
@@ -116,6 +120,18 @@ func (c Client) BroadcastTxSync(_ context.Context, tx types.Tx) (*ctypes.ResultB
 	return c.env.BroadcastTxSync(&rpctypes.Context{}, tx)
 }
 
+func (c Client) BroadcastTxs(_ context.Context, txs []types.Tx, async bool) (*ctypes.ResultBroadcastTxs, error) {
+	return c.env.BroadcastTxs(&rpctypes.Context{}, txs, async)
+}
+
+func (c Client) BroadcastTxTrack(_ context.Context, tx types.Tx, webhook string) (*ctypes.ResultBroadcastTxTrack, error) {
+	return c.env.BroadcastTxTrack(&rpctypes.Context{}, tx, webhook)
+}
+
+func (c Client) TxTrack(_ context.Context, id string) (*ctypes.ResultTxTrack, error) {
+	return c.env.TxTrack(&rpctypes.Context{}, id)
+}
+
 func (c Client) CheckTx(_ context.Context, tx types.Tx) (*ctypes.ResultCheckTx, error) {
 	return c.env.CheckTx(&rpctypes.Context{}, tx)
 }
@@ -132,10 +148,18 @@ func (c Client) DumpConsensusState(_ context.Context) (*ctypes.ResultDumpConsens
 	return c.env.DumpConsensusState(&rpctypes.Context{})
 }
 
+func (c Client) ConsensusTrace(_ context.Context, height *int64) (*ctypes.ResultConsensusTrace, error) {
+	return c.env.ConsensusTrace(&rpctypes.Context{}, height)
+}
+
 func (c Client) ConsensusParams(_ context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
 	return c.env.ConsensusParams(&rpctypes.Context{}, height)
 }
 
+func (c Client) ConsensusParamsChanges(_ context.Context, from, to *int64) (*ctypes.ResultConsensusParamsChanges, error) {
+	return c.env.ConsensusParamsChanges(&rpctypes.Context{}, from, to)
+}
+
 func (c Client) Health(_ context.Context) (*ctypes.ResultHealth, error) {
 	return c.env.Health(&rpctypes.Context{})
 }
@@ -178,6 +202,10 @@ func (c Client) Validators(_ context.Context, height *int64, page, perPage *int)
 	return c.env.Validators(&rpctypes.Context{}, height, page, perPage)
 }
 
+func (c Client) ValidatorSetChanges(_ context.Context, from, to *int64) (*ctypes.ResultValidatorSetChanges, error) {
+	return c.env.ValidatorSetChanges(&rpctypes.Context{}, from, to)
+}
+
 func (c Client) BroadcastEvidence(_ context.Context, ev types.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
 	return c.env.BroadcastEvidence(&rpctypes.Context{}, ev)
 }
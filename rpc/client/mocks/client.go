@@ -160,6 +160,29 @@ func (_m *Client) BlockResults(ctx context.Context, height *int64) (*coretypes.R
 	return r0, r1
 }
 
+// BlockResultsWithProofs provides a mock function with given fields: ctx, height
+func (_m *Client) BlockResultsWithProofs(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	ret := _m.Called(ctx, height)
+
+	var r0 *coretypes.ResultBlockResults
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) *coretypes.ResultBlockResults); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultBlockResults)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BlockSearch provides a mock function with given fields: ctx, query, page, perPage, orderBy
 func (_m *Client) BlockSearch(ctx context.Context, query string, page *int, perPage *int, orderBy string) (*coretypes.ResultBlockSearch, error) {
 	ret := _m.Called(ctx, query, page, perPage, orderBy)
@@ -183,6 +206,29 @@ func (_m *Client) BlockSearch(ctx context.Context, query string, page *int, perP
 	return r0, r1
 }
 
+// BlockSearchAfter provides a mock function with given fields: ctx, query, cursor, perPage, orderBy
+func (_m *Client) BlockSearchAfter(ctx context.Context, query string, cursor string, perPage *int, orderBy string) (*coretypes.ResultBlockSearch, error) {
+	ret := _m.Called(ctx, query, cursor, perPage, orderBy)
+
+	var r0 *coretypes.ResultBlockSearch
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *int, string) *coretypes.ResultBlockSearch); ok {
+		r0 = rf(ctx, query, cursor, perPage, orderBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultBlockSearch)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *int, string) error); ok {
+		r1 = rf(ctx, query, cursor, perPage, orderBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BlockchainInfo provides a mock function with given fields: ctx, minHeight, maxHeight
 func (_m *Client) BlockchainInfo(ctx context.Context, minHeight int64, maxHeight int64) (*coretypes.ResultBlockchainInfo, error) {
 	ret := _m.Called(ctx, minHeight, maxHeight)
@@ -298,6 +344,52 @@ func (_m *Client) BroadcastTxSync(_a0 context.Context, _a1 types.Tx) (*coretypes
 	return r0, r1
 }
 
+// BroadcastTxTrack provides a mock function with given fields: ctx, tx, webhook
+func (_m *Client) BroadcastTxTrack(ctx context.Context, tx types.Tx, webhook string) (*coretypes.ResultBroadcastTxTrack, error) {
+	ret := _m.Called(ctx, tx, webhook)
+
+	var r0 *coretypes.ResultBroadcastTxTrack
+	if rf, ok := ret.Get(0).(func(context.Context, types.Tx, string) *coretypes.ResultBroadcastTxTrack); ok {
+		r0 = rf(ctx, tx, webhook)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultBroadcastTxTrack)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.Tx, string) error); ok {
+		r1 = rf(ctx, tx, webhook)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BroadcastTxs provides a mock function with given fields: ctx, txs, async
+func (_m *Client) BroadcastTxs(ctx context.Context, txs []types.Tx, async bool) (*coretypes.ResultBroadcastTxs, error) {
+	ret := _m.Called(ctx, txs, async)
+
+	var r0 *coretypes.ResultBroadcastTxs
+	if rf, ok := ret.Get(0).(func(context.Context, []types.Tx, bool) *coretypes.ResultBroadcastTxs); ok {
+		r0 = rf(ctx, txs, async)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultBroadcastTxs)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []types.Tx, bool) error); ok {
+		r1 = rf(ctx, txs, async)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CheckTx provides a mock function with given fields: _a0, _a1
 func (_m *Client) CheckTx(_a0 context.Context, _a1 types.Tx) (*coretypes.ResultCheckTx, error) {
 	ret := _m.Called(_a0, _a1)
@@ -367,6 +459,29 @@ func (_m *Client) ConsensusParams(ctx context.Context, height *int64) (*coretype
 	return r0, r1
 }
 
+// ConsensusParamsChanges provides a mock function with given fields: ctx, from, to
+func (_m *Client) ConsensusParamsChanges(ctx context.Context, from *int64, to *int64) (*coretypes.ResultConsensusParamsChanges, error) {
+	ret := _m.Called(ctx, from, to)
+
+	var r0 *coretypes.ResultConsensusParamsChanges
+	if rf, ok := ret.Get(0).(func(context.Context, *int64, *int64) *coretypes.ResultConsensusParamsChanges); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultConsensusParamsChanges)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64, *int64) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ConsensusState provides a mock function with given fields: _a0
 func (_m *Client) ConsensusState(_a0 context.Context) (*coretypes.ResultConsensusState, error) {
 	ret := _m.Called(_a0)
@@ -390,6 +505,29 @@ func (_m *Client) ConsensusState(_a0 context.Context) (*coretypes.ResultConsensu
 	return r0, r1
 }
 
+// ConsensusTrace provides a mock function with given fields: ctx, height
+func (_m *Client) ConsensusTrace(ctx context.Context, height *int64) (*coretypes.ResultConsensusTrace, error) {
+	ret := _m.Called(ctx, height)
+
+	var r0 *coretypes.ResultConsensusTrace
+	if rf, ok := ret.Get(0).(func(context.Context, *int64) *coretypes.ResultConsensusTrace); ok {
+		r0 = rf(ctx, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultConsensusTrace)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64) error); ok {
+		r1 = rf(ctx, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DumpConsensusState provides a mock function with given fields: _a0
 func (_m *Client) DumpConsensusState(_a0 context.Context) (*coretypes.ResultDumpConsensusState, error) {
 	ret := _m.Called(_a0)
@@ -656,6 +794,29 @@ func (_m *Client) SetLogger(_a0 log.Logger) {
 	_m.Called(_a0)
 }
 
+// SimulateTx provides a mock function with given fields: _a0, _a1
+func (_m *Client) SimulateTx(_a0 context.Context, _a1 types.Tx) (*coretypes.ResultSimulateTx, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *coretypes.ResultSimulateTx
+	if rf, ok := ret.Get(0).(func(context.Context, types.Tx) *coretypes.ResultSimulateTx); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultSimulateTx)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, types.Tx) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Start provides a mock function with given fields:
 func (_m *Client) Start() error {
 	ret := _m.Called()
@@ -774,6 +935,29 @@ func (_m *Client) Tx(ctx context.Context, hash []byte, prove bool) (*coretypes.R
 	return r0, r1
 }
 
+// TxResultProof provides a mock function with given fields: ctx, hash
+func (_m *Client) TxResultProof(ctx context.Context, hash []byte) (*coretypes.ResultTxResultProof, error) {
+	ret := _m.Called(ctx, hash)
+
+	var r0 *coretypes.ResultTxResultProof
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *coretypes.ResultTxResultProof); ok {
+		r0 = rf(ctx, hash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultTxResultProof)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TxSearch provides a mock function with given fields: ctx, query, prove, page, perPage, orderBy
 func (_m *Client) TxSearch(ctx context.Context, query string, prove bool, page *int, perPage *int, orderBy string) (*coretypes.ResultTxSearch, error) {
 	ret := _m.Called(ctx, query, prove, page, perPage, orderBy)
@@ -797,6 +981,52 @@ func (_m *Client) TxSearch(ctx context.Context, query string, prove bool, page *
 	return r0, r1
 }
 
+// TxSearchAfter provides a mock function with given fields: ctx, query, prove, cursor, perPage, orderBy
+func (_m *Client) TxSearchAfter(ctx context.Context, query string, prove bool, cursor string, perPage *int, orderBy string) (*coretypes.ResultTxSearch, error) {
+	ret := _m.Called(ctx, query, prove, cursor, perPage, orderBy)
+
+	var r0 *coretypes.ResultTxSearch
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool, string, *int, string) *coretypes.ResultTxSearch); ok {
+		r0 = rf(ctx, query, prove, cursor, perPage, orderBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultTxSearch)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool, string, *int, string) error); ok {
+		r1 = rf(ctx, query, prove, cursor, perPage, orderBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TxTrack provides a mock function with given fields: ctx, id
+func (_m *Client) TxTrack(ctx context.Context, id string) (*coretypes.ResultTxTrack, error) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *coretypes.ResultTxTrack
+	if rf, ok := ret.Get(0).(func(context.Context, string) *coretypes.ResultTxTrack); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultTxTrack)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UnconfirmedTxs provides a mock function with given fields: ctx, limit
 func (_m *Client) UnconfirmedTxs(ctx context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error) {
 	ret := _m.Called(ctx, limit)
@@ -848,6 +1078,29 @@ func (_m *Client) UnsubscribeAll(ctx context.Context, subscriber string) error {
 	return r0
 }
 
+// ValidatorSetChanges provides a mock function with given fields: ctx, from, to
+func (_m *Client) ValidatorSetChanges(ctx context.Context, from *int64, to *int64) (*coretypes.ResultValidatorSetChanges, error) {
+	ret := _m.Called(ctx, from, to)
+
+	var r0 *coretypes.ResultValidatorSetChanges
+	if rf, ok := ret.Get(0).(func(context.Context, *int64, *int64) *coretypes.ResultValidatorSetChanges); ok {
+		r0 = rf(ctx, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*coretypes.ResultValidatorSetChanges)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *int64, *int64) error); ok {
+		r1 = rf(ctx, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Validators provides a mock function with given fields: ctx, height, page, perPage
 func (_m *Client) Validators(ctx context.Context, height *int64, page *int, perPage *int) (*coretypes.ResultValidators, error) {
 	ret := _m.Called(ctx, height, page, perPage)
@@ -0,0 +1,223 @@
+package client_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	rpccore "github.com/cometbft/cometbft/rpc/core"
+	rpctest "github.com/cometbft/cometbft/rpc/test"
+	"github.com/cometbft/cometbft/types"
+)
+
+// openAPISpec is the part of an OpenAPI spec checked by the tests.
+type openAPISpec struct {
+	Paths      map[string]map[string]openAPIOperation `json:"paths"`
+	Components struct {
+		Schemas map[string]map[string]any `json:"schemas"`
+	} `json:"components"`
+}
+
+type openAPIOperation struct {
+	Parameters []struct {
+		Name string `json:"name"`
+		In   string `json:"in"`
+	} `json:"parameters"`
+	Responses map[string]struct {
+		Content map[string]struct {
+			Schema map[string]any `json:"schema"`
+		} `json:"content"`
+	} `json:"responses"`
+}
+
+// Make sure the REST gateway serves every route of its OpenAPI spec, with
+// responses matching their schemas.
+func TestRESTGateway(t *testing.T) {
+	remote := strings.ReplaceAll(rpctest.GetConfig().RPC.ListenAddress, "tcp", "http") + rpccore.RESTPrefix
+
+	var spec openAPISpec
+	restCall(t, http.MethodGet, remote+"/openapi.json", nil, http.StatusOK, &spec)
+
+	var paths []string
+	for _, route := range rpccore.RESTRoutes() {
+		paths = append(paths, route.Method+" "+rpccore.RESTPrefix+route.Path)
+	}
+	var specPaths []string
+	for path, operations := range spec.Paths {
+		for method := range operations {
+			specPaths = append(specPaths, strings.ToUpper(method)+" "+path)
+		}
+	}
+	sort.Strings(paths)
+	sort.Strings(specPaths)
+	require.Equal(t, paths, specPaths)
+
+	// Commit a tracked transaction, to fetch it, its block and its status.
+	_, _, tx := MakeTxKV()
+	var tracked struct {
+		ID string `json:"id"`
+	}
+	restCall(t, http.MethodPost, remote+"/txs/track", map[string]any{"tx": tx}, http.StatusOK, &tracked)
+	var txResult struct {
+		Height int64 `json:"height,string"`
+	}
+	txHash := hex.EncodeToString(types.Tx(tx).Hash())
+	require.Eventually(t, func() bool {
+		resp, err := http.Get(remote + "/txs/" + txHash)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode == http.StatusOK && json.NewDecoder(resp.Body).Decode(&txResult) == nil
+	}, 10*time.Second, 100*time.Millisecond)
+	height := strconv.FormatInt(txResult.Height, 10)
+
+	pathParams := map[string]string{"height": height, "hash": txHash, "id": tracked.ID}
+	queries := map[string]url.Values{
+		"GET /v1/txs":           {"query": {"tx.height = " + height}, "prove": {"true"}},
+		"GET /v1/blocks/search": {"query": {"block.height = " + height}},
+		"GET /v1/blocks":        {"minHeight": {"1"}, "maxHeight": {height}},
+		"GET /v1/txs/{hash}":    {"prove": {"true"}},
+	}
+	for path, operations := range spec.Paths {
+		for method, operation := range operations {
+			method := strings.ToUpper(method)
+			t.Run(method+" "+path, func(t *testing.T) {
+				url := path
+				for _, param := range operation.Parameters {
+					if param.In == "path" {
+						url = strings.ReplaceAll(url, "{"+param.Name+"}", pathParams[param.Name])
+					}
+				}
+				url = strings.TrimSuffix(remote, rpccore.RESTPrefix) + url
+				if query := queries[method+" "+path]; query != nil {
+					url += "?" + query.Encode()
+				}
+				var body any
+				if method == http.MethodPost {
+					_, _, tx := MakeTxKV()
+					body = map[string]any{"tx": tx}
+					if path == "/v1/txs/batch" {
+						body = map[string]any{"txs": [][]byte{tx}}
+					}
+				}
+
+				var result any
+				restCall(t, method, url, body, http.StatusOK, &result)
+				schema := operation.Responses["200"].Content["application/json"].Schema
+				validateSchema(t, spec.Components.Schemas, schema, result, "result")
+			})
+		}
+	}
+}
+
+// restCall calls url with the JSON encoding of body, if not nil, and decodes
+// the response into result, with json.Number numbers.
+func restCall(t *testing.T, method, url string, body any, wantCode int, result any) {
+	t.Helper()
+	var reqBody bytes.Buffer
+	if body != nil {
+		require.NoError(t, json.NewEncoder(&reqBody).Encode(body))
+	}
+	req, err := http.NewRequest(method, url, &reqBody)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(resp.Body)
+	require.NoError(t, err)
+	require.Equal(t, wantCode, resp.StatusCode, buf.String())
+	require.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	if result != nil {
+		dec := json.NewDecoder(&buf)
+		dec.UseNumber()
+		require.NoError(t, dec.Decode(result))
+	}
+}
+
+// validateSchema checks that the decoded JSON value v matches schema, and
+// that its objects have no undeclared properties.
+func validateSchema(t *testing.T, schemas map[string]map[string]any, schema map[string]any, v any, path string) {
+	t.Helper()
+	if ref, ok := schema["$ref"].(string); ok {
+		name := strings.TrimPrefix(ref, "#/components/schemas/")
+		require.Contains(t, schemas, name, path)
+		validateSchema(t, schemas, schemas[name], v, path)
+		return
+	}
+	if v == nil {
+		require.True(t, schema["nullable"] == true || len(schema) == 0, "%s: unexpected null", path)
+		return
+	}
+	if allOf, ok := schema["allOf"].([]any); ok {
+		for _, s := range allOf {
+			validateSchema(t, schemas, s.(map[string]any), v, path)
+		}
+		return
+	}
+
+	switch schema["type"] {
+	case nil:
+		// any value
+	case "object":
+		obj, ok := v.(map[string]any)
+		require.True(t, ok, "%s: expected an object, got %v", path, v)
+		properties, _ := schema["properties"].(map[string]any)
+		additional, _ := schema["additionalProperties"].(map[string]any)
+		for name, value := range obj {
+			if s, ok := properties[name].(map[string]any); ok {
+				validateSchema(t, schemas, s, value, path+"."+name)
+			} else {
+				require.NotNil(t, additional, "%s: undeclared property %s", path, name)
+				validateSchema(t, schemas, additional, value, path+"."+name)
+			}
+		}
+	case "array":
+		arr, ok := v.([]any)
+		require.True(t, ok, "%s: expected an array, got %v", path, v)
+		for i, value := range arr {
+			validateSchema(t, schemas, schema["items"].(map[string]any), value, fmt.Sprintf("%s[%d]", path, i))
+		}
+	case "string":
+		s, ok := v.(string)
+		require.True(t, ok, "%s: expected a string, got %v", path, v)
+		switch schema["format"] {
+		case "byte":
+			_, err := base64.StdEncoding.DecodeString(s)
+			require.NoError(t, err, path)
+		case "date-time":
+			_, err := time.Parse(time.RFC3339Nano, s)
+			require.NoError(t, err, path)
+		case "int64":
+			_, err := strconv.ParseInt(s, 10, 64)
+			require.NoError(t, err, path)
+		}
+	case "integer":
+		n, ok := v.(json.Number)
+		require.True(t, ok, "%s: expected an integer, got %v", path, v)
+		_, err := strconv.ParseInt(n.String(), 10, 64)
+		if err != nil {
+			_, err = strconv.ParseUint(n.String(), 10, 64)
+		}
+		require.NoError(t, err, path)
+	case "number":
+		_, ok := v.(json.Number)
+		require.True(t, ok, "%s: expected a number, got %v", path, v)
+	case "boolean":
+		_, ok := v.(bool)
+		require.True(t, ok, "%s: expected a boolean, got %v", path, v)
+	default:
+		t.Fatalf("%s: unknown type %v", path, schema["type"])
+	}
+}
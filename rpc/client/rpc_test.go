@@ -14,6 +14,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cometbft/cometbft/abci/example/kvstore"
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
@@ -25,6 +26,7 @@ import (
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
 	rpctest "github.com/cometbft/cometbft/rpc/test"
+	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -152,6 +154,26 @@ func TestConsensusState(t *testing.T) {
 	}
 }
 
+func TestConsensusTrace(t *testing.T) {
+	for i, c := range GetClients() {
+		nc, ok := c.(client.NetworkClient)
+		require.True(t, ok, "%d", i)
+		res, err := nc.ConsensusTrace(context.Background(), nil)
+		require.Nil(t, err, "%d: %+v", i, err)
+		assert.NotEmpty(t, res.Trace)
+
+		// the timeline of a committed height holds its votes
+		require.NoError(t, client.WaitForHeight(c, 1, nil))
+		status, err := c.Status(context.Background())
+		require.Nil(t, err, "%d: %+v", i, err)
+		height := status.SyncInfo.LatestBlockHeight
+		res, err = nc.ConsensusTrace(context.Background(), &height)
+		require.Nil(t, err, "%d: %+v", i, err)
+		assert.Contains(t, string(res.Trace), fmt.Sprintf(`"height":"%d"`, height))
+		assert.Contains(t, string(res.Trace), `"type":"vote"`)
+	}
+}
+
 func TestHealth(t *testing.T) {
 	for i, c := range GetClients() {
 		nc, ok := c.(client.NetworkClient)
@@ -356,6 +378,39 @@ func TestBroadcastTxSync(t *testing.T) {
 	}
 }
 
+func TestBroadcastTxs(t *testing.T) {
+	for i, c := range GetClients() {
+		_, _, tx1 := MakeTxKV()
+		_, _, tx2 := MakeTxKV()
+		txs := []types.Tx{tx1, []byte("invalid"), tx2, tx1}
+
+		res, err := c.BroadcastTxs(context.Background(), txs, false)
+		require.NoError(t, err, "%d", i)
+		require.Len(t, res.Results, len(txs))
+		for j, tx := range txs {
+			assert.EqualValues(t, tx.Hash(), res.Results[j].Hash, "%d: tx %d", i, j)
+		}
+		assert.Equal(t, abci.CodeTypeOK, res.Results[0].Code)
+		assert.Empty(t, res.Results[0].Error)
+		assert.Equal(t, kvstore.CodeTypeInvalidTxFormat, res.Results[1].Code)
+		assert.Equal(t, abci.CodeTypeOK, res.Results[2].Code)
+		// The duplicate tx is rejected by the cache, before CheckTx.
+		assert.Equal(t, mempl.ErrTxInCache.Error(), res.Results[3].Error)
+
+		_, _, tx3 := MakeTxKV()
+		res, err = c.BroadcastTxs(context.Background(), []types.Tx{tx3, tx1}, true)
+		require.NoError(t, err, "%d", i)
+		require.Len(t, res.Results, 2)
+		assert.EqualValues(t, types.Tx(tx3).Hash(), res.Results[0].Hash)
+		assert.Empty(t, res.Results[0].Error)
+		assert.Equal(t, mempl.ErrTxInCache.Error(), res.Results[1].Error)
+
+		// The number of txs is limited by max_request_batch_size.
+		_, err = c.BroadcastTxs(context.Background(), make([]types.Tx, rpctest.GetConfig().RPC.MaxRequestBatchSize+1), false)
+		require.ErrorContains(t, err, "too many txs")
+	}
+}
+
 func TestBroadcastTxCommit(t *testing.T) {
 	require := require.New(t)
 
@@ -371,6 +426,75 @@ func TestBroadcastTxCommit(t *testing.T) {
 	}
 }
 
+func TestBroadcastTxTrack(t *testing.T) {
+	for i, c := range GetClients() {
+		_, _, tx := MakeTxKV()
+		res, err := c.BroadcastTxTrack(context.Background(), tx, "")
+		require.NoError(t, err, "%d", i)
+		require.True(t, res.CheckTx.IsOK())
+		require.NotEmpty(t, res.ID)
+
+		var status *ctypes.ResultTxTrack
+		require.Eventually(t, func() bool {
+			status, err = c.TxTrack(context.Background(), res.ID)
+			require.NoError(t, err)
+			return status.Status != ctypes.TxTrackPending
+		}, 10*time.Second, 50*time.Millisecond)
+		assert.Equal(t, ctypes.TxTrackCommitted, status.Status, "%d", i)
+		assert.EqualValues(t, types.Tx(tx).Hash(), status.Hash)
+		assert.Positive(t, status.Height)
+		assert.True(t, status.TxResult.IsOK())
+
+		// The txs failing CheckTx are not tracked.
+		res, err = c.BroadcastTxTrack(context.Background(), []byte("invalid"), "")
+		require.NoError(t, err, "%d", i)
+		assert.Equal(t, kvstore.CodeTypeInvalidTxFormat, res.CheckTx.Code)
+		assert.Empty(t, res.ID)
+
+		// Webhooks are disabled by default.
+		_, _, tx = MakeTxKV()
+		_, err = c.BroadcastTxTrack(context.Background(), tx, "http://localhost:1")
+		require.ErrorContains(t, err, "tx_track_webhooks")
+	}
+}
+
+func TestBroadcastTxTrackWebsocket(t *testing.T) {
+	cl, err := rpcclient.NewWS(rpctest.GetConfig().RPC.ListenAddress, "/websocket")
+	require.NoError(t, err)
+	cl.SetLogger(log.TestingLogger())
+	require.NoError(t, cl.Start())
+	t.Cleanup(func() {
+		if err := cl.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	_, _, tx := MakeTxKV()
+	require.NoError(t, cl.Call(context.Background(), "broadcast_tx_track", map[string]any{"tx": tx}))
+
+	// The response is followed by a notification once the tx is committed.
+	var res ctypes.ResultBroadcastTxTrack
+	select {
+	case msg := <-cl.ResponsesCh:
+		require.Nil(t, msg.Error)
+		require.NoError(t, cmtjson.Unmarshal(msg.Result, &res))
+		require.NotEmpty(t, res.ID)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no response")
+	}
+	select {
+	case msg := <-cl.ResponsesCh:
+		require.Nil(t, msg.Error)
+		var status ctypes.ResultTxTrack
+		require.NoError(t, cmtjson.Unmarshal(msg.Result, &status))
+		assert.Equal(t, res.ID, status.ID)
+		assert.Equal(t, ctypes.TxTrackCommitted, status.Status)
+		assert.Positive(t, status.Height)
+	case <-time.After(10 * time.Second):
+		t.Fatal("no notification")
+	}
+}
+
 func TestUnconfirmedTxs(t *testing.T) {
 	_, _, tx := MakeTxKV()
 
@@ -445,6 +569,27 @@ func TestCheckTx(t *testing.T) {
 	}
 }
 
+func TestSimulateTx(t *testing.T) {
+	mempool := node.Mempool()
+
+	for _, c := range GetClients() {
+		k, v, tx := MakeTxKV()
+
+		res, err := c.SimulateTx(context.Background(), tx)
+		require.NoError(t, err)
+		assert.EqualValues(t, types.Tx(tx).Hash(), res.Hash)
+		assert.Equal(t, abci.CodeTypeOK, res.TxResult.Code)
+		require.Len(t, res.TxResult.Events, 2)
+		assert.Equal(t, string(k), res.TxResult.Events[0].Attributes[1].Value)
+		assert.Equal(t, string(v), res.TxResult.Events[1].Attributes[1].Value)
+
+		assert.Equal(t, 0, mempool.Size(), "mempool must be empty")
+		qres, err := c.ABCIQuery(context.Background(), "/key", k)
+		require.NoError(t, err)
+		assert.Nil(t, qres.Response.Value, "simulated tx must not be persisted")
+	}
+}
+
 func TestTx(t *testing.T) {
 	// first we broadcast a tx
 	c := getHTTPClient()
@@ -499,6 +644,39 @@ func TestTx(t *testing.T) {
 	}
 }
 
+func TestTxResultProof(t *testing.T) {
+	c := getHTTPClient()
+	_, _, tx := MakeTxKV()
+	bres, err := c.BroadcastTxCommit(context.Background(), tx)
+	require.NoError(t, err)
+	// The results are committed by the next header.
+	require.NoError(t, client.WaitForHeight(c, bres.Height+1, nil))
+	nextHeight := bres.Height + 1
+
+	for i, c := range GetClients() {
+		res, err := c.TxResultProof(context.Background(), bres.Hash)
+		require.NoError(t, err, "%d", i)
+		assert.EqualValues(t, bres.Height, res.Height)
+		assert.EqualValues(t, tx, res.Tx)
+		assert.Equal(t, bres.TxResult, res.TxResult)
+
+		block, err := c.Block(context.Background(), &res.Height)
+		require.NoError(t, err)
+		assert.NoError(t, res.TxProof.Validate(block.Block.DataHash))
+		header, err := c.Header(context.Background(), &nextHeight)
+		require.NoError(t, err)
+		assert.NoError(t, res.TxResultProof.Validate(header.Header.LastResultsHash, &res.TxResult))
+
+		blockResults, err := c.BlockResultsWithProofs(context.Background(), &res.Height)
+		require.NoError(t, err)
+		require.Len(t, blockResults.TxsResultsProofs, len(blockResults.TxsResults))
+		assert.Equal(t, res.TxResultProof, blockResults.TxsResultsProofs[res.Index])
+
+		_, err = c.TxResultProof(context.Background(), types.Tx("a different tx").Hash())
+		require.Error(t, err)
+	}
+}
+
 func TestTxSearchWithTimeout(t *testing.T) {
 	// Get a client with a time-out of 10 secs.
 	timeoutClient := getHTTPClientWithTimeout(10)
@@ -552,7 +730,7 @@ func TestTxSearch(t *testing.T) {
 	// from other tests as well
 	result, err := c.TxSearch(context.Background(), "tx.height >= 0", true, nil, nil, "asc")
 	require.NoError(t, err)
-	txCount := len(result.Txs)
+	txCount := result.TotalCount
 
 	// pick out the last tx to have something to search for in tests
 	find := result.Txs[len(result.Txs)-1]
@@ -618,23 +796,18 @@ func TestTxSearch(t *testing.T) {
 		// check sorting
 		result, err = c.TxSearch(context.Background(), "tx.height >= 1", false, nil, nil, "asc")
 		require.Nil(t, err)
-		for k := 0; k < len(result.Txs)-1; k++ {
-			require.LessOrEqual(t, result.Txs[k].Height, result.Txs[k+1].Height)
-			require.LessOrEqual(t, result.Txs[k].Index, result.Txs[k+1].Index)
-		}
+		requireTxsInOrder(t, result.Txs, false)
 
 		result, err = c.TxSearch(context.Background(), "tx.height >= 1", false, nil, nil, "desc")
 		require.Nil(t, err)
-		for k := 0; k < len(result.Txs)-1; k++ {
-			require.GreaterOrEqual(t, result.Txs[k].Height, result.Txs[k+1].Height)
-			require.GreaterOrEqual(t, result.Txs[k].Index, result.Txs[k+1].Index)
-		}
+		requireTxsInOrder(t, result.Txs, true)
+
 		// check pagination
 		perPage = 3
 		var (
-			seen      = map[int64]bool{}
-			maxHeight int64
-			pages     = int(math.Ceil(float64(txCount) / float64(perPage)))
+			seen  = map[txPosition]bool{}
+			txs   []*ctypes.ResultTx
+			pages = int(math.Ceil(float64(txCount) / float64(perPage)))
 		)
 
 		totalTx := 0
@@ -649,16 +822,52 @@ func TestTxSearch(t *testing.T) {
 			}
 			totalTx += len(result.Txs)
 			for _, tx := range result.Txs {
-				require.False(t, seen[tx.Height],
-					"Found duplicate height %v in page %v", tx.Height, page)
-				require.Greater(t, tx.Height, maxHeight,
-					"Found decreasing height %v (max seen %v) in page %v", tx.Height, maxHeight, page)
-				seen[tx.Height] = true
-				maxHeight = tx.Height
+				pos := txPosition{tx.Height, tx.Index}
+				require.False(t, seen[pos],
+					"Found duplicate tx at height %v, index %v in page %v", tx.Height, tx.Index, page)
+				seen[pos] = true
 			}
+			txs = append(txs, result.Txs...)
 		}
 		require.Equal(t, txCount, totalTx)
 		require.Len(t, seen, txCount)
+		requireTxsInOrder(t, txs, false)
+
+		// check pagination with cursors, from the first page
+		result, err = c.TxSearchAfter(context.Background(), "tx.height >= 1", false, indexer.FirstCursor, &perPage, "desc")
+		require.NoError(t, err)
+		txs = result.Txs
+		for result.NextCursor != "" {
+			result, err = c.TxSearchAfter(context.Background(), "tx.height >= 1", false, result.NextCursor, &perPage, "desc")
+			require.NoError(t, err)
+			require.NotEmpty(t, result.Txs)
+			require.LessOrEqual(t, len(result.Txs), perPage)
+			txs = append(txs, result.Txs...)
+		}
+		require.Len(t, txs, txCount)
+		requireTxsInOrder(t, txs, true)
+	}
+}
+
+// txPosition is the position of a tx in the chain.
+type txPosition struct {
+	height int64
+	index  uint32
+}
+
+// requireTxsInOrder checks that txs are strictly sorted by height, then by
+// index within a block, in descending order if desc is set, since several
+// txs may be committed in the same block.
+func requireTxsInOrder(t *testing.T, txs []*ctypes.ResultTx, desc bool) {
+	t.Helper()
+	for k := 0; k < len(txs)-1; k++ {
+		a, b := txs[k], txs[k+1]
+		if desc {
+			a, b = b, a
+		}
+		require.True(t, a.Height < b.Height || (a.Height == b.Height && a.Index < b.Index),
+			"tx at height %v, index %v is not before tx at height %v, index %v",
+			a.Height, a.Index, b.Height, b.Index)
 	}
 }
 
@@ -2,12 +2,16 @@ package core
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cometbft/cometbft/proxy"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
 )
 
 // ABCIQuery queries the application for some information.
@@ -42,3 +46,56 @@ func (env *Environment) ABCIInfo(_ *rpctypes.Context) (*ctypes.ResultABCIInfo, e
 
 	return &ctypes.ResultABCIInfo{Response: *resInfo}, nil
 }
+
+// SimulateTx executes a transaction against the latest committed state of the
+// application, without persisting its changes, and returns the result its
+// execution in a block would have, including its events and gas. It fails if
+// the application does not support simulations (see abci.Simulator). It is
+// only routed if the rpc.simulate_tx config flag is set.
+func (env *Environment) SimulateTx(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultSimulateTx, error) {
+	if env.Config.MaxConcurrentSimulations == 0 {
+		return nil, errors.New("simulate_tx is disabled")
+	}
+	env.simulationSlotsOnce.Do(func() {
+		env.simulationSlots = make(chan struct{}, env.Config.MaxConcurrentSimulations)
+	})
+	select {
+	case env.simulationSlots <- struct{}{}:
+	default:
+		return nil, fmt.Errorf("too many concurrent simulations (max: %d)", env.Config.MaxConcurrentSimulations)
+	}
+
+	type simulateResult struct {
+		res *abci.ResponseSimulateTx
+		err error
+	}
+	resCh := make(chan simulateResult, 1)
+	// The slot is only released once the application returns, even after a
+	// timeout, so that the slow simulations cannot pile up in the application.
+	go func() {
+		defer func() { <-env.simulationSlots }()
+		res, err := env.ProxyAppQuery.SimulateTx(context.TODO(), &abci.RequestSimulateTx{Tx: tx})
+		resCh <- simulateResult{res, err}
+	}()
+
+	var timeoutCh <-chan time.Time
+	if env.Config.TimeoutSimulateTx > 0 {
+		timer := time.NewTimer(env.Config.TimeoutSimulateTx)
+		defer timer.Stop()
+		timeoutCh = timer.C
+	}
+	select {
+	case r := <-resCh:
+		if r.err != nil {
+			return nil, r.err
+		}
+		if r.res.TxResult == nil {
+			return nil, errors.New("the application returned no result")
+		}
+		return &ctypes.ResultSimulateTx{Hash: tx.Hash(), TxResult: *r.res.TxResult}, nil
+	case <-timeoutCh:
+		return nil, errors.New("timed out waiting for the simulation")
+	case <-ctx.Context().Done():
+		return nil, fmt.Errorf("simulation canceled: %w", ctx.Context().Err())
+	}
+}
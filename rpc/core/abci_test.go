@@ -0,0 +1,70 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/proxy/mocks"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestSimulateTx(t *testing.T) {
+	tx := types.Tx("a=b")
+	txResult := abci.ExecTxResult{
+		GasWanted: 10,
+		GasUsed:   5,
+		Events:    []abci.Event{{Type: "app", Attributes: []abci.EventAttribute{{Key: "key", Value: "a"}}}},
+	}
+
+	proxyAppQuery := &mocks.AppConnQuery{}
+	proxyAppQuery.On("SimulateTx", mock.Anything, &abci.RequestSimulateTx{Tx: tx}).
+		Return(&abci.ResponseSimulateTx{TxResult: &txResult}, nil).Once()
+	proxyAppQuery.On("SimulateTx", mock.Anything, &abci.RequestSimulateTx{Tx: tx}).
+		Return(nil, abci.ErrSimulateTxNotSupported).Once()
+	env := &Environment{ProxyAppQuery: proxyAppQuery, Config: *cfg.TestRPCConfig()}
+
+	res, err := env.SimulateTx(&rpctypes.Context{}, tx)
+	require.NoError(t, err)
+	assert.EqualValues(t, tx.Hash(), res.Hash)
+	assert.Equal(t, txResult, res.TxResult)
+
+	// The calls to the applications not supporting simulations fail.
+	_, err = env.SimulateTx(&rpctypes.Context{}, tx)
+	require.ErrorIs(t, err, abci.ErrSimulateTxNotSupported)
+	proxyAppQuery.AssertExpectations(t)
+
+	env.Config.MaxConcurrentSimulations = 0
+	_, err = env.SimulateTx(&rpctypes.Context{}, tx)
+	require.ErrorContains(t, err, "disabled")
+}
+
+func TestSimulateTxLimits(t *testing.T) {
+	release := make(chan time.Time)
+	proxyAppQuery := &mocks.AppConnQuery{}
+	proxyAppQuery.On("SimulateTx", mock.Anything, mock.Anything).WaitUntil(release).
+		Return(&abci.ResponseSimulateTx{TxResult: &abci.ExecTxResult{}}, nil)
+	env := &Environment{ProxyAppQuery: proxyAppQuery, Config: *cfg.TestRPCConfig()}
+	env.Config.MaxConcurrentSimulations = 1
+	env.Config.TimeoutSimulateTx = 10 * time.Millisecond
+
+	_, err := env.SimulateTx(&rpctypes.Context{}, types.Tx("a=b"))
+	require.ErrorContains(t, err, "timed out")
+
+	// The slot of the simulation which timed out is held until the
+	// application returns.
+	_, err = env.SimulateTx(&rpctypes.Context{}, types.Tx("a=b"))
+	require.ErrorContains(t, err, "too many concurrent simulations")
+
+	close(release)
+	require.Eventually(t, func() bool {
+		_, err := env.SimulateTx(&rpctypes.Context{}, types.Tx("a=b"))
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+}
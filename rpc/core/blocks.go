@@ -10,6 +10,7 @@ import (
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/state/indexer"
 	blockidxnull "github.com/cometbft/cometbft/state/indexer/block/null"
 	"github.com/cometbft/cometbft/types"
 )
@@ -180,8 +181,11 @@ func (env *Environment) Commit(_ *rpctypes.Context, heightPtr *int64) (*ctypes.R
 // Results are for the height of the block containing the txs.
 // Thus response.results.deliver_tx[5] is the results of executing
 // getBlock(h).Txs[5]
+//
+// If prove is true, the proofs of the results against the LastResultsHash of
+// the next header are returned too, in the same order.
 // More: https://docs.cometbft.com/v0.38/spec/rpc/#blockresults
-func (env *Environment) BlockResults(_ *rpctypes.Context, heightPtr *int64) (*ctypes.ResultBlockResults, error) {
+func (env *Environment) BlockResults(_ *rpctypes.Context, heightPtr *int64, prove bool) (*ctypes.ResultBlockResults, error) {
 	height, err := env.getHeight(env.BlockStore.Height(), heightPtr)
 	if err != nil {
 		return nil, err
@@ -193,9 +197,15 @@ func (env *Environment) BlockResults(_ *rpctypes.Context, heightPtr *int64) (*ct
 		return nil, err
 	}
 
+	var proofs []types.TxResultProof
+	if prove {
+		proofs = types.NewResults(results.TxResults).Proofs()
+	}
+
 	return &ctypes.ResultBlockResults{
 		Height:                height,
 		TxsResults:            results.TxResults,
+		TxsResultsProofs:      proofs,
 		FinalizeBlockEvents:   results.Events,
 		ValidatorUpdates:      results.ValidatorUpdates,
 		ConsensusParamUpdates: results.ConsensusParamUpdates,
@@ -205,11 +215,17 @@ func (env *Environment) BlockResults(_ *rpctypes.Context, heightPtr *int64) (*ct
 
 // BlockSearch searches for a paginated set of blocks matching
 // FinalizeBlock event search criteria.
+//
+// Each page of results comes with a cursor if more results follow. Passing
+// the cursor instead of a page number returns the results following it, in
+// the same order, without computing the total count. The cursor "first"
+// returns the first page this way.
 func (env *Environment) BlockSearch(
 	ctx *rpctypes.Context,
 	query string,
 	pagePtr, perPagePtr *int,
 	orderBy string,
+	cursor string,
 ) (*ctypes.ResultBlockSearch, error) {
 	// skip if block indexing is disabled
 	if _, ok := env.BlockIndexer.(*blockidxnull.BlockerIndexer); ok {
@@ -221,21 +237,47 @@ func (env *Environment) BlockSearch(
 		return nil, err
 	}
 
+	var desc bool
+	switch orderBy {
+	case "desc", "":
+		desc = true
+	case "asc":
+	default:
+		return nil, errors.New("expected order_by to be either `asc` or `desc` or empty")
+	}
+
+	if cursor != "" {
+		if pagePtr != nil {
+			return nil, errors.New("page and cursor cannot be used together")
+		}
+		after, err := indexer.ParseAfter(cursor)
+		if err != nil {
+			return nil, err
+		}
+		results, more, err := env.BlockIndexer.SearchPage(ctx.Context(), q, indexer.Page{
+			After: after,
+			Limit: env.validatePerPage(perPagePtr),
+			Desc:  desc,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &ctypes.ResultBlockSearch{
+			Blocks:     env.blockSearchResults(results),
+			NextCursor: nextBlockCursor(results, more),
+		}, nil
+	}
+
 	results, err := env.BlockIndexer.Search(ctx.Context(), q)
 	if err != nil {
 		return nil, err
 	}
 
 	// sort results (must be done before pagination)
-	switch orderBy {
-	case "desc", "":
+	if desc {
 		sort.Slice(results, func(i, j int) bool { return results[i] > results[j] })
-
-	case "asc":
+	} else {
 		sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
-
-	default:
-		return nil, errors.New("expected order_by to be either `asc` or `desc` or empty")
 	}
 
 	// paginate results
@@ -249,10 +291,20 @@ func (env *Environment) BlockSearch(
 
 	skipCount := validateSkipCount(page, perPage)
 	pageSize := cmtmath.MinInt(perPage, totalCount-skipCount)
+	pageResults := results[skipCount : skipCount+pageSize]
 
-	apiResults := make([]*ctypes.ResultBlock, 0, pageSize)
-	for i := skipCount; i < skipCount+pageSize; i++ {
-		block := env.BlockStore.LoadBlock(results[i])
+	return &ctypes.ResultBlockSearch{
+		Blocks:     env.blockSearchResults(pageResults),
+		TotalCount: totalCount,
+		NextCursor: nextBlockCursor(pageResults, skipCount+pageSize < totalCount),
+	}, nil
+}
+
+// blockSearchResults loads the blocks at the given heights.
+func (env *Environment) blockSearchResults(heights []int64) []*ctypes.ResultBlock {
+	apiResults := make([]*ctypes.ResultBlock, 0, len(heights))
+	for _, height := range heights {
+		block := env.BlockStore.LoadBlock(height)
 		if block != nil {
 			blockMeta := env.BlockStore.LoadBlockMeta(block.Height)
 			if blockMeta != nil {
@@ -263,6 +315,14 @@ func (env *Environment) BlockSearch(
 			}
 		}
 	}
+	return apiResults
+}
 
-	return &ctypes.ResultBlockSearch{Blocks: apiResults, TotalCount: totalCount}, nil
+// nextBlockCursor returns the cursor of the last of the heights if more
+// heights follow them, and an empty string otherwise.
+func nextBlockCursor(heights []int64, more bool) string {
+	if !more || len(heights) == 0 {
+		return ""
+	}
+	return indexer.Cursor{Height: heights[len(heights)-1]}.String()
 }
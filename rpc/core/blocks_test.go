@@ -107,7 +107,7 @@ func TestBlockResults(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		res, err := env.BlockResults(&rpctypes.Context{}, &tc.height)
+		res, err := env.BlockResults(&rpctypes.Context{}, &tc.height, false)
 		if tc.wantErr {
 			assert.Error(t, err)
 		} else {
@@ -115,4 +115,13 @@ func TestBlockResults(t *testing.T) {
 			assert.Equal(t, tc.wantRes, res)
 		}
 	}
+
+	// The proofs of the results are against the results hash.
+	height := int64(100)
+	res, err := env.BlockResults(&rpctypes.Context{}, &height, true)
+	require.NoError(t, err)
+	require.Len(t, res.TxsResultsProofs, len(results.TxResults))
+	for i, proof := range res.TxsResultsProofs {
+		assert.NoError(t, proof.Validate(sm.TxResultsHash(results.TxResults), results.TxResults[i]), "%d", i)
+	}
 }
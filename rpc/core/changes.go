@@ -0,0 +1,291 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// ValidatorSetChanges returns the heights in the range [from, to] at which the
+// validator set changed, with the validators whose voting power changed.
+//
+// If from is not provided, it defaults to the lowest available height; if to
+// is not provided, it defaults to the height of the latest validator set. At
+// most 100 changes, the latest ones, are returned: if there are more, the
+// result's from is raised above the requested one, and the earlier changes
+// can be queried with to set to from-1.
+func (env *Environment) ValidatorSetChanges(
+	_ *rpctypes.Context,
+	fromPtr, toPtr *int64,
+) (*ctypes.ResultValidatorSetChanges, error) {
+	from, to, err := env.changesRange(fromPtr, toPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	heights, from, err := changeHeights(from, to, env.StateStore.LoadValidatorsChangeHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]ctypes.ValidatorSetChange, 0, len(heights))
+	for _, height := range heights {
+		vals, err := env.StateStore.LoadValidators(height)
+		if err != nil {
+			return nil, err
+		}
+		prevVals, err := env.loadPreviousValidators(height)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, ctypes.ValidatorSetChange{
+			Height:  height,
+			Changes: validatorPowerChanges(prevVals, vals),
+		})
+	}
+
+	return &ctypes.ResultValidatorSetChanges{
+		From:    from,
+		To:      to,
+		Changes: changes,
+	}, nil
+}
+
+// ConsensusParamsChanges returns the heights in the range [from, to] at which
+// the consensus params changed, with the params that changed.
+//
+// The range is defined and limited as for ValidatorSetChanges.
+func (env *Environment) ConsensusParamsChanges(
+	_ *rpctypes.Context,
+	fromPtr, toPtr *int64,
+) (*ctypes.ResultConsensusParamsChanges, error) {
+	from, to, err := env.changesRange(fromPtr, toPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	heights, from, err := changeHeights(from, to, env.StateStore.LoadConsensusParamsChangeHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]ctypes.ConsensusParamsChange, 0, len(heights))
+	for _, height := range heights {
+		params, err := env.StateStore.LoadConsensusParams(height)
+		if err != nil {
+			return nil, err
+		}
+		var prevParams *types.ConsensusParams
+		if height > 1 {
+			p, err := env.StateStore.LoadConsensusParams(height - 1)
+			// The params before the initial height or below the pruned
+			// heights are unknown.
+			if err == nil {
+				prevParams = &p
+			}
+		}
+		paramChanges, err := consensusParamChanges(prevParams, params)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, ctypes.ConsensusParamsChange{
+			Height:          height,
+			ConsensusParams: params,
+			Changes:         paramChanges,
+		})
+	}
+
+	return &ctypes.ResultConsensusParamsChanges{
+		From:    from,
+		To:      to,
+		Changes: changes,
+	}, nil
+}
+
+// changesRange returns the range of heights of a query for changes.
+func (env *Environment) changesRange(fromPtr, toPtr *int64) (int64, int64, error) {
+	latestHeight := env.latestUncommittedHeight()
+	to, err := env.getHeight(latestHeight, toPtr)
+	if err != nil {
+		return 0, 0, err
+	}
+	from := env.BlockStore.Base()
+	if fromPtr != nil {
+		if from, err = env.getHeight(latestHeight, fromPtr); err != nil {
+			return 0, 0, err
+		}
+	}
+	if from < 1 {
+		from = 1
+	}
+	if from > to {
+		return 0, 0, fmt.Errorf("from %d must be less than or equal to to %d", from, to)
+	}
+	return from, to, nil
+}
+
+// changeHeights walks the change heights returned by changeHeight back from
+// to, and returns the ones in [from, to] in ascending order. It returns at
+// most maxPerPage heights, the highest ones, along with the lowest height
+// covered by them.
+func changeHeights(from, to int64, changeHeight func(int64) (int64, error)) ([]int64, int64, error) {
+	var heights []int64
+	height := to
+	for height >= from {
+		if len(heights) == maxPerPage {
+			from = height + 1
+			break
+		}
+		changed, err := changeHeight(height)
+		if err != nil {
+			return nil, 0, err
+		}
+		if changed > height {
+			return nil, 0, fmt.Errorf("height %d changed at later height %d", height, changed)
+		}
+		if changed < from {
+			break
+		}
+		heights = append(heights, changed)
+		height = changed - 1
+	}
+
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights, from, nil
+}
+
+// loadPreviousValidators loads the validator set preceding the given height,
+// or returns nil if it is unknown, as for the initial height or below the
+// pruned heights.
+func (env *Environment) loadPreviousValidators(height int64) (*types.ValidatorSet, error) {
+	if height <= 1 {
+		return nil, nil
+	}
+	vals, err := env.StateStore.LoadValidators(height - 1)
+	if errors.As(err, &sm.ErrNoValSetForHeight{}) {
+		return nil, nil
+	}
+	return vals, err
+}
+
+// validatorPowerChanges returns the validators whose voting power differs
+// between prevVals and vals: the validators of vals first, in their order,
+// then the removed ones.
+func validatorPowerChanges(prevVals, vals *types.ValidatorSet) []ctypes.ValidatorPowerChange {
+	prevPowers := make(map[string]int64)
+	if prevVals != nil {
+		for _, v := range prevVals.Validators {
+			prevPowers[string(v.Address)] = v.VotingPower
+		}
+	}
+
+	changes := make([]ctypes.ValidatorPowerChange, 0)
+	for _, v := range vals.Validators {
+		prevPower := prevPowers[string(v.Address)]
+		delete(prevPowers, string(v.Address))
+		if prevPower == v.VotingPower {
+			continue
+		}
+		changes = append(changes, ctypes.ValidatorPowerChange{
+			Address:       v.Address,
+			PubKey:        v.PubKey,
+			PreviousPower: prevPower,
+			Power:         v.VotingPower,
+		})
+	}
+	if prevVals != nil {
+		for _, v := range prevVals.Validators {
+			if _, removed := prevPowers[string(v.Address)]; !removed {
+				continue
+			}
+			changes = append(changes, ctypes.ValidatorPowerChange{
+				Address:       v.Address,
+				PubKey:        v.PubKey,
+				PreviousPower: v.VotingPower,
+			})
+		}
+	}
+	return changes
+}
+
+// consensusParamChanges returns the params that differ between prevParams and
+// params, sorted by name. All the params are returned if prevParams is nil.
+func consensusParamChanges(prevParams *types.ConsensusParams, params types.ConsensusParams) ([]ctypes.ConsensusParamChange, error) {
+	values, err := flattenParams(params)
+	if err != nil {
+		return nil, err
+	}
+	prevValues := map[string]string{}
+	if prevParams != nil {
+		if prevValues, err = flattenParams(*prevParams); err != nil {
+			return nil, err
+		}
+	}
+
+	changes := make([]ctypes.ConsensusParamChange, 0)
+	for name, value := range values {
+		if prevValue, ok := prevValues[name]; ok && prevValue == value {
+			continue
+		}
+		changes = append(changes, ctypes.ConsensusParamChange{
+			Param:         name,
+			PreviousValue: prevValues[name],
+			Value:         value,
+		})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Param < changes[j].Param })
+	return changes, nil
+}
+
+// flattenParams returns the values of the consensus params, as encoded in
+// the RPC responses, by their dotted JSON name, like "block.max_bytes".
+func flattenParams(params types.ConsensusParams) (map[string]string, error) {
+	bz, err := cmtjson.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	var tree map[string]any
+	if err := dec.Decode(&tree); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string)
+	if err := flattenJSON("", tree, values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func flattenJSON(prefix string, v any, values map[string]string) error {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			name := k
+			if prefix != "" {
+				name = prefix + "." + k
+			}
+			if err := flattenJSON(name, child, values); err != nil {
+				return err
+			}
+		}
+	case string:
+		values[prefix] = v
+	default:
+		bz, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		values[prefix] = string(bz)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
+)
+
+type syncedReactor struct{}
+
+func (syncedReactor) WaitSync() bool { return false }
+
+// changesTestEnv returns an environment with 9 committed blocks, where the
+// validator set changes at heights 1, 5 and 8, and the consensus params at
+// heights 1 and 5.
+func changesTestEnv(t *testing.T) (*Environment, []*types.Validator) {
+	t.Helper()
+
+	vals := make([]*types.Validator, 3)
+	for i := range vals {
+		vals[i] = types.NewValidator(ed25519.GenPrivKey().PubKey(), 10)
+	}
+
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	state := sm.State{
+		InitialHeight:                    1,
+		Validators:                       types.NewValidatorSet(vals[:2]),
+		NextValidators:                   types.NewValidatorSet(vals[:2]),
+		LastHeightValidatorsChanged:      1,
+		ConsensusParams:                  *types.DefaultConsensusParams(),
+		LastHeightConsensusParamsChanged: 1,
+	}
+	require.NoError(t, stateStore.Save(state))
+	for h := int64(1); h <= 9; h++ {
+		state.LastBlockHeight = h
+		switch h {
+		case 3:
+			// Increase the power of the first validator and add the third one.
+			state.NextValidators = types.NewValidatorSet([]*types.Validator{
+				types.NewValidator(vals[0].PubKey, 20), vals[1], vals[2],
+			})
+			state.LastHeightValidatorsChanged = h + 2
+		case 4:
+			state.ConsensusParams.Block.MaxBytes = 1024
+			state.LastHeightConsensusParamsChanged = h + 1
+		case 6:
+			// Remove the second validator.
+			state.NextValidators = types.NewValidatorSet([]*types.Validator{
+				types.NewValidator(vals[0].PubKey, 20), vals[2],
+			})
+			state.LastHeightValidatorsChanged = h + 2
+		}
+		require.NoError(t, stateStore.Save(state))
+	}
+
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("Base").Return(int64(1))
+	blockStore.On("Height").Return(int64(9))
+
+	return &Environment{
+		StateStore:       stateStore,
+		BlockStore:       blockStore,
+		ConsensusReactor: syncedReactor{},
+	}, vals
+}
+
+func TestValidatorSetChanges(t *testing.T) {
+	env, vals := changesTestEnv(t)
+
+	res, err := env.ValidatorSetChanges(&rpctypes.Context{}, nil, nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, res.From)
+	require.EqualValues(t, 10, res.To)
+	require.Len(t, res.Changes, 3)
+
+	require.EqualValues(t, 1, res.Changes[0].Height)
+	require.Len(t, res.Changes[0].Changes, 2)
+	require.Zero(t, res.Changes[0].Changes[0].PreviousPower)
+
+	require.EqualValues(t, 5, res.Changes[1].Height)
+	require.Len(t, res.Changes[1].Changes, 2)
+	for _, c := range res.Changes[1].Changes {
+		switch c.Address.String() {
+		case vals[0].Address.String():
+			require.EqualValues(t, 10, c.PreviousPower)
+			require.EqualValues(t, 20, c.Power)
+		case vals[2].Address.String():
+			require.Zero(t, c.PreviousPower)
+			require.EqualValues(t, 10, c.Power)
+		default:
+			t.Fatalf("unexpected change %v", c)
+		}
+	}
+
+	require.EqualValues(t, 8, res.Changes[2].Height)
+	require.Len(t, res.Changes[2].Changes, 1)
+	require.Equal(t, vals[1].Address, res.Changes[2].Changes[0].Address)
+	require.EqualValues(t, 10, res.Changes[2].Changes[0].PreviousPower)
+	require.Zero(t, res.Changes[2].Changes[0].Power)
+
+	from, to := int64(2), int64(7)
+	res, err = env.ValidatorSetChanges(&rpctypes.Context{}, &from, &to)
+	require.NoError(t, err)
+	require.Len(t, res.Changes, 1)
+	require.EqualValues(t, 5, res.Changes[0].Height)
+
+	from, to = int64(7), int64(2)
+	_, err = env.ValidatorSetChanges(&rpctypes.Context{}, &from, &to)
+	require.Error(t, err)
+}
+
+func TestConsensusParamsChanges(t *testing.T) {
+	env, _ := changesTestEnv(t)
+
+	res, err := env.ConsensusParamsChanges(&rpctypes.Context{}, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, res.Changes, 2)
+	require.EqualValues(t, 1, res.Changes[0].Height)
+	require.NotEmpty(t, res.Changes[0].Changes)
+
+	require.EqualValues(t, 5, res.Changes[1].Height)
+	require.EqualValues(t, 1024, res.Changes[1].ConsensusParams.Block.MaxBytes)
+	require.Len(t, res.Changes[1].Changes, 1)
+	change := res.Changes[1].Changes[0]
+	require.Equal(t, "block.max_bytes", change.Param)
+	require.Equal(t, "22020096", change.PreviousValue)
+	require.Equal(t, "1024", change.Value)
+}
+
+func TestChangeHeightsLimit(t *testing.T) {
+	// Every height is a change.
+	heights, from, err := changeHeights(1, 1000, func(h int64) (int64, error) { return h, nil })
+	require.NoError(t, err)
+	require.Len(t, heights, maxPerPage)
+	require.EqualValues(t, 1000-maxPerPage+1, from)
+	require.Equal(t, from, heights[0])
+	require.EqualValues(t, 1000, heights[maxPerPage-1])
+}
@@ -101,6 +101,32 @@ func (env *Environment) GetConsensusState(*rpctypes.Context) (*ctypes.ResultCons
 	return &ctypes.ResultConsensusState{RoundState: bz}, err
 }
 
+// ConsensusTrace returns the consensus timeline recorded for the given height:
+// step transitions, fired timeouts, arrival of the proposal, block parts and
+// votes (with the sending peer), as well as the time spent in PrepareProposal
+// and ProcessProposal. Only the consensus.trace_heights most recent heights
+// are kept, none by default.
+// If no height is provided, it returns the timeline of the current height.
+// UNSTABLE
+func (env *Environment) ConsensusTrace(
+	_ *rpctypes.Context,
+	heightPtr *int64,
+) (*ctypes.ResultConsensusTrace, error) {
+	height := env.ConsensusState.GetLastHeight() + 1
+	if heightPtr != nil {
+		if *heightPtr <= 0 {
+			return nil, fmt.Errorf("height must be greater than 0, but got %d", *heightPtr)
+		}
+		height = *heightPtr
+	}
+
+	bz, err := env.ConsensusState.GetTraceJSON(height)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultConsensusTrace{Trace: bz}, nil
+}
+
 // ConsensusParams gets the consensus parameters at the given block height.
 // If no height is provided, it will fetch the latest consensus params.
 // More: https://docs.cometbft.com/v0.38/spec/rpc/#consensusparams
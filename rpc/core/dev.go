@@ -1,6 +1,8 @@
 package core
 
 import (
+	"fmt"
+
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
 )
@@ -10,3 +12,22 @@ func (env *Environment) UnsafeFlushMempool(*rpctypes.Context) (*ctypes.ResultUns
 	env.Mempool.Flush()
 	return &ctypes.ResultUnsafeFlushMempool{}, nil
 }
+
+// UnsafeSetIndexerRetainHeight sets the height below which the indexed
+// transactions and block events are pruned. The indexer retain height can only
+// be increased.
+func (env *Environment) UnsafeSetIndexerRetainHeight(
+	_ *rpctypes.Context,
+	height int64,
+) (*ctypes.ResultSetIndexerRetainHeight, error) {
+	if height <= 0 {
+		return nil, fmt.Errorf("height must be greater than 0, but got %d", height)
+	}
+	if err := env.TxIndexer.SetRetainHeight(height); err != nil {
+		return nil, fmt.Errorf("setting tx indexer retain height: %w", err)
+	}
+	if err := env.BlockIndexer.SetRetainHeight(height); err != nil {
+		return nil, fmt.Errorf("setting block indexer retain height: %w", err)
+	}
+	return &ctypes.ResultSetIndexerRetainHeight{Height: height}, nil
+}
@@ -34,12 +34,21 @@ Endpoints that require arguments:
 /broadcast_tx_async?tx=_
 /broadcast_tx_commit?tx=_
 /broadcast_tx_sync?tx=_
+/broadcast_tx_track?tx=_&webhook=_
+/broadcast_txs?txs=_&async=_
 /commit?height=_
+/compact_dbs?dbs=_
+/consensus_params_changes?from=_&to=_
 /dial_seeds?seeds=_
 /dial_persistent_peers?persistent_peers=_
+/set_indexer_retain_height?height=_
+/simulate_tx?tx=_
 /subscribe?event=_
 /tx?hash=_&prove=_
+/tx_result_proof?hash=_
+/tx_track?id=_
 /unsubscribe?event=_
+/validator_set_changes?from=_&to=_
 ```
 */
 package core
@@ -3,8 +3,13 @@ package core
 import (
 	"encoding/base64"
 	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	dbm "github.com/cometbft/cometbft-db"
+
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
@@ -41,6 +46,7 @@ type Consensus interface {
 	GetLastHeight() int64
 	GetRoundStateJSON() ([]byte, error)
 	GetRoundStateSimpleJSON() ([]byte, error)
+	GetTraceJSON(height int64) ([]byte, error)
 }
 
 type transport interface {
@@ -92,12 +98,29 @@ type Environment struct {
 	EventBus     *types.EventBus // thread safe
 	Mempool      mempl.Mempool
 
+	// databases of the node by ID, and the directory holding them
+	Databases map[string]dbm.DB
+	DBDir     string
+
 	Logger log.Logger
 
 	Config cfg.RPCConfig
 
 	// cache of chunked genesis data.
 	genChunks []string
+
+	// set while the databases are compacted
+	compacting atomic.Bool
+
+	// slots of the running simulations, see SimulateTx
+	simulationSlotsOnce sync.Once
+	simulationSlots     chan struct{}
+
+	// tracker of the txs broadcast with broadcast_tx_track, see BroadcastTxTrack
+	txTrackerMtx sync.Mutex
+	txTracker    *txTracker
+	// posts to the webhooks of the tracked txs, txTrackWebhookClient if nil
+	txTrackWebhookClient *http.Client
 }
 
 //----------------------------------------------
@@ -203,3 +226,9 @@ func (env *Environment) latestUncommittedHeight() int64 {
 	}
 	return env.BlockStore.Height() + 1
 }
+
+// isHistoricalHeight reports whether height is stored and below the latest
+// height, so that the blocks and results at this height can no longer change.
+func (env *Environment) isHistoricalHeight(height int64) bool {
+	return height >= env.BlockStore.Base() && height < env.BlockStore.Height()
+}
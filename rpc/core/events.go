@@ -6,21 +6,37 @@ import (
 	"fmt"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
 )
 
 const (
 	// maxQueryLength is the maximum length of a query string that will be
 	// accepted. This is just a safety check to avoid outlandish queries.
 	maxQueryLength = 512
+
+	// maxReplayHeights is the maximum number of heights whose events a
+	// subscription can replay.
+	maxReplayHeights = 1000
 )
 
 // Subscribe for events via WebSocket.
+//
+// If fromHeight is provided, the NewBlock, NewBlockEvents and Tx events of
+// the committed heights from fromHeight onwards are replayed from the stores
+// before the live events, so that a client can resume a subscription from
+// the height of the last event it received. The heights committed while
+// replaying are replayed too, until the replay has caught up with the live
+// events. The events of a height are replayed in the order in which they were
+// published, and either replayed or received live, never both. Events can be
+// deduplicated by their ID.
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/Websocket/subscribe
-func (env *Environment) Subscribe(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, error) {
+func (env *Environment) Subscribe(ctx *rpctypes.Context, query string, fromHeightPtr *int64) (*ctypes.ResultSubscribe, error) {
 	addr := ctx.RemoteAddr()
 
 	numClients := env.EventBus.NumClients()
@@ -48,6 +64,14 @@ func (env *Environment) Subscribe(ctx *rpctypes.Context, query string) (*ctypes.
 		return nil, fmt.Errorf("failed to parse query: %w", err)
 	}
 
+	var fromHeight int64
+	if fromHeightPtr != nil {
+		fromHeight = *fromHeightPtr
+		if err := env.checkReplayHeight(fromHeight); err != nil {
+			return nil, err
+		}
+	}
+
 	subCtx, cancel := context.WithTimeout(ctx.Context(), SubscribeTimeout)
 	defer cancel()
 
@@ -58,14 +82,54 @@ func (env *Environment) Subscribe(ctx *rpctypes.Context, query string) (*ctypes.
 
 	closeIfSlow := env.Config.CloseOnSlowClient
 
+	// The events of the heights up to the latest one, committed before
+	// subscribing, are replayed; the live ones of these heights are dropped.
+	var replayTo int64
+	if fromHeight > 0 {
+		replayTo = env.BlockStore.Height()
+		// The events of a block are published after its results are saved.
+		if _, err := env.StateStore.LoadFinalizeBlockResponse(replayTo); err != nil {
+			if !errors.As(err, &sm.ErrNoABCIResponsesForHeight{}) {
+				if err := env.EventBus.Unsubscribe(context.Background(), addr, q); err != nil {
+					env.Logger.Error("Failed to unsubscribe", "to", addr, "err", err)
+				}
+				return nil, fmt.Errorf("cannot replay events: %w", err)
+			}
+			replayTo--
+		}
+	}
+
 	// Capture the current ID, since it can change in the future.
 	subscriptionID := ctx.JSONReq.ID
 	go func() {
+		if fromHeight > 0 {
+			var err error
+			replayTo, err = env.catchUp(q, sub, fromHeight, replayTo, func(resultEvent *ctypes.ResultEvent) error {
+				resultEvent.Query = query
+				writeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				return ctx.WSConn.WriteRPCResponse(writeCtx, rpctypes.NewRPCSuccessResponse(subscriptionID, resultEvent))
+			})
+			if err != nil {
+				env.Logger.Info("Can't replay events", "to", addr, "subscriptionID", subscriptionID, "err", err)
+				resp := rpctypes.RPCServerError(subscriptionID, fmt.Errorf("subscription was canceled (reason: %w)", err))
+				ctx.WSConn.TryWriteRPCResponse(resp)
+				if err := env.EventBus.Unsubscribe(context.Background(), addr, q); err != nil {
+					env.Logger.Error("Failed to unsubscribe", "to", addr, "err", err)
+				}
+				return
+			}
+		}
+
 		for {
 			select {
 			case msg := <-sub.Out():
+				id := ctypes.EventID(msg.Data())
+				if height, ok := ctypes.EventHeight(id); ok && height <= replayTo {
+					continue
+				}
 				var (
-					resultEvent = &ctypes.ResultEvent{Query: query, Data: msg.Data(), Events: msg.Events()}
+					resultEvent = &ctypes.ResultEvent{ID: id, Query: query, Data: msg.Data(), Events: msg.Events()}
 					resp        = rpctypes.NewRPCSuccessResponse(subscriptionID, resultEvent)
 				)
 				writeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -111,6 +175,173 @@ func (env *Environment) Subscribe(ctx *rpctypes.Context, query string) (*ctypes.
 	return &ctypes.ResultSubscribe{}, nil
 }
 
+// checkReplayHeight returns an error if the events cannot be replayed from
+// the given height.
+func (env *Environment) checkReplayHeight(fromHeight int64) error {
+	base, height := env.BlockStore.Base(), env.BlockStore.Height()
+	switch {
+	case fromHeight <= 0:
+		return fmt.Errorf("from_height must be greater than 0, got %d", fromHeight)
+	case fromHeight < base:
+		return fmt.Errorf("from_height %d is below the lowest available height %d", fromHeight, base)
+	case fromHeight > height+1:
+		return fmt.Errorf("from_height %d is above the next height %d", fromHeight, height+1)
+	case height-fromHeight >= maxReplayHeights:
+		return fmt.Errorf("cannot replay more than %d heights, from_height must be at least %d",
+			maxReplayHeights, height-maxReplayHeights+1)
+	}
+	// The results are discarded or pruned from the lowest heights, and those
+	// of the latest height may not be saved yet.
+	if fromHeight <= height {
+		_, err := env.StateStore.LoadFinalizeBlockResponse(fromHeight)
+		if err != nil && (fromHeight < height || !errors.As(err, &sm.ErrNoABCIResponsesForHeight{})) {
+			return fmt.Errorf("cannot replay events from height %d, its results are not available: %w", fromHeight, err)
+		}
+	}
+	return nil
+}
+
+// catchUp replays the events of the heights from fromHeight to replayTo
+// matching q with emit, and then those of the heights of the live events
+// received from sub in the meantime, until it has caught up with them. The
+// live events are drained while replaying, so that they do not fill up the
+// buffer of the subscription: the events of a height are dropped, since the
+// height is replayed, and the others are emitted after replaying. It returns
+// the last replayed height: the live events of the heights up to it have been
+// replayed.
+func (env *Environment) catchUp(
+	q *cmtquery.Query,
+	sub types.Subscription,
+	fromHeight, replayTo int64,
+	emit func(*ctypes.ResultEvent) error,
+) (int64, error) {
+	for fromHeight <= replayTo {
+		drain := drainLiveEvents(sub, env.Config.SubscriptionBufferSize)
+		err := env.replayEvents(q, fromHeight, replayTo, emit)
+		latest, pending, drainErr := drain.stop()
+		if err != nil {
+			return 0, err
+		}
+		if drainErr != nil {
+			return 0, drainErr
+		}
+		for _, msg := range pending {
+			if err := emit(&ctypes.ResultEvent{Data: msg.Data(), Events: msg.Events()}); err != nil {
+				return 0, err
+			}
+		}
+		// The results of a block are saved before its events are published,
+		// so the heights of the drained events can be replayed.
+		fromHeight, replayTo = replayTo+1, max(replayTo, latest)
+	}
+	return replayTo, nil
+}
+
+// liveEventDrain receives the live events of a subscription in the
+// background, while the events of past heights are replayed.
+type liveEventDrain struct {
+	quit chan struct{}
+	done chan struct{}
+
+	// written by the draining routine, and read once it is done
+	latest  int64
+	pending []cmtpubsub.Message
+	err     error
+}
+
+// drainLiveEvents starts receiving the live events of sub, recording the
+// latest height of the events of a height and keeping up to maxPending other
+// events.
+func drainLiveEvents(sub types.Subscription, maxPending int) *liveEventDrain {
+	d := &liveEventDrain{quit: make(chan struct{}), done: make(chan struct{})}
+	go func() {
+		defer close(d.done)
+		for {
+			select {
+			case msg := <-sub.Out():
+				if height, ok := ctypes.EventHeight(ctypes.EventID(msg.Data())); ok {
+					d.latest = max(d.latest, height)
+				} else if len(d.pending) < maxPending {
+					d.pending = append(d.pending, msg)
+				} else {
+					d.err = errors.New("too many live events received while replaying")
+					return
+				}
+			case <-d.quit:
+				return
+			}
+		}
+	}()
+	return d
+}
+
+// stop stops receiving the live events, and returns the latest height of the
+// received events of a height and the other received events.
+func (d *liveEventDrain) stop() (int64, []cmtpubsub.Message, error) {
+	close(d.quit)
+	<-d.done
+	return d.latest, d.pending, d.err
+}
+
+// replayEvents calls emit with the NewBlock, NewBlockEvents and Tx events of
+// the heights in [from, to] matching q, in the order in which they were
+// published.
+func (env *Environment) replayEvents(
+	q *cmtquery.Query,
+	from, to int64,
+	emit func(*ctypes.ResultEvent) error,
+) error {
+	for height := from; height <= to; height++ {
+		block, blockMeta := env.BlockStore.LoadBlock(height), env.BlockStore.LoadBlockMeta(height)
+		if block == nil || blockMeta == nil {
+			return fmt.Errorf("block at height %d not found", height)
+		}
+		results, err := env.StateStore.LoadFinalizeBlockResponse(height)
+		if err != nil {
+			return fmt.Errorf("results at height %d not found: %w", height, err)
+		}
+
+		events := []interface {
+			types.TMEventData
+			QueryEvents() map[string][]string
+		}{
+			types.EventDataNewBlock{
+				Block:               block,
+				BlockID:             blockMeta.BlockID,
+				ResultFinalizeBlock: *results,
+			},
+			types.EventDataNewBlockEvents{
+				Height: height,
+				Events: results.Events,
+				NumTxs: int64(len(block.Txs)),
+			},
+		}
+		for i, tx := range block.Txs {
+			events = append(events, types.EventDataTx{TxResult: abci.TxResult{
+				Height: height,
+				Index:  uint32(i),
+				Tx:     tx,
+				Result: *results.TxResults[i],
+			}})
+		}
+
+		for _, data := range events {
+			queryEvents := data.QueryEvents()
+			match, err := q.Matches(queryEvents)
+			if err != nil {
+				return err
+			}
+			if !match {
+				continue
+			}
+			if err := emit(&ctypes.ResultEvent{ID: ctypes.EventID(data), Data: data, Events: queryEvents}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // Unsubscribe from events via WebSocket.
 // More: https://docs.cometbft.com/v0.38.x/rpc/#/Websocket/unsubscribe
 func (env *Environment) Unsubscribe(ctx *rpctypes.Context, query string) (*ctypes.ResultUnsubscribe, error) {
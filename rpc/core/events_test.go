@@ -0,0 +1,152 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
+)
+
+// slowWSConn is a websocket connection taking some time to write each
+// response.
+type slowWSConn struct {
+	delay time.Duration
+
+	mtx       sync.Mutex
+	responses []rpctypes.RPCResponse
+}
+
+func (c *slowWSConn) GetRemoteAddr() string { return "slow" }
+
+func (c *slowWSConn) WriteRPCResponse(_ context.Context, resp rpctypes.RPCResponse) error {
+	time.Sleep(c.delay)
+	c.TryWriteRPCResponse(resp)
+	return nil
+}
+
+func (c *slowWSConn) TryWriteRPCResponse(resp rpctypes.RPCResponse) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.responses = append(c.responses, resp)
+	return true
+}
+
+func (c *slowWSConn) Context() context.Context { return context.Background() }
+
+func (c *slowWSConn) received() []rpctypes.RPCResponse {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return append([]rpctypes.RPCResponse(nil), c.responses...)
+}
+
+func TestSubscribeFromHeightCatchesUp(t *testing.T) {
+	const (
+		committed = 20 // heights committed before subscribing
+		latest    = 60 // heights committed while replaying
+	)
+
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	blocks := make(map[int64]*types.Block)
+	var height atomic.Int64
+	commit := func(h int64) {
+		block := types.MakeBlock(h, nil, nil, nil)
+		blocks[h] = block
+		require.NoError(t, stateStore.SaveFinalizeBlockResponse(h, &abci.ResponseFinalizeBlock{AppHash: []byte{1}}))
+		height.Store(h)
+	}
+	for h := int64(1); h <= latest; h++ {
+		commit(h)
+	}
+	height.Store(committed)
+
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("Base").Return(int64(1))
+	blockStore.On("Height").Return(func() int64 { return height.Load() })
+	blockStore.On("LoadBlock", mock.Anything).Return(func(h int64) *types.Block { return blocks[h] })
+	blockStore.On("LoadBlockMeta", mock.Anything).Return(func(h int64) *types.BlockMeta {
+		return &types.BlockMeta{Header: blocks[h].Header}
+	})
+
+	// The buffer of the subscription is smaller than the number of heights
+	// committed while replaying.
+	config := cfg.DefaultRPCConfig()
+	config.SubscriptionBufferSize = 5
+	env := &Environment{
+		Config:     *config,
+		EventBus:   eventBus,
+		BlockStore: blockStore,
+		StateStore: stateStore,
+		Logger:     log.TestingLogger(),
+	}
+
+	conn := &slowWSConn{delay: 10 * time.Millisecond}
+	ctx := &rpctypes.Context{JSONReq: &rpctypes.RPCRequest{ID: rpctypes.JSONRPCIntID(1)}, WSConn: conn}
+	fromHeight := int64(1)
+	_, err := env.Subscribe(ctx, types.QueryForEvent(types.EventNewBlock).String(), &fromHeight)
+	require.NoError(t, err)
+
+	// Commit the next heights while the first ones are replayed.
+	for h := int64(committed + 1); h <= latest; h++ {
+		height.Store(h)
+		require.NoError(t, eventBus.PublishEventNewBlock(types.EventDataNewBlock{Block: blocks[h]}))
+		time.Sleep(time.Millisecond)
+	}
+
+	require.Eventually(t, func() bool { return len(conn.received()) >= latest }, 10*time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	responses := conn.received()
+	require.Len(t, responses, latest)
+	for i, resp := range responses {
+		require.Nil(t, resp.Error)
+		var event struct {
+			ID string `json:"id"`
+		}
+		require.NoError(t, json.Unmarshal(resp.Result, &event))
+		assert.Equal(t, ctypes.EventID(types.EventDataNewBlock{Block: blocks[int64(i+1)]}), event.ID)
+	}
+}
+
+func TestSubscribeFromHeightWithoutResults(t *testing.T) {
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("Base").Return(int64(1))
+	blockStore.On("Height").Return(int64(10))
+	env := &Environment{
+		Config:     *cfg.DefaultRPCConfig(),
+		EventBus:   types.NewEventBus(),
+		BlockStore: blockStore,
+		StateStore: sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{DiscardABCIResponses: true}),
+		Logger:     log.TestingLogger(),
+	}
+
+	conn := &slowWSConn{}
+	ctx := &rpctypes.Context{JSONReq: &rpctypes.RPCRequest{ID: rpctypes.JSONRPCIntID(1)}, WSConn: conn}
+	fromHeight := int64(5)
+	_, err := env.Subscribe(ctx, types.QueryForEvent(types.EventNewBlock).String(), &fromHeight)
+	require.ErrorContains(t, err, "results are not available")
+	assert.Zero(t, env.EventBus.NumClients())
+}
@@ -65,6 +65,64 @@ func (env *Environment) BroadcastTxSync(ctx *rpctypes.Context, tx types.Tx) (*ct
 	}
 }
 
+// BroadcastTxs broadcasts several txs at once, passing them to the mempool in
+// one pass, and returns their results in order. Unless async is set, it waits
+// for their CheckTx responses, like BroadcastTxSync; otherwise, it returns
+// right away, like BroadcastTxAsync. The txs rejected before CheckTx, e.g.
+// because they are too large or already in the cache, have their error set,
+// without failing the call. The number of txs is limited by
+// max_request_batch_size.
+func (env *Environment) BroadcastTxs(ctx *rpctypes.Context, txs []types.Tx, async bool) (*ctypes.ResultBroadcastTxs, error) {
+	if env.MempoolReactor.WaitSync() {
+		return nil, ErrEndpointClosedCatchingUp
+	}
+	if len(txs) == 0 {
+		return nil, errors.New("no txs to broadcast")
+	}
+	if maxTxs := env.Config.MaxRequestBatchSize; maxTxs > 0 && len(txs) > maxTxs {
+		return nil, fmt.Errorf("too many txs: %d, max: %d (max_request_batch_size)", len(txs), maxTxs)
+	}
+
+	type indexedResponse struct {
+		i   int
+		res *abci.ResponseCheckTx
+	}
+	// The channel can hold all the responses, so that the callbacks never
+	// block, even after a timeout.
+	resCh := make(chan indexedResponse, len(txs))
+	results := make([]ctypes.ResultBroadcastTxsItem, len(txs))
+	pending := 0
+	for i, tx := range txs {
+		results[i].Hash = tx.Hash()
+		var cb func(*abci.ResponseCheckTx)
+		if !async {
+			cb = func(res *abci.ResponseCheckTx) {
+				resCh <- indexedResponse{i, res}
+			}
+		}
+		if err := env.Mempool.CheckTx(tx, cb, mempl.TxInfo{}); err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		pending++
+	}
+
+	if !async {
+		for ; pending > 0; pending-- {
+			select {
+			case <-ctx.Context().Done():
+				return nil, fmt.Errorf("broadcast confirmations not received: %w", ctx.Context().Err())
+			case r := <-resCh:
+				results[r.i].Code = r.res.Code
+				results[r.i].Data = r.res.Data
+				results[r.i].Log = r.res.Log
+				results[r.i].Codespace = r.res.Codespace
+			}
+		}
+	}
+	return &ctypes.ResultBroadcastTxs{Results: results}, nil
+}
+
 // BroadcastTxCommit returns with the responses from CheckTx and ExecTxResult.
 // More: https://docs.cometbft.com/v0.38/rpc/#/Tx/broadcast_tx_commit
 func (env *Environment) BroadcastTxCommit(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
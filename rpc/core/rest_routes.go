@@ -0,0 +1,53 @@
+package core
+
+import (
+	"net/http"
+
+	rpc "github.com/cometbft/cometbft/rpc/jsonrpc/server"
+)
+
+// RESTPrefix is the path prefix of the REST gateway.
+const RESTPrefix = "/v1"
+
+// RESTRoutes returns the routes of the REST gateway, each calling one of the
+// routes returned by GetRoutes.
+func RESTRoutes() []rpc.RESTRoute {
+	return []rpc.RESTRoute{
+		// info API
+		{Method: http.MethodGet, Path: "/health", RPC: "health", Tag: "Info", Summary: "Node heartbeat"},
+		{Method: http.MethodGet, Path: "/status", RPC: "status", Tag: "Info", Summary: "Node status"},
+		{Method: http.MethodGet, Path: "/net_info", RPC: "net_info", Tag: "Info", Summary: "Network information"},
+		{Method: http.MethodGet, Path: "/genesis", RPC: "genesis", Tag: "Info", Summary: "Genesis file"},
+		{Method: http.MethodGet, Path: "/unconfirmed_txs", RPC: "unconfirmed_txs", Tag: "Info", Summary: "Unconfirmed transactions"},
+
+		// blocks
+		{Method: http.MethodGet, Path: "/blocks", RPC: "blockchain", Tag: "Blocks", Summary: "Metadata of a range of blocks"},
+		{Method: http.MethodGet, Path: "/blocks/search", RPC: "block_search", Tag: "Blocks", Summary: "Search for blocks by FinalizeBlock events"},
+		{Method: http.MethodGet, Path: "/blocks/latest", RPC: "block", Tag: "Blocks", Summary: "Latest block"},
+		{Method: http.MethodGet, Path: "/blocks/{height}", RPC: "block", Tag: "Blocks", Summary: "Block at a height"},
+		{Method: http.MethodGet, Path: "/blocks/{height}/header", RPC: "header", Tag: "Blocks", Summary: "Header of a block"},
+		{Method: http.MethodGet, Path: "/blocks/{height}/commit", RPC: "commit", Tag: "Blocks", Summary: "Commit of a block"},
+		{Method: http.MethodGet, Path: "/blocks/{height}/results", RPC: "block_results", Tag: "Blocks", Summary: "Results of the transactions of a block"},
+		{Method: http.MethodGet, Path: "/blocks/{height}/validators", RPC: "validators", Tag: "Blocks", Summary: "Validator set at a height"},
+		{Method: http.MethodGet, Path: "/blocks/{height}/consensus_params", RPC: "consensus_params", Tag: "Blocks", Summary: "Consensus parameters at a height"},
+
+		// history API
+		{Method: http.MethodGet, Path: "/validator_set_changes", RPC: "validator_set_changes", Tag: "Blocks", Summary: "Validator set changes in a range of heights"},
+		{Method: http.MethodGet, Path: "/consensus_params_changes", RPC: "consensus_params_changes", Tag: "Blocks", Summary: "Consensus parameters changes in a range of heights"},
+
+		// transactions
+		{Method: http.MethodGet, Path: "/txs", RPC: "tx_search", Tag: "Txs", Summary: "Search for transactions by their events"},
+		{Method: http.MethodPost, Path: "/txs", RPC: "broadcast_tx_sync", Tag: "Txs", Summary: "Broadcast a transaction, returning its CheckTx result"},
+		{Method: http.MethodPost, Path: "/txs/batch", RPC: "broadcast_txs", Tag: "Txs", Summary: "Broadcast several transactions, returning their CheckTx results unless async"},
+		{Method: http.MethodPost, Path: "/txs/check", RPC: "check_tx", Tag: "Txs", Summary: "Check a transaction without adding it to the mempool"},
+		{Method: http.MethodPost, Path: "/txs/simulate", RPC: "simulate_tx", Tag: "Txs", Summary: "Simulate the execution of a transaction without persisting it"},
+		{Method: http.MethodPost, Path: "/txs/track", RPC: "broadcast_tx_track", Tag: "Txs", Summary: "Broadcast a transaction, returning its CheckTx result and an ID to track it with"},
+		{Method: http.MethodGet, Path: "/txs/track/{id}", RPC: "tx_track", Tag: "Txs", Summary: "Status of a tracked transaction"},
+		{Method: http.MethodGet, Path: "/txs/{hash}", RPC: "tx", Tag: "Txs", Summary: "Transaction by hash"},
+		{Method: http.MethodGet, Path: "/txs/proofs/{hash}", RPC: "tx_result_proof", Tag: "Txs", Summary: "Proofs of a transaction and of its result"},
+
+		// abci API
+		{Method: http.MethodGet, Path: "/abci/info", RPC: "abci_info", Tag: "ABCI", Summary: "Information about the application"},
+		{Method: http.MethodGet, Path: "/abci/query", RPC: "abci_query", Tag: "ABCI", Summary: "Query the application"},
+	}
+}
@@ -12,7 +12,7 @@ type RoutesMap map[string]*rpc.RPCFunc
 func (env *Environment) GetRoutes() RoutesMap {
 	return RoutesMap{
 		// subscribe/unsubscribe are reserved for websocket events.
-		"subscribe":       rpc.NewWSRPCFunc(env.Subscribe, "query"),
+		"subscribe":       rpc.NewWSRPCFunc(env.Subscribe, "query,from_height"),
 		"unsubscribe":     rpc.NewWSRPCFunc(env.Unsubscribe, "query"),
 		"unsubscribe_all": rpc.NewWSRPCFunc(env.UnsubscribeAll, ""),
 
@@ -22,28 +22,37 @@ func (env *Environment) GetRoutes() RoutesMap {
 		"net_info":             rpc.NewRPCFunc(env.NetInfo, ""),
 		"blockchain":           rpc.NewRPCFunc(env.BlockchainInfo, "minHeight,maxHeight", rpc.Cacheable()),
 		"genesis":              rpc.NewRPCFunc(env.Genesis, "", rpc.Cacheable()),
-		"genesis_chunked":      rpc.NewRPCFunc(env.GenesisChunked, "chunk", rpc.Cacheable()),
-		"block":                rpc.NewRPCFunc(env.Block, "height", rpc.Cacheable("height")),
+		"genesis_chunked":      rpc.NewRPCFunc(env.GenesisChunked, "chunk", rpc.Cacheable(), rpc.Immutable()),
+		"block":                rpc.NewRPCFunc(env.Block, "height", rpc.Cacheable("height"), rpc.ImmutableAtHeights("height", env.isHistoricalHeight)),
 		"block_by_hash":        rpc.NewRPCFunc(env.BlockByHash, "hash", rpc.Cacheable()),
-		"block_results":        rpc.NewRPCFunc(env.BlockResults, "height", rpc.Cacheable("height")),
-		"commit":               rpc.NewRPCFunc(env.Commit, "height", rpc.Cacheable("height")),
+		"block_results":        rpc.NewRPCFunc(env.BlockResults, "height,prove", rpc.Cacheable("height"), rpc.ImmutableAtHeights("height", env.isHistoricalHeight)),
+		"commit":               rpc.NewRPCFunc(env.Commit, "height", rpc.Cacheable("height"), rpc.ImmutableAtHeights("height", env.isHistoricalHeight)),
 		"header":               rpc.NewRPCFunc(env.Header, "height", rpc.Cacheable("height")),
 		"header_by_hash":       rpc.NewRPCFunc(env.HeaderByHash, "hash", rpc.Cacheable()),
 		"check_tx":             rpc.NewRPCFunc(env.CheckTx, "tx"),
 		"tx":                   rpc.NewRPCFunc(env.Tx, "hash,prove", rpc.Cacheable()),
-		"tx_search":            rpc.NewRPCFunc(env.TxSearch, "query,prove,page,per_page,order_by"),
-		"block_search":         rpc.NewRPCFunc(env.BlockSearch, "query,page,per_page,order_by"),
-		"validators":           rpc.NewRPCFunc(env.Validators, "height,page,per_page", rpc.Cacheable("height")),
+		"tx_result_proof":      rpc.NewRPCFunc(env.TxResultProof, "hash", rpc.Cacheable()),
+		"tx_search":            rpc.NewRPCFunc(env.TxSearch, "query,prove,page,per_page,order_by,cursor"),
+		"block_search":         rpc.NewRPCFunc(env.BlockSearch, "query,page,per_page,order_by,cursor"),
+		"validators":           rpc.NewRPCFunc(env.Validators, "height,page,per_page", rpc.Cacheable("height"), rpc.ImmutableAtHeights("height", env.isHistoricalHeight)),
 		"dump_consensus_state": rpc.NewRPCFunc(env.DumpConsensusState, ""),
 		"consensus_state":      rpc.NewRPCFunc(env.GetConsensusState, ""),
+		"consensus_trace":      rpc.NewRPCFunc(env.ConsensusTrace, "height"),
 		"consensus_params":     rpc.NewRPCFunc(env.ConsensusParams, "height", rpc.Cacheable("height")),
 		"unconfirmed_txs":      rpc.NewRPCFunc(env.UnconfirmedTxs, "limit"),
 		"num_unconfirmed_txs":  rpc.NewRPCFunc(env.NumUnconfirmedTxs, ""),
 
+		// history API
+		"validator_set_changes":    rpc.NewRPCFunc(env.ValidatorSetChanges, "from,to"),
+		"consensus_params_changes": rpc.NewRPCFunc(env.ConsensusParamsChanges, "from,to"),
+
 		// tx broadcast API
 		"broadcast_tx_commit": rpc.NewRPCFunc(env.BroadcastTxCommit, "tx"),
 		"broadcast_tx_sync":   rpc.NewRPCFunc(env.BroadcastTxSync, "tx"),
 		"broadcast_tx_async":  rpc.NewRPCFunc(env.BroadcastTxAsync, "tx"),
+		"broadcast_txs":       rpc.NewRPCFunc(env.BroadcastTxs, "txs,async", rpc.CostPerItem("txs")),
+		"broadcast_tx_track":  rpc.NewRPCFunc(env.BroadcastTxTrack, "tx,webhook"),
+		"tx_track":            rpc.NewRPCFunc(env.TxTrack, "id"),
 
 		// abci API
 		"abci_query": rpc.NewRPCFunc(env.ABCIQuery, "path,data,height,prove"),
@@ -54,10 +63,19 @@ func (env *Environment) GetRoutes() RoutesMap {
 	}
 }
 
+// AddSimulateTxRoute adds the simulate_tx route, for the applications which
+// support simulations.
+func (env *Environment) AddSimulateTxRoute(routes RoutesMap) {
+	routes["simulate_tx"] = rpc.NewRPCFunc(env.SimulateTx, "tx")
+}
+
 // AddUnsafeRoutes adds unsafe routes.
 func (env *Environment) AddUnsafeRoutes(routes RoutesMap) {
 	// control API
 	routes["dial_seeds"] = rpc.NewRPCFunc(env.UnsafeDialSeeds, "seeds")
 	routes["dial_peers"] = rpc.NewRPCFunc(env.UnsafeDialPeers, "peers,persistent,unconditional,private")
 	routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(env.UnsafeFlushMempool, "")
+	routes["set_indexer_retain_height"] = rpc.NewRPCFunc(env.UnsafeSetIndexerRetainHeight, "height")
+	routes["compact_dbs"] = rpc.NewRPCFunc(env.UnsafeCompactDBs, "dbs")
+	routes["storage_stats"] = rpc.NewRPCFunc(env.StorageStats, "")
 }
@@ -0,0 +1,179 @@
+package core
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+)
+
+// dbKeyPrefixes are the prefixes of the keys counted by StorageStats, by
+// database and kind of data.
+var dbKeyPrefixes = map[string]map[string][]byte{
+	"blockstore": store.KeyPrefixes(),
+	"state":      sm.KeyPrefixes(),
+}
+
+// UnsafeCompactDBs starts compacting the given databases of the node, or all of
+// them if none is given, in the background. Compaction reclaims the disk space
+// of the pruned data while the node runs. It returns an error if a compaction
+// is already in progress. The progress is reported in the node logs.
+func (env *Environment) UnsafeCompactDBs(_ *rpctypes.Context, dbs []string) (*ctypes.ResultCompactDBs, error) {
+	names, err := env.databaseNames(dbs)
+	if err != nil {
+		return nil, err
+	}
+	if !env.compacting.CompareAndSwap(false, true) {
+		return nil, errors.New("a compaction is already in progress")
+	}
+
+	go func() {
+		defer env.compacting.Store(false)
+		for _, name := range names {
+			env.Logger.Info("Compacting database", "db", name)
+			start := time.Now()
+			if err := env.Databases[name].Compact(nil, nil); err != nil {
+				env.Logger.Error("Failed to compact database", "db", name, "err", err)
+				continue
+			}
+			env.Logger.Info("Compacted database", "db", name, "duration", time.Since(start))
+		}
+	}()
+
+	return &ctypes.ResultCompactDBs{DBs: names}, nil
+}
+
+// StorageStats returns the size and the number of keys of the databases of the
+// node, and the heights below which their data was pruned. It iterates over
+// all the keys of the databases, so it is only served with the unsafe routes.
+func (env *Environment) StorageStats(ctx *rpctypes.Context) (*ctypes.ResultStorageStats, error) {
+	names, err := env.databaseNames(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ctypes.ResultStorageStats{
+		Compacting: env.compacting.Load(),
+		DBs:        make([]ctypes.DBStats, 0, len(names)),
+	}
+	for _, name := range names {
+		stats, err := env.dbStats(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		result.DBs = append(result.DBs, stats)
+	}
+
+	result.Pruning.BlockStoreBase = env.BlockStore.Base()
+	if result.Pruning.ABCIResponsesRetainHeight, err = env.StateStore.GetABCIResponsesRetainHeight(); err != nil {
+		return nil, fmt.Errorf("loading the ABCI responses retain height: %w", err)
+	}
+	if result.Pruning.CompanionBlockRetainHeight, err = env.StateStore.GetCompanionBlockRetainHeight(); err != nil {
+		return nil, fmt.Errorf("loading the companion block retain height: %w", err)
+	}
+	if result.Pruning.TxIndexerRetainHeight, err = env.TxIndexer.GetRetainHeight(); err != nil {
+		return nil, fmt.Errorf("loading the tx indexer retain height: %w", err)
+	}
+	if result.Pruning.BlockIndexerRetainHeight, err = env.BlockIndexer.GetRetainHeight(); err != nil {
+		return nil, fmt.Errorf("loading the block indexer retain height: %w", err)
+	}
+	return result, nil
+}
+
+// databaseNames returns the given names of databases, sorted, or the names of
+// all the databases if none is given. It returns an error if one of them is
+// unknown.
+func (env *Environment) databaseNames(names []string) ([]string, error) {
+	if len(env.Databases) == 0 {
+		return nil, errors.New("the databases of the node are not available")
+	}
+	if len(names) == 0 {
+		for name := range env.Databases {
+			names = append(names, name)
+		}
+	} else {
+		names = append([]string(nil), names...)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, ok := env.Databases[name]; !ok {
+			return nil, fmt.Errorf("unknown database %q", name)
+		}
+	}
+	return names, nil
+}
+
+// dbStats counts the keys of a database and measures its size on disk.
+func (env *Environment) dbStats(ctx *rpctypes.Context, name string) (ctypes.DBStats, error) {
+	stats := ctypes.DBStats{Name: name}
+
+	prefixes := dbKeyPrefixes[name]
+	if len(prefixes) > 0 {
+		stats.KeysByPrefix = make(map[string]int64, len(prefixes))
+		for kind := range prefixes {
+			stats.KeysByPrefix[kind] = 0
+		}
+	}
+
+	it, err := env.Databases[name].Iterator(nil, nil)
+	if err != nil {
+		return stats, fmt.Errorf("iterating over database %s: %w", name, err)
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		stats.Keys++
+		for kind, prefix := range prefixes {
+			if bytes.HasPrefix(it.Key(), prefix) {
+				stats.KeysByPrefix[kind]++
+				break
+			}
+		}
+		// Stop counting if the client went away.
+		if stats.Keys%10000 == 0 && ctx.Context().Err() != nil {
+			return stats, ctx.Context().Err()
+		}
+	}
+	if err := it.Error(); err != nil {
+		return stats, fmt.Errorf("iterating over database %s: %w", name, err)
+	}
+
+	if env.DBDir != "" {
+		if stats.Size, err = dirSize(filepath.Join(env.DBDir, name+".db")); err != nil {
+			return stats, fmt.Errorf("measuring the size of database %s: %w", name, err)
+		}
+	}
+	return stats, nil
+}
+
+// dirSize returns the total size of the files in the given directory, or 0 if
+// it does not exist, as for in-memory databases.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	return size, err
+}
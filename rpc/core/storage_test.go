@@ -0,0 +1,66 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	sm "github.com/cometbft/cometbft/state"
+	blockidxnull "github.com/cometbft/cometbft/state/indexer/block/null"
+	txidxnull "github.com/cometbft/cometbft/state/txindex/null"
+	"github.com/cometbft/cometbft/store"
+)
+
+func TestStorageStats(t *testing.T) {
+	blockStoreDB, stateDB := dbm.NewMemDB(), dbm.NewMemDB()
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+	for h := int64(1); h <= 5; h++ {
+		require.NoError(t, stateStore.SaveFinalizeBlockResponse(h, &abci.ResponseFinalizeBlock{AppHash: []byte{1}}))
+	}
+	_, err := stateStore.PruneABCIResponses(3)
+	require.NoError(t, err)
+
+	dbDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dbDir, "state.db"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dbDir, "state.db", "000001.log"), make([]byte, 10), 0o600))
+
+	env := &Environment{
+		StateStore:   stateStore,
+		BlockStore:   store.NewBlockStore(blockStoreDB),
+		TxIndexer:    &txidxnull.TxIndex{},
+		BlockIndexer: &blockidxnull.BlockerIndexer{},
+		Databases:    map[string]dbm.DB{"blockstore": blockStoreDB, "state": stateDB},
+		DBDir:        dbDir,
+		Logger:       log.TestingLogger(),
+	}
+
+	stats, err := env.StorageStats(&rpctypes.Context{})
+	require.NoError(t, err)
+	require.Len(t, stats.DBs, 2)
+	require.Equal(t, "blockstore", stats.DBs[0].Name)
+	require.Zero(t, stats.DBs[0].Size)
+	require.Zero(t, stats.DBs[0].KeysByPrefix["block_parts"])
+
+	require.Equal(t, "state", stats.DBs[1].Name)
+	require.EqualValues(t, 10, stats.DBs[1].Size)
+	// The responses, the last response and the retain height of the responses.
+	require.EqualValues(t, 3+2, stats.DBs[1].Keys)
+	require.EqualValues(t, 3, stats.DBs[1].KeysByPrefix["abci_responses"])
+	require.EqualValues(t, 3, stats.Pruning.ABCIResponsesRetainHeight)
+
+	res, err := env.UnsafeCompactDBs(&rpctypes.Context{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"blockstore", "state"}, res.DBs)
+	require.Eventually(t, func() bool { return !env.compacting.Load() }, time.Second, 10*time.Millisecond)
+
+	_, err = env.UnsafeCompactDBs(&rpctypes.Context{}, []string{"unknown"})
+	require.Error(t, err)
+}
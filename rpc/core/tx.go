@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"sort"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex/null"
 	"github.com/cometbft/cometbft/types"
 )
@@ -50,8 +52,57 @@ func (env *Environment) Tx(_ *rpctypes.Context, hash []byte, prove bool) (*ctype
 	}, nil
 }
 
+// TxResultProof returns the proofs of a committed tx and of its result, for
+// the light clients: the proof of the tx against the DataHash of the header of
+// its block, and the proof of its result against the LastResultsHash of the
+// header of the next block. Only the deterministic fields of the result are
+// proven; its events are not part of any header. The results of the block of
+// the tx must not be discarded (see storage.discard_abci_responses).
+func (env *Environment) TxResultProof(_ *rpctypes.Context, hash []byte) (*ctypes.ResultTxResultProof, error) {
+	// if index is disabled, return error
+	if _, ok := env.TxIndexer.(*null.TxIndex); ok {
+		return nil, errors.New("transaction indexing is disabled")
+	}
+
+	r, err := env.TxIndexer.Get(hash)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, fmt.Errorf("tx (%X) not found", hash)
+	}
+
+	block := env.BlockStore.LoadBlock(r.Height)
+	if block == nil {
+		return nil, fmt.Errorf("block at height %d not found", r.Height)
+	}
+	results, err := env.StateStore.LoadFinalizeBlockResponse(r.Height)
+	if err != nil {
+		return nil, fmt.Errorf("can't load the results of height %d: %w", r.Height, err)
+	}
+	index := int(r.Index)
+	if index >= len(block.Txs) || index >= len(results.TxResults) {
+		return nil, fmt.Errorf("tx index %d out of range at height %d", index, r.Height)
+	}
+
+	return &ctypes.ResultTxResultProof{
+		Hash:          hash,
+		Height:        r.Height,
+		Index:         r.Index,
+		Tx:            block.Txs[index],
+		TxProof:       block.Txs.Proof(index),
+		TxResult:      *results.TxResults[index],
+		TxResultProof: types.NewResults(results.TxResults).Proofs()[index],
+	}, nil
+}
+
 // TxSearch allows you to query for multiple transactions results. It returns a
 // list of transactions (maximum ?per_page entries) and the total count.
+//
+// Each page of results comes with a cursor if more results follow. Passing
+// the cursor instead of a page number returns the results following it, in
+// the same order, without computing the total count. The cursor "first"
+// returns the first page this way.
 // More: https://docs.cometbft.com/v0.38/rpc/#/Info/tx_search
 func (env *Environment) TxSearch(
 	ctx *rpctypes.Context,
@@ -59,6 +110,7 @@ func (env *Environment) TxSearch(
 	prove bool,
 	pagePtr, perPagePtr *int,
 	orderBy string,
+	cursor string,
 ) (*ctypes.ResultTxSearch, error) {
 	// if index is disabled, return error
 	if _, ok := env.TxIndexer.(*null.TxIndex); ok {
@@ -72,29 +124,57 @@ func (env *Environment) TxSearch(
 		return nil, err
 	}
 
+	var desc bool
+	switch orderBy {
+	case "desc":
+		desc = true
+	case "asc", "":
+	default:
+		return nil, errors.New("expected order_by to be either `asc` or `desc` or empty")
+	}
+
+	if cursor != "" {
+		if pagePtr != nil {
+			return nil, errors.New("page and cursor cannot be used together")
+		}
+		after, err := indexer.ParseAfter(cursor)
+		if err != nil {
+			return nil, err
+		}
+		results, more, err := env.TxIndexer.SearchPage(ctx.Context(), q, indexer.Page{
+			After: after,
+			Limit: env.validatePerPage(perPagePtr),
+			Desc:  desc,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &ctypes.ResultTxSearch{
+			Txs:        env.txSearchResults(results, prove),
+			NextCursor: nextTxCursor(results, more),
+		}, nil
+	}
+
 	results, err := env.TxIndexer.Search(ctx.Context(), q)
 	if err != nil {
 		return nil, err
 	}
 
 	// sort results (must be done before pagination)
-	switch orderBy {
-	case "desc":
+	if desc {
 		sort.Slice(results, func(i, j int) bool {
 			if results[i].Height == results[j].Height {
 				return results[i].Index > results[j].Index
 			}
 			return results[i].Height > results[j].Height
 		})
-	case "asc", "":
+	} else {
 		sort.Slice(results, func(i, j int) bool {
 			if results[i].Height == results[j].Height {
 				return results[i].Index < results[j].Index
 			}
 			return results[i].Height < results[j].Height
 		})
-	default:
-		return nil, errors.New("expected order_by to be either `asc` or `desc` or empty")
 	}
 
 	// paginate results
@@ -108,11 +188,20 @@ func (env *Environment) TxSearch(
 
 	skipCount := validateSkipCount(page, perPage)
 	pageSize := cmtmath.MinInt(perPage, totalCount-skipCount)
+	pageResults := results[skipCount : skipCount+pageSize]
 
-	apiResults := make([]*ctypes.ResultTx, 0, pageSize)
-	for i := skipCount; i < skipCount+pageSize; i++ {
-		r := results[i]
+	return &ctypes.ResultTxSearch{
+		Txs:        env.txSearchResults(pageResults, prove),
+		TotalCount: totalCount,
+		NextCursor: nextTxCursor(pageResults, skipCount+pageSize < totalCount),
+	}, nil
+}
 
+// txSearchResults converts indexed transaction results to RPC results, with
+// inclusion proofs if prove is set.
+func (env *Environment) txSearchResults(results []*abci.TxResult, prove bool) []*ctypes.ResultTx {
+	apiResults := make([]*ctypes.ResultTx, 0, len(results))
+	for _, r := range results {
 		var proof types.TxProof
 		if prove {
 			block := env.BlockStore.LoadBlock(r.Height)
@@ -130,6 +219,15 @@ func (env *Environment) TxSearch(
 			Proof:    proof,
 		})
 	}
+	return apiResults
+}
 
-	return &ctypes.ResultTxSearch{Txs: apiResults, TotalCount: totalCount}, nil
+// nextTxCursor returns the cursor of the last of the results if more results
+// follow them, and an empty string otherwise.
+func nextTxCursor(results []*abci.TxResult, more bool) string {
+	if !more || len(results) == 0 {
+		return ""
+	}
+	last := results[len(results)-1]
+	return indexer.Cursor{Height: last.Height, Index: last.Index}.String()
 }
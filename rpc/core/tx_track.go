@@ -0,0 +1,486 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"sync"
+	"syscall"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	mempl "github.com/cometbft/cometbft/mempool"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpcserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// txTrackerSubscriber prefixes the subscriber of the NewBlock events used
+	// to track the txs, unique per Environment sharing the event bus.
+	txTrackerSubscriber = "rpc-tx-tracker-"
+
+	// txTrackWebhookTimeout is the timeout of the requests posting the status
+	// of the tracked txs to their webhooks.
+	txTrackWebhookTimeout = 10 * time.Second
+)
+
+// txTrackWebhookClient posts the status of the tracked txs to their webhooks.
+// Since the webhooks are given by the RPC clients, it only connects to public
+// IP addresses, checked once the host is resolved, and doesn't follow
+// redirects, so that the clients can't make the node send requests to its
+// private network. It doesn't use the proxy of the environment either, which
+// would resolve the host itself.
+var txTrackWebhookClient = &http.Client{
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: txTrackWebhookTimeout,
+			Control: func(_, address string, _ syscall.RawConn) error {
+				return checkWebhookAddress(address)
+			},
+		}).DialContext,
+		TLSHandshakeTimeout: txTrackWebhookTimeout,
+		MaxIdleConns:        10,
+		IdleConnTimeout:     90 * time.Second,
+	},
+	CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// nonPublicPrefixes are the IPv4 ranges which are not public, besides the
+// private, loopback, link-local, multicast and unspecified addresses.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // "this" network
+	netip.MustParsePrefix("100.64.0.0/10"), // shared address space (CGNAT)
+}
+
+// txContainer is implemented by the mempools which can tell whether they hold
+// a tx, like the CListMempool. The txs tracked in the other mempools are never
+// known to be dropped.
+type txContainer interface {
+	Contains(txKey types.TxKey) bool
+}
+
+// BroadcastTxTrack broadcasts a tx like BroadcastTxSync, and returns right
+// after CheckTx with an ID to track the tx with, instead of waiting for it to
+// be committed like BroadcastTxCommit. TxTrack then returns the status of the
+// tx. Once the tx is committed or dropped, its status is also sent once to the
+// websocket the call was made over, if any, with the ID of the request, and
+// posted to webhook, if set and tx_track_webhooks is enabled. The txs which
+// fail CheckTx are not tracked.
+func (env *Environment) BroadcastTxTrack(
+	ctx *rpctypes.Context,
+	tx types.Tx,
+	webhook string,
+) (*ctypes.ResultBroadcastTxTrack, error) {
+	if env.MempoolReactor.WaitSync() {
+		return nil, ErrEndpointClosedCatchingUp
+	}
+	if env.Config.MaxTrackedTxs == 0 {
+		return nil, errors.New("broadcast_tx_track is disabled (max_tracked_txs is 0)")
+	}
+	if webhook != "" {
+		if !env.Config.TxTrackWebhooks {
+			return nil, errors.New("webhooks are disabled (tx_track_webhooks is false)")
+		}
+		if err := validateWebhook(webhook); err != nil {
+			return nil, err
+		}
+	}
+	client := txTrackClient(ctx)
+
+	tracker, err := env.getTxTracker()
+	if err != nil {
+		return nil, err
+	}
+
+	var notifies []func(ctypes.ResultTxTrack)
+	if ctx.WSConn != nil {
+		// Capture the current ID, since it can change in the future.
+		reqID := ctx.JSONReq.ID
+		notifies = append(notifies, func(result ctypes.ResultTxTrack) {
+			if !ctx.WSConn.TryWriteRPCResponse(rpctypes.NewRPCSuccessResponse(reqID, &result)) {
+				env.Logger.Info("Can't write tx track notification", "to", ctx.RemoteAddr(), "id", result.ID)
+			}
+		})
+	}
+	if webhook != "" {
+		notifies = append(notifies, func(result ctypes.ResultTxTrack) {
+			go env.postTxTrackWebhook(webhook, result)
+		})
+	}
+
+	// The tx is tracked before CheckTx, so that it can't be committed before
+	// being tracked.
+	id, err := tracker.add(tx, client, notifies, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	resCh := make(chan *abci.ResponseCheckTx, 1)
+	err = env.Mempool.CheckTx(tx, func(res *abci.ResponseCheckTx) {
+		resCh <- res
+	}, mempl.TxInfo{})
+	if err != nil {
+		tracker.remove(id)
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Context().Done():
+		tracker.remove(id)
+		return nil, fmt.Errorf("broadcast confirmation not received: %w", ctx.Context().Err())
+	case res := <-resCh:
+		if res.Code != abci.CodeTypeOK {
+			tracker.remove(id)
+			return &ctypes.ResultBroadcastTxTrack{Hash: tx.Hash(), CheckTx: *res}, nil
+		}
+		tracker.check(id)
+		return &ctypes.ResultBroadcastTxTrack{ID: id, Hash: tx.Hash(), CheckTx: *res}, nil
+	}
+}
+
+// TxTrack returns the status of a tx broadcast with BroadcastTxTrack. The
+// status of the committed and dropped txs is retained for
+// tracked_tx_retention.
+func (env *Environment) TxTrack(_ *rpctypes.Context, id string) (*ctypes.ResultTxTrack, error) {
+	env.txTrackerMtx.Lock()
+	tracker := env.txTracker
+	env.txTrackerMtx.Unlock()
+
+	if tracker != nil {
+		if result, ok := tracker.get(id); ok {
+			return &result, nil
+		}
+	}
+	return nil, fmt.Errorf("tracked tx %q not found", id)
+}
+
+// getTxTracker returns the tracker of the txs, starting it on the first call.
+func (env *Environment) getTxTracker() (*txTracker, error) {
+	env.txTrackerMtx.Lock()
+	defer env.txTrackerMtx.Unlock()
+
+	if env.txTracker != nil {
+		return env.txTracker, nil
+	}
+
+	// The txs are resolved as soon as their block is committed: the
+	// subscription is not buffered, and updating the tracker doesn't block.
+	subscriber := txTrackerSubscriber + crypto.CRandHex(16)
+	sub, err := env.EventBus.SubscribeUnbuffered(context.Background(), subscriber, types.EventQueryNewBlock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to new blocks: %w", err)
+	}
+	env.txTracker = newTxTracker(env.Config.MaxTrackedTxs, env.Config.MaxTrackedTxsPerClient, env.Config.TrackedTxRetention)
+	go env.trackTxs(env.txTracker, sub)
+	return env.txTracker, nil
+}
+
+// trackTxs updates tracker with the blocks published by sub.
+func (env *Environment) trackTxs(tracker *txTracker, sub types.Subscription) {
+	for {
+		select {
+		case msg := <-sub.Out():
+			data := msg.Data().(types.EventDataNewBlock)
+			var inMempool func(types.TxKey) bool
+			if c, ok := env.Mempool.(txContainer); ok {
+				height := data.Block.Height
+				inMempool = func(txKey types.TxKey) bool {
+					// The mempool may already be updated with the next block,
+					// whose txs are no longer in the mempool. They are only
+					// dropped once the mempool is known to be at this block.
+					return c.Contains(txKey) || env.BlockStore.Height() > height
+				}
+			}
+			notifications := tracker.update(data.Block, data.ResultFinalizeBlock.TxResults, inMempool, time.Now())
+			for _, notify := range notifications {
+				notify()
+			}
+		case <-sub.Canceled():
+			env.Logger.Info("Stopped tracking txs", "reason", sub.Err())
+			env.txTrackerMtx.Lock()
+			env.txTracker = nil
+			env.txTrackerMtx.Unlock()
+			return
+		}
+	}
+}
+
+// postTxTrackWebhook posts result to webhook, without retrying.
+func (env *Environment) postTxTrackWebhook(webhook string, result ctypes.ResultTxTrack) {
+	body, err := cmtjson.Marshal(result)
+	if err != nil {
+		env.Logger.Error("Failed to encode tx track notification", "id", result.ID, "err", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), txTrackWebhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(body))
+	if err != nil {
+		env.Logger.Error("Failed to post tx track notification", "id", result.ID, "err", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := env.txTrackWebhookClient
+	if client == nil {
+		client = txTrackWebhookClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		env.Logger.Info("Failed to post tx track notification", "id", result.ID, "err", err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		env.Logger.Info("Tx track notification rejected", "id", result.ID, "status", resp.Status)
+	}
+}
+
+// validateWebhook returns an error if webhook is not an absolute HTTP(S) URL,
+// or if its host is an IP address which is not public. The hosts given by
+// name are only checked once resolved, when posting to the webhook.
+func validateWebhook(webhook string) error {
+	u, err := url.Parse(webhook)
+	if err != nil {
+		return fmt.Errorf("invalid webhook: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook %q: expected an http or https URL", webhook)
+	}
+	if ip, err := netip.ParseAddr(u.Hostname()); err == nil && !isPublicAddr(ip) {
+		return fmt.Errorf("invalid webhook %q: %v is not a public IP address", webhook, ip)
+	}
+	return nil
+}
+
+// checkWebhookAddress returns an error if the resolved address, of the form
+// "host:port", which the webhook client is about to connect to is not public.
+func checkWebhookAddress(address string) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("invalid webhook address %q: %w", address, err)
+	}
+	if !isPublicAddr(addrPort.Addr()) {
+		return fmt.Errorf("webhook address %v is not a public IP address", addrPort.Addr())
+	}
+	return nil
+}
+
+// isPublicAddr returns true if ip is a public unicast address.
+func isPublicAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// txTrackClient returns the key identifying the client of ctx for the limit of
+// txs tracked per client: its rate limit key if it is rate limited, its IP
+// address otherwise.
+func txTrackClient(ctx *rpctypes.Context) string {
+	if key := rpcserver.RateLimitKey(ctx.Context()); key != "" {
+		return key
+	}
+	host, _, err := net.SplitHostPort(ctx.RemoteAddr())
+	if err != nil {
+		host = ctx.RemoteAddr()
+	}
+	return "ip:" + host
+}
+
+//-----------------------------------------------------------------------------
+
+// trackedTx is a tx tracked by a txTracker.
+type trackedTx struct {
+	key    types.TxKey
+	result ctypes.ResultTxTrack
+	// key of the client which broadcast the tx, see txTrackClient
+	client string
+	// set once the tx passed CheckTx; until then, it may not be in the
+	// mempool yet
+	checked bool
+	// when the tx started to be tracked, or was resolved
+	since    time.Time
+	notifies []func(ctypes.ResultTxTrack)
+}
+
+// txTracker tracks the txs broadcast with BroadcastTxTrack until they are
+// committed or dropped, and retains their status for a while afterwards.
+type txTracker struct {
+	max          int
+	maxPerClient int // 0 means no limit
+	retention    time.Duration
+
+	mtx     sync.Mutex
+	txs     map[string]*trackedTx
+	clients map[string]int // number of tracked txs per client
+}
+
+func newTxTracker(max, maxPerClient int, retention time.Duration) *txTracker {
+	return &txTracker{
+		max:          max,
+		maxPerClient: maxPerClient,
+		retention:    retention,
+		txs:          make(map[string]*trackedTx),
+		clients:      make(map[string]int),
+	}
+}
+
+// add starts tracking tx for client and returns its ID. notifies are called
+// with the status of tx once it is resolved.
+func (t *txTracker) add(tx types.Tx, client string, notifies []func(ctypes.ResultTxTrack), now time.Time) (string, error) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.prune(now)
+	if len(t.txs) >= t.max {
+		return "", fmt.Errorf("too many tracked txs: %d, max: %d (max_tracked_txs)", len(t.txs), t.max)
+	}
+	if n := t.clients[client]; t.maxPerClient > 0 && n >= t.maxPerClient {
+		return "", fmt.Errorf("too many txs tracked for this client: %d, max: %d (max_tracked_txs_per_client)",
+			n, t.maxPerClient)
+	}
+
+	id := crypto.CRandHex(32)
+	t.txs[id] = &trackedTx{
+		key: tx.Key(),
+		result: ctypes.ResultTxTrack{
+			ID:     id,
+			Hash:   tx.Hash(),
+			Status: ctypes.TxTrackPending,
+		},
+		client:   client,
+		since:    now,
+		notifies: notifies,
+	}
+	t.clients[client]++
+	return id, nil
+}
+
+// check marks the tx of the given ID as passed CheckTx.
+func (t *txTracker) check(id string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	if tx, ok := t.txs[id]; ok {
+		tx.checked = true
+	}
+}
+
+// remove stops tracking the tx of the given ID, without notifying.
+func (t *txTracker) remove(id string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.delete(id)
+}
+
+// delete removes the tx of the given ID. t.mtx must be held.
+func (t *txTracker) delete(id string) {
+	tx, ok := t.txs[id]
+	if !ok {
+		return
+	}
+	delete(t.txs, id)
+	if t.clients[tx.client]--; t.clients[tx.client] <= 0 {
+		delete(t.clients, tx.client)
+	}
+}
+
+// get returns the status of the tx of the given ID.
+func (t *txTracker) get(id string) (ctypes.ResultTxTrack, bool) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	tx, ok := t.txs[id]
+	if !ok {
+		return ctypes.ResultTxTrack{}, false
+	}
+	return tx.result, true
+}
+
+// update resolves the tracked txs once block is committed: the txs of block
+// are committed, and the pending txs which passed CheckTx and are no longer in
+// the mempool, according to inMempool, are dropped. inMempool is nil if the
+// mempool can't tell, in which case the pending txs expire once tracked for
+// longer than the retention period. It returns the notifications of the
+// resolved txs, to be called by the caller.
+func (t *txTracker) update(
+	block *types.Block,
+	txResults []*abci.ExecTxResult,
+	inMempool func(types.TxKey) bool,
+	now time.Time,
+) []func() {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	blockTxs := make(map[types.TxKey]int, len(block.Txs))
+	for i, tx := range block.Txs {
+		blockTxs[tx.Key()] = i
+	}
+
+	var notifications []func()
+	for _, tx := range t.txs {
+		if tx.result.Status != ctypes.TxTrackPending {
+			continue
+		}
+		i, committed := blockTxs[tx.key]
+		switch {
+		case committed:
+			tx.result.Height = block.Height
+			if i < len(txResults) && txResults[i] != nil {
+				tx.result.TxResult = *txResults[i]
+			}
+			notifications = append(notifications, t.resolve(tx, ctypes.TxTrackCommitted, now)...)
+		case !tx.checked:
+		case inMempool != nil:
+			if !inMempool(tx.key) {
+				notifications = append(notifications, t.resolve(tx, ctypes.TxTrackDropped, now)...)
+			}
+		case now.Sub(tx.since) >= t.retention:
+			notifications = append(notifications, t.resolve(tx, ctypes.TxTrackExpired, now)...)
+		}
+	}
+	t.prune(now)
+	return notifications
+}
+
+// resolve sets the final status of tx and returns its notifications.
+func (t *txTracker) resolve(tx *trackedTx, status string, now time.Time) []func() {
+	tx.result.Status = status
+	tx.since = now
+
+	notifications := make([]func(), 0, len(tx.notifies))
+	for _, notify := range tx.notifies {
+		notify, result := notify, tx.result
+		notifications = append(notifications, func() { notify(result) })
+	}
+	tx.notifies = nil
+	return notifications
+}
+
+// prune removes the resolved txs whose retention period is over.
+func (t *txTracker) prune(now time.Time) {
+	for id, tx := range t.txs {
+		if tx.result.Status != ctypes.TxTrackPending && now.Sub(tx.since) >= t.retention {
+			t.delete(id)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package core
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/log"
+	mempl "github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/mempool/mocks"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestTxTracker(t *testing.T) {
+	now := time.Now()
+	tracker := newTxTracker(3, 0, time.Minute)
+
+	var notified []ctypes.ResultTxTrack
+	notify := func(result ctypes.ResultTxTrack) { notified = append(notified, result) }
+
+	committed, dropped, unchecked := types.Tx("a=1"), types.Tx("b=2"), types.Tx("c=3")
+	committedID, err := tracker.add(committed, "ip:a", []func(ctypes.ResultTxTrack){notify}, now)
+	require.NoError(t, err)
+	droppedID, err := tracker.add(dropped, "ip:a", []func(ctypes.ResultTxTrack){notify}, now)
+	require.NoError(t, err)
+	uncheckedID, err := tracker.add(unchecked, "ip:a", nil, now)
+	require.NoError(t, err)
+	_, err = tracker.add(types.Tx("d=4"), "ip:b", nil, now)
+	require.ErrorContains(t, err, "max_tracked_txs")
+	tracker.check(committedID)
+	tracker.check(droppedID)
+
+	// Only the txs which passed CheckTx are dropped.
+	block := types.MakeBlock(5, []types.Tx{types.Tx("e=5"), committed}, nil, nil)
+	txResults := []*abci.ExecTxResult{{Code: 1}, {Code: 0, Data: []byte("ok")}}
+	inMempool := func(types.TxKey) bool { return false }
+	for _, notify := range tracker.update(block, txResults, inMempool, now) {
+		notify()
+	}
+	require.Len(t, notified, 2)
+
+	result, ok := tracker.get(committedID)
+	require.True(t, ok)
+	assert.Equal(t, ctypes.TxTrackCommitted, result.Status)
+	assert.EqualValues(t, 5, result.Height)
+	assert.Equal(t, *txResults[1], result.TxResult)
+	assert.Contains(t, notified, result)
+
+	result, ok = tracker.get(droppedID)
+	require.True(t, ok)
+	assert.Equal(t, ctypes.TxTrackDropped, result.Status)
+	assert.Contains(t, notified, result)
+
+	result, ok = tracker.get(uncheckedID)
+	require.True(t, ok)
+	assert.Equal(t, ctypes.TxTrackPending, result.Status)
+
+	// The resolved txs are removed after the retention period.
+	tracker.remove(uncheckedID)
+	tracker.update(types.MakeBlock(6, nil, nil, nil), nil, inMempool, now.Add(time.Minute))
+	_, ok = tracker.get(committedID)
+	assert.False(t, ok)
+	_, ok = tracker.get(droppedID)
+	assert.False(t, ok)
+}
+
+func TestTxTrackerPerClient(t *testing.T) {
+	now := time.Now()
+	tracker := newTxTracker(10, 2, time.Minute)
+
+	first, err := tracker.add(types.Tx("a=1"), "ip:a", nil, now)
+	require.NoError(t, err)
+	_, err = tracker.add(types.Tx("b=2"), "ip:a", nil, now)
+	require.NoError(t, err)
+	_, err = tracker.add(types.Tx("c=3"), "ip:a", nil, now)
+	require.ErrorContains(t, err, "max_tracked_txs_per_client")
+
+	// The other clients have their own limit.
+	_, err = tracker.add(types.Tx("c=3"), "key:b", nil, now)
+	require.NoError(t, err)
+
+	// The resolved txs count until their retention period is over.
+	tracker.check(first)
+	inMempool := func(types.TxKey) bool { return false }
+	tracker.update(types.MakeBlock(1, []types.Tx{types.Tx("a=1")}, nil, nil), nil, inMempool, now)
+	_, err = tracker.add(types.Tx("c=3"), "ip:a", nil, now)
+	require.ErrorContains(t, err, "max_tracked_txs_per_client")
+	_, err = tracker.add(types.Tx("c=3"), "ip:a", nil, now.Add(time.Minute))
+	require.NoError(t, err)
+}
+
+func TestTxTrackerExpiry(t *testing.T) {
+	now := time.Now()
+	tracker := newTxTracker(10, 0, time.Minute)
+
+	notified := false
+	id, err := tracker.add(types.Tx("a=1"), "ip:a", []func(ctypes.ResultTxTrack){func(result ctypes.ResultTxTrack) {
+		assert.Equal(t, ctypes.TxTrackExpired, result.Status)
+		notified = true
+	}}, now)
+	require.NoError(t, err)
+	tracker.check(id)
+
+	// Without knowing the content of the mempool, the pending txs only expire.
+	require.Empty(t, tracker.update(types.MakeBlock(1, nil, nil, nil), nil, nil, now.Add(time.Second)))
+	result, ok := tracker.get(id)
+	require.True(t, ok)
+	assert.Equal(t, ctypes.TxTrackPending, result.Status)
+
+	for _, notify := range tracker.update(types.MakeBlock(2, nil, nil, nil), nil, nil, now.Add(time.Minute)) {
+		notify()
+	}
+	assert.True(t, notified)
+	result, ok = tracker.get(id)
+	require.True(t, ok)
+	assert.Equal(t, ctypes.TxTrackExpired, result.Status)
+}
+
+func TestBroadcastTxTrack(t *testing.T) {
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() { _ = eventBus.Stop() })
+
+	webhookCh := make(chan []byte, 1)
+	webhook := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		webhookCh <- body
+	}))
+	t.Cleanup(webhook.Close)
+
+	tx, invalidTx := types.Tx("a=b"), types.Tx("invalid")
+	mempool := &mocks.Mempool{}
+	mempool.On("CheckTx", tx, mock.Anything, mempl.TxInfo{}).Run(func(args mock.Arguments) {
+		args.Get(1).(func(*abci.ResponseCheckTx))(&abci.ResponseCheckTx{Code: abci.CodeTypeOK})
+	}).Return(nil)
+	mempool.On("CheckTx", invalidTx, mock.Anything, mempl.TxInfo{}).Run(func(args mock.Arguments) {
+		args.Get(1).(func(*abci.ResponseCheckTx))(&abci.ResponseCheckTx{Code: 1})
+	}).Return(nil)
+
+	// The test server is local, and only reached by name with its client.
+	webhookURL := strings.Replace(webhook.URL, "127.0.0.1", "localhost", 1)
+	env := &Environment{
+		EventBus:             eventBus,
+		Mempool:              mempool,
+		MempoolReactor:       syncedReactor{},
+		Config:               *cfg.TestRPCConfig(),
+		Logger:               log.NewNopLogger(),
+		txTrackWebhookClient: webhook.Client(),
+	}
+
+	_, err := env.BroadcastTxTrack(&rpctypes.Context{}, tx, webhookURL)
+	require.ErrorContains(t, err, "tx_track_webhooks")
+	env.Config.TxTrackWebhooks = true
+	_, err = env.BroadcastTxTrack(&rpctypes.Context{}, tx, "ftp://localhost")
+	require.ErrorContains(t, err, "invalid webhook")
+	_, err = env.BroadcastTxTrack(&rpctypes.Context{}, tx, webhook.URL)
+	require.ErrorContains(t, err, "not a public IP address")
+
+	// The txs failing CheckTx are not tracked.
+	res, err := env.BroadcastTxTrack(&rpctypes.Context{}, invalidTx, "")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, res.CheckTx.Code)
+	assert.Empty(t, res.ID)
+
+	res, err = env.BroadcastTxTrack(&rpctypes.Context{}, tx, webhookURL)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.ID)
+	assert.EqualValues(t, tx.Hash(), res.Hash)
+
+	status, err := env.TxTrack(&rpctypes.Context{}, res.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ctypes.TxTrackPending, status.Status)
+
+	txResult := &abci.ExecTxResult{Data: []byte("ok")}
+	err = eventBus.PublishEventNewBlock(types.EventDataNewBlock{
+		Block:               types.MakeBlock(1, []types.Tx{tx}, nil, nil),
+		ResultFinalizeBlock: abci.ResponseFinalizeBlock{TxResults: []*abci.ExecTxResult{txResult}},
+	})
+	require.NoError(t, err)
+
+	select {
+	case body := <-webhookCh:
+		var posted ctypes.ResultTxTrack
+		require.NoError(t, cmtjson.Unmarshal(body, &posted))
+		assert.Equal(t, res.ID, posted.ID)
+		assert.Equal(t, ctypes.TxTrackCommitted, posted.Status)
+		assert.EqualValues(t, 1, posted.Height)
+		assert.Equal(t, *txResult, posted.TxResult)
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook not called")
+	}
+
+	status, err = env.TxTrack(&rpctypes.Context{}, res.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ctypes.TxTrackCommitted, status.Status)
+
+	_, err = env.TxTrack(&rpctypes.Context{}, "unknown")
+	require.ErrorContains(t, err, "not found")
+
+	env.Config.MaxTrackedTxs = 0
+	_, err = env.BroadcastTxTrack(&rpctypes.Context{}, tx, "")
+	require.ErrorContains(t, err, "disabled")
+}
+
+func TestTxTrackWebhookClient(t *testing.T) {
+	for addr, public := range map[string]bool{
+		"8.8.8.8":          true,
+		"2001:4860::8888":  true,
+		"127.0.0.1":        false,
+		"::1":              false,
+		"10.1.2.3":         false,
+		"172.16.0.1":       false,
+		"192.168.1.1":      false,
+		"169.254.169.254":  false,
+		"fe80::1":          false,
+		"fd00::1":          false,
+		"0.0.0.0":          false,
+		"0.1.2.3":          false,
+		"100.64.0.1":       false,
+		"224.0.0.1":        false,
+		"::ffff:127.0.0.1": false,
+	} {
+		assert.Equal(t, public, isPublicAddr(netip.MustParseAddr(addr)), addr)
+	}
+
+	redirected := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		t.Error("redirect followed")
+	}))
+	t.Cleanup(redirected.Close)
+	webhook := httptest.NewServer(http.RedirectHandler(redirected.URL, http.StatusFound))
+	t.Cleanup(webhook.Close)
+
+	// The local webhooks are not reached, even by name.
+	resp, err := txTrackWebhookClient.Post(strings.Replace(webhook.URL, "127.0.0.1", "localhost", 1), "application/json", nil)
+	if err == nil {
+		resp.Body.Close()
+	}
+	require.ErrorContains(t, err, "not a public IP address")
+
+	// The redirects are not followed.
+	client := webhook.Client()
+	client.CheckRedirect = txTrackWebhookClient.CheckRedirect
+	resp, err = client.Post(webhook.URL, "application/json", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+}
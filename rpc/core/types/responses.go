@@ -2,6 +2,9 @@ package coretypes
 
 import (
 	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -54,6 +57,7 @@ type ResultCommit struct {
 type ResultBlockResults struct {
 	Height                int64                     `json:"height"`
 	TxsResults            []*abci.ExecTxResult      `json:"txs_results"`
+	TxsResultsProofs      []types.TxResultProof     `json:"txs_results_proofs,omitempty"`
 	FinalizeBlockEvents   []abci.Event              `json:"finalize_block_events"`
 	ValidatorUpdates      []abci.ValidatorUpdate    `json:"validator_updates"`
 	ConsensusParamUpdates *cmtproto.ConsensusParams `json:"consensus_param_updates"`
@@ -153,6 +157,49 @@ type ResultConsensusParams struct {
 	ConsensusParams types.ConsensusParams `json:"consensus_params"`
 }
 
+// Change of the voting power of a validator between two validator sets. The
+// previous power of an added validator and the power of a removed one are 0.
+type ValidatorPowerChange struct {
+	Address       types.Address `json:"address"`
+	PubKey        crypto.PubKey `json:"pub_key"`
+	PreviousPower int64         `json:"previous_power"`
+	Power         int64         `json:"power"`
+}
+
+// Validators whose voting power changed at a height
+type ValidatorSetChange struct {
+	Height  int64                  `json:"height"`
+	Changes []ValidatorPowerChange `json:"changes"`
+}
+
+// Validator set changes in a range of heights
+type ResultValidatorSetChanges struct {
+	From    int64                `json:"from"`
+	To      int64                `json:"to"`
+	Changes []ValidatorSetChange `json:"changes"`
+}
+
+// Change of a consensus param, named by its dotted JSON path
+type ConsensusParamChange struct {
+	Param         string `json:"param"`
+	PreviousValue string `json:"previous_value"`
+	Value         string `json:"value"`
+}
+
+// Consensus params that took effect at a height, and the changed params
+type ConsensusParamsChange struct {
+	Height          int64                  `json:"height"`
+	ConsensusParams types.ConsensusParams  `json:"consensus_params"`
+	Changes         []ConsensusParamChange `json:"changes"`
+}
+
+// Consensus params changes in a range of heights
+type ResultConsensusParamsChanges struct {
+	From    int64                   `json:"from"`
+	To      int64                   `json:"to"`
+	Changes []ConsensusParamsChange `json:"changes"`
+}
+
 // Info about the consensus state.
 // UNSTABLE
 type ResultDumpConsensusState struct {
@@ -171,6 +218,11 @@ type ResultConsensusState struct {
 	RoundState json.RawMessage `json:"round_state"`
 }
 
+// UNSTABLE
+type ResultConsensusTrace struct {
+	Trace json.RawMessage `json:"trace"`
+}
+
 // CheckTx result
 type ResultBroadcastTx struct {
 	Code      uint32         `json:"code"`
@@ -181,6 +233,23 @@ type ResultBroadcastTx struct {
 	Hash bytes.HexBytes `json:"hash"`
 }
 
+// CheckTx results of several txs, in order
+type ResultBroadcastTxs struct {
+	Results []ResultBroadcastTxsItem `json:"results"`
+}
+
+// CheckTx result of a tx of ResultBroadcastTxs. Error is set instead if the
+// tx was rejected before CheckTx, e.g. if it was already in the cache.
+type ResultBroadcastTxsItem struct {
+	Code      uint32         `json:"code"`
+	Data      bytes.HexBytes `json:"data"`
+	Log       string         `json:"log"`
+	Codespace string         `json:"codespace"`
+
+	Hash  bytes.HexBytes `json:"hash"`
+	Error string         `json:"error,omitempty"`
+}
+
 // CheckTx and ExecTx results
 type ResultBroadcastTxCommit struct {
 	CheckTx  abci.ResponseCheckTx `json:"check_tx"`
@@ -189,11 +258,62 @@ type ResultBroadcastTxCommit struct {
 	Height   int64                `json:"height"`
 }
 
+// CheckTx result of a tracked tx, with the ID to query its status with. ID is
+// empty if the tx failed CheckTx, in which case it is not tracked.
+type ResultBroadcastTxTrack struct {
+	ID      string               `json:"id"`
+	Hash    bytes.HexBytes       `json:"hash"`
+	CheckTx abci.ResponseCheckTx `json:"check_tx"`
+}
+
+// Statuses of a tracked tx
+const (
+	// The tx is in the mempool, or about to be.
+	TxTrackPending = "pending"
+	// The tx was committed in the block of Height, with TxResult.
+	TxTrackCommitted = "committed"
+	// The tx was removed from the mempool without being committed, e.g. by
+	// a recheck or a flush, or not added because the mempool was full.
+	TxTrackDropped = "dropped"
+	// The tx was neither committed nor known to be dropped within the
+	// retention period, and is no longer tracked.
+	TxTrackExpired = "expired"
+)
+
+// Status of a tracked tx. Height and TxResult are only set once the tx is
+// committed.
+type ResultTxTrack struct {
+	ID       string            `json:"id"`
+	Hash     bytes.HexBytes    `json:"hash"`
+	Status   string            `json:"status"`
+	Height   int64             `json:"height"`
+	TxResult abci.ExecTxResult `json:"tx_result"`
+}
+
 // ResultCheckTx wraps abci.ResponseCheckTx.
 type ResultCheckTx struct {
 	abci.ResponseCheckTx
 }
 
+// ResultSimulateTx contains the result of the simulated execution of a tx.
+type ResultSimulateTx struct {
+	Hash     bytes.HexBytes    `json:"hash"`
+	TxResult abci.ExecTxResult `json:"tx_result"`
+}
+
+// Proofs of a committed tx and of its result: TxProof proves Tx against the
+// DataHash of the header at Height, and TxResultProof proves the deterministic
+// fields of TxResult against the LastResultsHash of the header at Height+1.
+type ResultTxResultProof struct {
+	Hash          bytes.HexBytes      `json:"hash"`
+	Height        int64               `json:"height"`
+	Index         uint32              `json:"index"`
+	Tx            types.Tx            `json:"tx"`
+	TxProof       types.TxProof       `json:"tx_proof"`
+	TxResult      abci.ExecTxResult   `json:"tx_result"`
+	TxResultProof types.TxResultProof `json:"tx_result_proof"`
+}
+
 // Result of querying for a tx
 type ResultTx struct {
 	Hash     bytes.HexBytes    `json:"hash"`
@@ -208,12 +328,16 @@ type ResultTx struct {
 type ResultTxSearch struct {
 	Txs        []*ResultTx `json:"txs"`
 	TotalCount int         `json:"total_count"`
+	// NextCursor selects the results following Txs, if any.
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // ResultBlockSearch defines the RPC response type for a block search by events.
 type ResultBlockSearch struct {
 	Blocks     []*ResultBlock `json:"blocks"`
 	TotalCount int            `json:"total_count"`
+	// NextCursor selects the results following Blocks, if any.
+	NextCursor string `json:"next_cursor,omitempty"`
 }
 
 // List of mempool txs
@@ -239,6 +363,45 @@ type ResultBroadcastEvidence struct {
 	Hash []byte `json:"hash"`
 }
 
+// Result of setting the indexer retain height
+type ResultSetIndexerRetainHeight struct {
+	Height int64 `json:"height"`
+}
+
+// Result of starting the compaction of databases
+type ResultCompactDBs struct {
+	// the databases being compacted
+	DBs []string `json:"dbs"`
+}
+
+// Size and key counts of a database
+type DBStats struct {
+	Name string `json:"name"`
+	// size of the files of the database, in bytes, or 0 for in-memory
+	// databases
+	Size int64 `json:"size"`
+	Keys int64 `json:"keys"`
+	// number of keys by kind of data, for the block and state stores
+	KeysByPrefix map[string]int64 `json:"keys_by_prefix,omitempty"`
+}
+
+// Heights below which the data of the node was pruned
+type PruningStats struct {
+	// lowest height of the blocks still stored
+	BlockStoreBase             int64 `json:"block_store_base"`
+	ABCIResponsesRetainHeight  int64 `json:"abci_responses_retain_height"`
+	CompanionBlockRetainHeight int64 `json:"companion_block_retain_height"`
+	TxIndexerRetainHeight      int64 `json:"tx_indexer_retain_height"`
+	BlockIndexerRetainHeight   int64 `json:"block_indexer_retain_height"`
+}
+
+// Storage statistics of the node
+type ResultStorageStats struct {
+	DBs        []DBStats    `json:"dbs"`
+	Pruning    PruningStats `json:"pruning"`
+	Compacting bool         `json:"compacting"`
+}
+
 // empty results
 type (
 	ResultUnsafeFlushMempool struct{}
@@ -250,7 +413,38 @@ type (
 
 // Event data from a subscription
 type ResultEvent struct {
+	// ID identifies the NewBlock, NewBlockEvents and Tx events, which may be
+	// received twice when a subscription is resumed (see EventID).
+	ID     string              `json:"id,omitempty"`
 	Query  string              `json:"query"`
 	Data   types.TMEventData   `json:"data"`
 	Events map[string][]string `json:"events"`
 }
+
+// EventID returns the ID of the event with the given data: the height and the
+// type of the event, followed by the index of the transaction for the Tx
+// events, like "42/tx/3". It returns "" for the other events, which have no
+// ID.
+func EventID(data types.TMEventData) string {
+	switch data := data.(type) {
+	case types.EventDataNewBlock:
+		return fmt.Sprintf("%d/block", data.Block.Height)
+	case types.EventDataNewBlockEvents:
+		return fmt.Sprintf("%d/block_events", data.Height)
+	case types.EventDataTx:
+		return fmt.Sprintf("%d/tx/%d", data.Height, data.Index)
+	default:
+		return ""
+	}
+}
+
+// EventHeight returns the height of the event with the given ID, or false if
+// it is not a valid event ID.
+func EventHeight(id string) (int64, bool) {
+	height, _, ok := strings.Cut(id, "/")
+	if !ok {
+		return 0, false
+	}
+	h, err := strconv.ParseInt(height, 10, 64)
+	return h, err == nil
+}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
 )
 
 func TestStatusIndexer(t *testing.T) {
@@ -33,3 +35,24 @@ func TestStatusIndexer(t *testing.T) {
 		assert.Equal(t, tc.expected, status.TxIndexEnabled())
 	}
 }
+
+func TestEventID(t *testing.T) {
+	cases := []struct {
+		data   types.TMEventData
+		id     string
+		height int64
+	}{
+		{types.EventDataNewBlock{Block: &types.Block{Header: types.Header{Height: 5}}}, "5/block", 5},
+		{types.EventDataNewBlockEvents{Height: 6}, "6/block_events", 6},
+		{types.EventDataTx{TxResult: abci.TxResult{Height: 7, Index: 2}}, "7/tx/2", 7},
+		{types.EventDataVote{}, "", 0},
+	}
+
+	for _, tc := range cases {
+		id := EventID(tc.data)
+		assert.Equal(t, tc.id, id)
+		height, ok := EventHeight(id)
+		assert.Equal(t, tc.id != "", ok)
+		assert.Equal(t, tc.height, height)
+	}
+}
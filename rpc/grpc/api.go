@@ -6,6 +6,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	core "github.com/cometbft/cometbft/rpc/core"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
 )
 
 type broadcastAPI struct {
@@ -38,3 +39,28 @@ func (bapi *broadcastAPI) BroadcastTx(_ context.Context, req *RequestBroadcastTx
 		},
 	}, nil
 }
+
+func (bapi *broadcastAPI) BroadcastTxs(_ context.Context, req *RequestBroadcastTxs) (*ResponseBroadcastTxs, error) {
+	txs := make([]types.Tx, len(req.Txs))
+	for i, tx := range req.Txs {
+		txs[i] = tx
+	}
+	res, err := bapi.env.BroadcastTxs(&rpctypes.Context{}, txs, req.Async)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*BroadcastTxResult, len(res.Results))
+	for i, r := range res.Results {
+		results[i] = &BroadcastTxResult{Hash: r.Hash, Error: r.Error}
+		if !req.Async && r.Error == "" {
+			results[i].CheckTx = &abci.ResponseCheckTx{
+				Code:      r.Code,
+				Data:      r.Data,
+				Log:       r.Log,
+				Codespace: r.Codespace,
+			}
+		}
+	}
+	return &ResponseBroadcastTxs{Results: results}, nil
+}
@@ -18,12 +18,12 @@ type Config struct {
 }
 
 // StartGRPCServer starts a new gRPC BroadcastAPIServer using the given
-// net.Listener.
+// net.Listener and server options, e.g. the maximum size of the messages.
 // NOTE: This function blocks - you may want to call it in a go-routine.
 //
 // Deprecated: A new gRPC API will be introduced after v0.38.
-func StartGRPCServer(env *core.Environment, ln net.Listener) error {
-	grpcServer := grpc.NewServer()
+func StartGRPCServer(env *core.Environment, ln net.Listener, opts ...grpc.ServerOption) error {
+	grpcServer := grpc.NewServer(opts...)
 	RegisterBroadcastAPIServer(grpcServer, &broadcastAPI{env: env})
 	return grpcServer.Serve(ln)
 }
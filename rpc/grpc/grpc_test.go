@@ -8,8 +8,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cometbft/cometbft/abci/example/kvstore"
+	"github.com/cometbft/cometbft/mempool"
 	core_grpc "github.com/cometbft/cometbft/rpc/grpc"
 	rpctest "github.com/cometbft/cometbft/rpc/test"
+	"github.com/cometbft/cometbft/types"
 )
 
 func TestMain(m *testing.M) {
@@ -33,3 +35,21 @@ func TestBroadcastTx(t *testing.T) {
 	require.EqualValues(t, 0, res.CheckTx.Code)
 	require.EqualValues(t, 0, res.TxResult.Code)
 }
+
+func TestBroadcastTxs(t *testing.T) {
+	tx := kvstore.NewTx("hello", "batch")
+	res, err := rpctest.GetGRPCClient().BroadcastTxs(
+		context.Background(),
+		&core_grpc.RequestBroadcastTxs{Txs: [][]byte{tx, []byte("invalid"), tx}},
+	)
+	require.NoError(t, err)
+	require.Len(t, res.Results, 3)
+	require.EqualValues(t, types.Tx(tx).Hash(), res.Results[0].Hash)
+	require.EqualValues(t, 0, res.Results[0].CheckTx.Code)
+	require.EqualValues(t, kvstore.CodeTypeInvalidTxFormat, res.Results[1].CheckTx.Code)
+	require.Nil(t, res.Results[2].CheckTx)
+	require.Equal(t, mempool.ErrTxInCache.Error(), res.Results[2].Error)
+
+	_, err = rpctest.GetGRPCClient().BroadcastTxs(context.Background(), &core_grpc.RequestBroadcastTxs{})
+	require.Error(t, err)
+}
@@ -0,0 +1,77 @@
+// Package privileged implements the privileged gRPC server of CometBFT. The
+// services it serves allow to influence the operation of the node, e.g. the
+// pruning of blocks, and must not be exposed publicly.
+package privileged
+
+import (
+	"net"
+
+	"google.golang.org/grpc"
+
+	"github.com/cometbft/cometbft/libs/log"
+	pruningsvc "github.com/cometbft/cometbft/proto/tendermint/services/pruning"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/pruningservice"
+	sm "github.com/cometbft/cometbft/state"
+)
+
+// Option is any function that allows for configuration of the privileged
+// gRPC server.
+type Option func(*serverBuilder)
+
+type serverBuilder struct {
+	pruningService pruningsvc.PruningServiceServer
+	logger         log.Logger
+	grpcOpts       []grpc.ServerOption
+}
+
+func newServerBuilder() *serverBuilder {
+	return &serverBuilder{
+		logger:   log.NewNopLogger(),
+		grpcOpts: make([]grpc.ServerOption, 0),
+	}
+}
+
+// WithPruningService enables the pruning service on the privileged gRPC
+// server.
+func WithPruningService(blockStore sm.BlockStore, stateStore sm.Store, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.pruningService = pruningservice.New(blockStore, stateStore, logger)
+	}
+}
+
+// WithLogger enables logging using the given logger.
+func WithLogger(logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.logger = logger
+	}
+}
+
+// WithGRPCOption allows one to specify Google gRPC server options.
+func WithGRPCOption(opt grpc.ServerOption) Option {
+	return func(b *serverBuilder) {
+		b.grpcOpts = append(b.grpcOpts, opt)
+	}
+}
+
+// NewServer returns a privileged gRPC server serving the services enabled by
+// the given options. The caller is responsible for starting it with Serve and
+// stopping it with GracefulStop.
+func NewServer(opts ...Option) *grpc.Server {
+	b := newServerBuilder()
+	for _, opt := range opts {
+		opt(b)
+	}
+	server := grpc.NewServer(b.grpcOpts...)
+	if b.pruningService != nil {
+		pruningsvc.RegisterPruningServiceServer(server, b.pruningService)
+		b.logger.Debug("Registered pruning service")
+	}
+	return server
+}
+
+// Serve starts the privileged gRPC server on the given listener, serving the
+// services enabled by the given options.
+// NOTE: This function blocks - you may want to call it in a go-routine.
+func Serve(listener net.Listener, opts ...Option) error {
+	return NewServer(opts...).Serve(listener)
+}
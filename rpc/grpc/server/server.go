@@ -0,0 +1,85 @@
+// Package server implements the gRPC server of CometBFT, which serves the
+// block and block results services.
+package server
+
+import (
+	"net"
+
+	"google.golang.org/grpc"
+
+	"github.com/cometbft/cometbft/libs/log"
+	blocksvc "github.com/cometbft/cometbft/proto/tendermint/services/block"
+	blockresultsvc "github.com/cometbft/cometbft/proto/tendermint/services/block_results"
+	"github.com/cometbft/cometbft/rpc/core"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/blockresultservice"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/blockservice"
+)
+
+// Option is any function that allows for configuration of the gRPC server.
+type Option func(*serverBuilder)
+
+type serverBuilder struct {
+	listener            net.Listener
+	blockService        blocksvc.BlockServiceServer
+	blockResultsService blockresultsvc.BlockResultsServiceServer
+	logger              log.Logger
+	grpcOpts            []grpc.ServerOption
+}
+
+func newServerBuilder(listener net.Listener) *serverBuilder {
+	return &serverBuilder{
+		listener: listener,
+		logger:   log.NewNopLogger(),
+		grpcOpts: make([]grpc.ServerOption, 0),
+	}
+}
+
+// WithBlockService enables the block service on the gRPC server.
+func WithBlockService(env *core.Environment, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.blockService = blockservice.New(env, logger)
+	}
+}
+
+// WithBlockResultsService enables the block results service on the gRPC
+// server.
+func WithBlockResultsService(env *core.Environment, logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.blockResultsService = blockresultservice.New(env, logger)
+	}
+}
+
+// WithLogger enables logging using the given logger.
+func WithLogger(logger log.Logger) Option {
+	return func(b *serverBuilder) {
+		b.logger = logger
+	}
+}
+
+// WithGRPCOption allows one to specify Google gRPC server options.
+func WithGRPCOption(opt grpc.ServerOption) Option {
+	return func(b *serverBuilder) {
+		b.grpcOpts = append(b.grpcOpts, opt)
+	}
+}
+
+// Serve starts the gRPC server on the given listener, serving the services
+// enabled by the given options.
+// NOTE: This function blocks - you may want to call it in a go-routine.
+func Serve(listener net.Listener, opts ...Option) error {
+	b := newServerBuilder(listener)
+	for _, opt := range opts {
+		opt(b)
+	}
+	server := grpc.NewServer(b.grpcOpts...)
+	if b.blockService != nil {
+		blocksvc.RegisterBlockServiceServer(server, b.blockService)
+		b.logger.Debug("Registered block service")
+	}
+	if b.blockResultsService != nil {
+		blockresultsvc.RegisterBlockResultsServiceServer(server, b.blockResultsService)
+		b.logger.Debug("Registered block results service")
+	}
+	b.logger.Info("serve", "msg", "Starting gRPC server")
+	return server.Serve(b.listener)
+}
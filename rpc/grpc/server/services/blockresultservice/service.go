@@ -0,0 +1,54 @@
+// Package blockresultservice implements the gRPC block results service, which
+// provides the results of the blocks executed by the node.
+package blockresultservice
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cometbft/cometbft/libs/log"
+	v1 "github.com/cometbft/cometbft/proto/tendermint/services/block_results"
+	"github.com/cometbft/cometbft/rpc/core"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+type blockResultsServiceServer struct {
+	env    *core.Environment
+	logger log.Logger
+}
+
+// New creates a new CometBFT block results service server.
+func New(env *core.Environment, logger log.Logger) v1.BlockResultsServiceServer {
+	return &blockResultsServiceServer{
+		env:    env,
+		logger: logger.With("service", "BlockResultsService"),
+	}
+}
+
+// GetBlockResults implements v1.BlockResultsServiceServer.
+func (s *blockResultsServiceServer) GetBlockResults(
+	_ context.Context,
+	req *v1.GetBlockResultsRequest,
+) (*v1.GetBlockResultsResponse, error) {
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height cannot be negative, but got %d", req.Height)
+	}
+	var heightPtr *int64
+	if req.Height > 0 {
+		heightPtr = &req.Height
+	}
+	res, err := s.env.BlockResults(&rpctypes.Context{}, heightPtr, false)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &v1.GetBlockResultsResponse{
+		Height:                res.Height,
+		TxResults:             res.TxsResults,
+		FinalizeBlockEvents:   res.FinalizeBlockEvents,
+		ValidatorUpdates:      res.ValidatorUpdates,
+		ConsensusParamUpdates: res.ConsensusParamUpdates,
+		AppHash:               res.AppHash,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package blockresultservice_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	v1 "github.com/cometbft/cometbft/proto/tendermint/services/block_results"
+	"github.com/cometbft/cometbft/rpc/core"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/blockresultservice"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/mocks"
+)
+
+func TestGetBlockResults(t *testing.T) {
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("Base").Return(int64(1))
+	blockStore.On("Height").Return(int64(2))
+
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	results := map[int64]*abci.ResponseFinalizeBlock{
+		1: {
+			TxResults: []*abci.ExecTxResult{{Code: 1, Data: []byte("first")}},
+			AppHash:   []byte("app_hash_1"),
+		},
+		2: {
+			TxResults: []*abci.ExecTxResult{{Code: 0, Data: []byte("second")}},
+			Events:    []abci.Event{{Type: "block", Attributes: []abci.EventAttribute{{Key: "k", Value: "v"}}}},
+			AppHash:   []byte("app_hash_2"),
+		},
+	}
+	for height, resp := range results {
+		require.NoError(t, stateStore.SaveFinalizeBlockResponse(height, resp))
+	}
+
+	svc := blockresultservice.New(&core.Environment{
+		BlockStore: blockStore,
+		StateStore: stateStore,
+		Logger:     log.TestingLogger(),
+	}, log.TestingLogger())
+	ctx := context.Background()
+
+	resp, err := svc.GetBlockResults(ctx, &v1.GetBlockResultsRequest{Height: 1})
+	require.NoError(t, err)
+	require.EqualValues(t, 1, resp.Height)
+	require.Equal(t, results[1].TxResults, resp.TxResults)
+	require.Equal(t, results[1].AppHash, resp.AppHash)
+
+	// height 0 returns the results of the latest block
+	resp, err = svc.GetBlockResults(ctx, &v1.GetBlockResultsRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, resp.Height)
+	require.Equal(t, results[2].Events, resp.FinalizeBlockEvents)
+
+	_, err = svc.GetBlockResults(ctx, &v1.GetBlockResultsRequest{Height: 3})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = svc.GetBlockResults(ctx, &v1.GetBlockResultsRequest{Height: -1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,197 @@
+// Package blockservice implements the gRPC block service, which provides the
+// blocks stored by the node, either one at a time or as a stream.
+package blockservice
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cometbft/cometbft/libs/log"
+	v1 "github.com/cometbft/cometbft/proto/tendermint/services/block"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/rpc/core"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// newBlockBufferSize is the capacity of the event bus subscription used to
+// learn about new blocks. Notifications are coalesced as soon as they are
+// received, so it only needs to absorb short scheduling delays.
+const newBlockBufferSize = 10
+
+type blockServiceServer struct {
+	env    *core.Environment
+	logger log.Logger
+
+	// used to give each event bus subscription a unique subscriber name
+	lastSubscriberID atomic.Uint64
+}
+
+// New creates a new CometBFT block service server.
+func New(env *core.Environment, logger log.Logger) v1.BlockServiceServer {
+	return &blockServiceServer{
+		env:    env,
+		logger: logger.With("service", "BlockService"),
+	}
+}
+
+// GetBlock implements v1.BlockServiceServer.
+func (s *blockServiceServer) GetBlock(_ context.Context, req *v1.GetBlockRequest) (*v1.GetBlockResponse, error) {
+	if req.Height < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height cannot be negative, but got %d", req.Height)
+	}
+	var heightPtr *int64
+	if req.Height > 0 {
+		heightPtr = &req.Height
+	}
+	blockID, block, err := s.loadBlock(heightPtr)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetBlockResponse{
+		BlockId: blockID,
+		Block:   block,
+	}, nil
+}
+
+// GetLatestHeight implements v1.BlockServiceServer.
+func (s *blockServiceServer) GetLatestHeight(
+	_ *v1.GetLatestHeightRequest,
+	stream v1.BlockService_GetLatestHeightServer,
+) error {
+	ctx := stream.Context()
+	newBlocks, err := s.subscribeNewBlocks(ctx)
+	if err != nil {
+		return err
+	}
+
+	lastHeight := int64(0)
+	for {
+		// Only the latest height is sent, so a slow client skips heights
+		// instead of accumulating them.
+		if height := s.env.BlockStore.Height(); height > lastHeight {
+			if err := stream.Send(&v1.GetLatestHeightResponse{Height: height}); err != nil {
+				s.logger.Debug("Error sending latest height", "height", height, "err", err)
+				return err
+			}
+			lastHeight = height
+		}
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case _, ok := <-newBlocks:
+			if !ok {
+				return status.Error(codes.Unavailable, "new block subscription was canceled")
+			}
+		}
+	}
+}
+
+// StreamBlocks implements v1.BlockServiceServer.
+func (s *blockServiceServer) StreamBlocks(req *v1.StreamBlocksRequest, stream v1.BlockService_StreamBlocksServer) error {
+	if req.FromHeight < 0 {
+		return status.Errorf(codes.InvalidArgument, "height cannot be negative, but got %d", req.FromHeight)
+	}
+	ctx := stream.Context()
+	newBlocks, err := s.subscribeNewBlocks(ctx)
+	if err != nil {
+		return err
+	}
+
+	height := req.FromHeight
+	if height == 0 {
+		height = max(s.env.BlockStore.Height(), 1)
+	}
+	if base := s.env.BlockStore.Base(); height < base {
+		return status.Errorf(codes.NotFound, "height %d is not available, lowest height is %d", height, base)
+	}
+
+	for {
+		// Blocks are loaded one at a time, once the previous one has been
+		// handed over to the transport, so that a slow client only holds back
+		// its own stream.
+		for ; height <= s.env.BlockStore.Height(); height++ {
+			blockID, block, err := s.loadBlock(&height)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(&v1.StreamBlocksResponse{BlockId: blockID, Block: block}); err != nil {
+				s.logger.Debug("Error sending block", "height", height, "err", err)
+				return err
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case _, ok := <-newBlocks:
+			if !ok {
+				return status.Error(codes.Unavailable, "new block subscription was canceled")
+			}
+		}
+	}
+}
+
+// loadBlock loads the block at the given height, or the latest block if the
+// height is nil, and converts it to its protobuf representation.
+func (s *blockServiceServer) loadBlock(heightPtr *int64) (*cmtproto.BlockID, *cmtproto.Block, error) {
+	res, err := s.env.Block(&rpctypes.Context{}, heightPtr)
+	if err != nil {
+		return nil, nil, status.Error(codes.NotFound, err.Error())
+	}
+	if res.Block == nil {
+		return nil, nil, status.Error(codes.NotFound, "block not found")
+	}
+	bp, err := res.Block.ToProto()
+	if err != nil {
+		s.logger.Error("Error converting block to proto", "height", res.Block.Height, "err", err)
+		return nil, nil, status.Errorf(codes.Internal, "failed to convert block at height %d", res.Block.Height)
+	}
+	blockID := res.BlockID.ToProto()
+	return &blockID, bp, nil
+}
+
+// subscribeNewBlocks returns a channel receiving a notification every time a
+// new block is committed, until the given context is done. Notifications are
+// coalesced, so that a slow consumer never causes the event bus subscription to
+// be canceled for being out of capacity. The channel is closed if the
+// subscription is canceled.
+func (s *blockServiceServer) subscribeNewBlocks(ctx context.Context) (<-chan struct{}, error) {
+	if s.env.EventBus.NumClients() >= s.env.Config.MaxSubscriptionClients {
+		return nil, status.Errorf(codes.ResourceExhausted,
+			"max_subscription_clients %d reached", s.env.Config.MaxSubscriptionClients)
+	}
+	subscriber := fmt.Sprintf("grpc-block-service-%d", s.lastSubscriberID.Add(1))
+	sub, err := s.env.EventBus.Subscribe(ctx, subscriber, types.EventQueryNewBlockHeader, newBlockBufferSize)
+	if err != nil {
+		s.logger.Error("Error subscribing to new blocks", "err", err)
+		return nil, status.Error(codes.Unavailable, "failed to subscribe to new blocks")
+	}
+
+	notify := make(chan struct{}, 1)
+	go func() {
+		defer close(notify)
+		defer func() {
+			if err := s.env.EventBus.UnsubscribeAll(context.Background(), subscriber); err != nil {
+				s.logger.Debug("Error unsubscribing from new blocks", "subscriber", subscriber, "err", err)
+			}
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sub.Canceled():
+				return
+			case <-sub.Out():
+				select {
+				case notify <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+	return notify, nil
+}
@@ -0,0 +1,162 @@
+package blockservice_test
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/libs/log"
+	v1 "github.com/cometbft/cometbft/proto/tendermint/services/block"
+	"github.com/cometbft/cometbft/rpc/core"
+	grpcserver "github.com/cometbft/cometbft/rpc/grpc/server"
+	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
+)
+
+type testChain struct {
+	env    *core.Environment
+	height atomic.Int64
+}
+
+// newTestChain returns a chain of the given height, whose blocks are served
+// by a mock block store.
+func newTestChain(t *testing.T, height int64) *testChain {
+	t.Helper()
+
+	c := &testChain{}
+	c.height.Store(height)
+
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("Base").Return(int64(1))
+	blockStore.On("Height").Return(func() int64 { return c.height.Load() })
+	blockStore.On("LoadBlock", mock.Anything).Return(func(h int64) *types.Block {
+		return types.MakeBlock(h, nil, new(types.Commit), nil)
+	})
+	blockStore.On("LoadBlockMeta", mock.Anything).Return(func(int64) *types.BlockMeta {
+		return &types.BlockMeta{BlockID: types.BlockID{Hash: tmhash.Sum([]byte("block"))}}
+	})
+
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	c.env = &core.Environment{
+		BlockStore: blockStore,
+		EventBus:   eventBus,
+		Logger:     log.TestingLogger(),
+		Config:     *cfg.TestRPCConfig(),
+	}
+	return c
+}
+
+// commit advances the chain by one block and notifies the subscribers.
+func (c *testChain) commit(t *testing.T) {
+	t.Helper()
+	height := c.height.Add(1)
+	err := c.env.EventBus.PublishEventNewBlockHeader(types.EventDataNewBlockHeader{
+		Header: types.Header{Height: height},
+	})
+	require.NoError(t, err)
+}
+
+func newClient(t *testing.T, env *core.Environment) v1.BlockServiceClient {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		_ = grpcserver.Serve(ln, grpcserver.WithBlockService(env, env.Logger))
+	}()
+
+	conn, err := grpc.NewClient(ln.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return v1.NewBlockServiceClient(conn)
+}
+
+func TestGetBlock(t *testing.T) {
+	chain := newTestChain(t, 3)
+	client := newClient(t, chain.env)
+	ctx := context.Background()
+
+	resp, err := client.GetBlock(ctx, &v1.GetBlockRequest{Height: 2})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, resp.Block.Header.Height)
+	require.NotEmpty(t, resp.BlockId.Hash)
+
+	// height 0 returns the latest block
+	resp, err = client.GetBlock(ctx, &v1.GetBlockRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, resp.Block.Header.Height)
+
+	_, err = client.GetBlock(ctx, &v1.GetBlockRequest{Height: 4})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.GetBlock(ctx, &v1.GetBlockRequest{Height: -1})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetLatestHeight(t *testing.T) {
+	chain := newTestChain(t, 3)
+	client := newClient(t, chain.env)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := client.GetLatestHeight(ctx, &v1.GetLatestHeightRequest{})
+	require.NoError(t, err)
+
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	require.EqualValues(t, 3, resp.Height)
+
+	chain.commit(t)
+	resp, err = stream.Recv()
+	require.NoError(t, err)
+	require.EqualValues(t, 4, resp.Height)
+}
+
+func TestStreamBlocks(t *testing.T) {
+	chain := newTestChain(t, 3)
+	client := newClient(t, chain.env)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := client.StreamBlocks(ctx, &v1.StreamBlocksRequest{FromHeight: 2})
+	require.NoError(t, err)
+
+	for _, height := range []int64{2, 3} {
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, height, resp.Block.Header.Height)
+	}
+
+	// the stream waits for new blocks once it has caught up
+	chain.commit(t)
+	chain.commit(t)
+	for _, height := range []int64{4, 5} {
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, height, resp.Block.Header.Height)
+	}
+
+	stream, err = client.StreamBlocks(ctx, &v1.StreamBlocksRequest{FromHeight: -1})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,137 @@
+// Package pruningservice implements the gRPC pruning service, which allows a
+// data companion to read the blocks and block results stored by the node, and
+// to hold back their pruning until it has processed them.
+package pruningservice
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	v1 "github.com/cometbft/cometbft/proto/tendermint/services/pruning"
+	sm "github.com/cometbft/cometbft/state"
+)
+
+type pruningServiceServer struct {
+	blockStore sm.BlockStore
+	stateStore sm.Store
+	logger     log.Logger
+
+	// serializes updates of the companion retain height
+	mtx cmtsync.Mutex
+}
+
+// New creates a new CometBFT pruning service server.
+func New(blockStore sm.BlockStore, stateStore sm.Store, logger log.Logger) v1.PruningServiceServer {
+	return &pruningServiceServer{
+		blockStore: blockStore,
+		stateStore: stateStore,
+		logger:     logger.With("service", "PruningService"),
+	}
+}
+
+// GetBlock implements v1.PruningServiceServer.
+func (s *pruningServiceServer) GetBlock(_ context.Context, req *v1.GetBlockRequest) (*v1.GetBlockResponse, error) {
+	if err := s.checkHeight(req.Height); err != nil {
+		return nil, err
+	}
+	blockMeta := s.blockStore.LoadBlockMeta(req.Height)
+	block := s.blockStore.LoadBlock(req.Height)
+	if blockMeta == nil || block == nil {
+		return nil, status.Errorf(codes.NotFound, "block at height %d not found", req.Height)
+	}
+	bp, err := block.ToProto()
+	if err != nil {
+		s.logger.Error("Error converting block to proto", "height", req.Height, "err", err)
+		return nil, status.Errorf(codes.Internal, "failed to convert block at height %d", req.Height)
+	}
+	blockID := blockMeta.BlockID.ToProto()
+	return &v1.GetBlockResponse{
+		BlockId: &blockID,
+		Block:   bp,
+	}, nil
+}
+
+// GetBlockResults implements v1.PruningServiceServer.
+func (s *pruningServiceServer) GetBlockResults(
+	_ context.Context,
+	req *v1.GetBlockResultsRequest,
+) (*v1.GetBlockResultsResponse, error) {
+	if err := s.checkHeight(req.Height); err != nil {
+		return nil, err
+	}
+	resp, err := s.stateStore.LoadFinalizeBlockResponse(req.Height)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "results of block at height %d not found: %v", req.Height, err)
+	}
+	return &v1.GetBlockResultsResponse{
+		Height:        req.Height,
+		FinalizeBlock: resp,
+	}, nil
+}
+
+// SetBlockRetainHeight implements v1.PruningServiceServer.
+func (s *pruningServiceServer) SetBlockRetainHeight(
+	_ context.Context,
+	req *v1.SetBlockRetainHeightRequest,
+) (*v1.SetBlockRetainHeightResponse, error) {
+	height := req.Height
+	if height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height must be greater than 0, but got %d", height)
+	}
+	if latest := s.blockStore.Height(); height > latest {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"height %d is greater than the latest height %d", height, latest)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	current, err := s.stateStore.GetCompanionBlockRetainHeight()
+	if err != nil {
+		s.logger.Error("Error loading the companion block retain height", "err", err)
+		return nil, status.Error(codes.Internal, "failed to load the companion block retain height")
+	}
+	if height < current {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"height %d is lower than the current companion block retain height %d", height, current)
+	}
+	if err := s.stateStore.SaveCompanionBlockRetainHeight(height); err != nil {
+		s.logger.Error("Error saving the companion block retain height", "height", height, "err", err)
+		return nil, status.Error(codes.Internal, "failed to save the companion block retain height")
+	}
+	s.logger.Info("Set companion block retain height", "height", height)
+	return &v1.SetBlockRetainHeightResponse{}, nil
+}
+
+// GetBlockRetainHeight implements v1.PruningServiceServer.
+func (s *pruningServiceServer) GetBlockRetainHeight(
+	context.Context,
+	*v1.GetBlockRetainHeightRequest,
+) (*v1.GetBlockRetainHeightResponse, error) {
+	height, err := s.stateStore.GetCompanionBlockRetainHeight()
+	if err != nil {
+		s.logger.Error("Error loading the companion block retain height", "err", err)
+		return nil, status.Error(codes.Internal, "failed to load the companion block retain height")
+	}
+	return &v1.GetBlockRetainHeightResponse{
+		CompanionRetainHeight: height,
+		Base:                  s.blockStore.Base(),
+	}, nil
+}
+
+// checkHeight returns an error if the block store does not hold the given
+// height.
+func (s *pruningServiceServer) checkHeight(height int64) error {
+	if height <= 0 {
+		return status.Errorf(codes.InvalidArgument, "height must be greater than 0, but got %d", height)
+	}
+	if base, latest := s.blockStore.Base(), s.blockStore.Height(); height < base || height > latest {
+		return status.Errorf(codes.NotFound,
+			"height %d is not available, lowest height is %d and latest height is %d", height, base, latest)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package pruningservice_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/libs/log"
+	v1 "github.com/cometbft/cometbft/proto/tendermint/services/pruning"
+	"github.com/cometbft/cometbft/rpc/grpc/server/services/pruningservice"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
+)
+
+func newService(t *testing.T) (v1.PruningServiceServer, sm.Store) {
+	t.Helper()
+
+	block := types.MakeBlock(5, nil, new(types.Commit), nil)
+	blockID := types.BlockID{Hash: tmhash.Sum([]byte("block"))}
+
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("Base").Return(int64(2))
+	blockStore.On("Height").Return(int64(10))
+	blockStore.On("LoadBlock", int64(5)).Return(block)
+	blockStore.On("LoadBlockMeta", int64(5)).Return(&types.BlockMeta{BlockID: blockID})
+	blockStore.On("LoadBlock", int64(6)).Return(nil)
+	blockStore.On("LoadBlockMeta", int64(6)).Return(nil)
+
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	return pruningservice.New(blockStore, stateStore, log.TestingLogger()), stateStore
+}
+
+func TestGetBlock(t *testing.T) {
+	svc, _ := newService(t)
+	ctx := context.Background()
+
+	resp, err := svc.GetBlock(ctx, &v1.GetBlockRequest{Height: 5})
+	require.NoError(t, err)
+	require.EqualValues(t, 5, resp.Block.Header.Height)
+	require.NotEmpty(t, resp.BlockId.Hash)
+
+	_, err = svc.GetBlock(ctx, &v1.GetBlockRequest{Height: 6})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = svc.GetBlock(ctx, &v1.GetBlockRequest{Height: 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = svc.GetBlock(ctx, &v1.GetBlockRequest{Height: 0})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGetBlockResults(t *testing.T) {
+	svc, stateStore := newService(t)
+	ctx := context.Background()
+
+	finalizeBlock := &abci.ResponseFinalizeBlock{
+		TxResults: []*abci.ExecTxResult{{Code: 1, Data: []byte("result")}},
+		AppHash:   []byte("app_hash"),
+	}
+	require.NoError(t, stateStore.SaveFinalizeBlockResponse(5, finalizeBlock))
+
+	resp, err := svc.GetBlockResults(ctx, &v1.GetBlockResultsRequest{Height: 5})
+	require.NoError(t, err)
+	require.EqualValues(t, 5, resp.Height)
+	require.Equal(t, finalizeBlock.AppHash, resp.FinalizeBlock.AppHash)
+	require.Equal(t, finalizeBlock.TxResults, resp.FinalizeBlock.TxResults)
+
+	_, err = svc.GetBlockResults(ctx, &v1.GetBlockResultsRequest{Height: 6})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestBlockRetainHeight(t *testing.T) {
+	svc, stateStore := newService(t)
+	ctx := context.Background()
+
+	resp, err := svc.GetBlockRetainHeight(ctx, &v1.GetBlockRetainHeightRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, resp.CompanionRetainHeight)
+	require.EqualValues(t, 2, resp.Base)
+
+	_, err = svc.SetBlockRetainHeight(ctx, &v1.SetBlockRetainHeightRequest{Height: 7})
+	require.NoError(t, err)
+	height, err := stateStore.GetCompanionBlockRetainHeight()
+	require.NoError(t, err)
+	require.EqualValues(t, 7, height)
+
+	resp, err = svc.GetBlockRetainHeight(ctx, &v1.GetBlockRetainHeightRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 7, resp.CompanionRetainHeight)
+
+	testCases := []struct {
+		name   string
+		height int64
+	}{
+		{"zero height", 0},
+		{"height above the latest height", 11},
+		{"decreasing height", 6},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svc.SetBlockRetainHeight(ctx, &v1.SetBlockRetainHeightRequest{Height: tc.height})
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+
+	// setting the same height again is allowed
+	_, err = svc.SetBlockRetainHeight(ctx, &v1.SetBlockRetainHeightRequest{Height: 7})
+	require.NoError(t, err)
+}
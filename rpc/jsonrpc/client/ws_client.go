@@ -553,6 +553,14 @@ func (c *WSClient) Subscribe(ctx context.Context, query string) error {
 	return c.Call(ctx, "subscribe", params)
 }
 
+// SubscribeFromHeight subscribes to the given query, replaying the events of
+// the heights from fromHeight onwards. Note the server must have a
+// "subscribe" route defined, accepting a "from_height" parameter.
+func (c *WSClient) SubscribeFromHeight(ctx context.Context, query string, fromHeight int64) error {
+	params := map[string]any{"query": query, "from_height": fromHeight}
+	return c.Call(ctx, "subscribe", params)
+}
+
 // Unsubscribe from a query. Note the server must have a "unsubscribe" route
 // defined.
 func (c *WSClient) Unsubscribe(ctx context.Context, query string) error {
@@ -0,0 +1,332 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	types "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+// AnonymousRole is the role of every client, including the clients without
+// credentials.
+const AnonymousRole = "anonymous"
+
+// Credentials are the identity and the roles of an authenticated client.
+type Credentials struct {
+	// ID identifies the client, e.g. to rate limit it.
+	ID    string
+	Roles []string
+	// ExpiresAt is the time at which the credentials expire, or the zero time
+	// if they do not. The websocket connections and event streams opened with
+	// them are closed when they expire.
+	ExpiresAt time.Time
+}
+
+// errCredentialsExpired is returned when the credentials of a client expired.
+var errCredentialsExpired = errors.New("credentials expired")
+
+// Authenticator authenticates the clients of the RPC server.
+type Authenticator interface {
+	// Authenticate returns the credentials of the client of r, or nil if r
+	// has no credentials for this authenticator. It returns an error if they
+	// are invalid.
+	Authenticate(r *http.Request) (*Credentials, error)
+}
+
+// AuthConfig defines how the clients of the RPC server are authenticated and
+// which methods they may call.
+type AuthConfig struct {
+	// Authenticators are tried in order; the first one finding credentials
+	// in a request authenticates its client.
+	Authenticators []Authenticator
+	// RoleMethods lists the methods each role may call. A method ending with
+	// "*" matches all the methods with that prefix.
+	RoleMethods map[string][]string
+}
+
+// Allowed reports whether a client with the given roles may call method. The
+// AnonymousRole is implied.
+func (cfg *AuthConfig) Allowed(roles []string, method string) bool {
+	if cfg.allowedByRole(AnonymousRole, method) {
+		return true
+	}
+	for _, role := range roles {
+		if cfg.allowedByRole(role, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func (cfg *AuthConfig) allowedByRole(role, method string) bool {
+	for _, pattern := range cfg.RoleMethods[role] {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+			if strings.HasPrefix(method, prefix) {
+				return true
+			}
+		} else if pattern == method {
+			return true
+		}
+	}
+	return false
+}
+
+// authenticate returns the credentials of the client of r, or nil if it has
+// none.
+func (cfg *AuthConfig) authenticate(r *http.Request) (*Credentials, error) {
+	for _, a := range cfg.Authenticators {
+		creds, err := a.Authenticate(r)
+		if err != nil || creds != nil {
+			return creds, err
+		}
+	}
+	return nil, nil
+}
+
+type clientKey struct{}
+
+// client is a client of the RPC server.
+type client struct {
+	config *AuthConfig
+	// credentials of the client, nil if it has none
+	creds *Credentials
+}
+
+// clientFromContext returns the client authenticated by AuthHandler, or nil
+// if authentication is disabled.
+func clientFromContext(ctx context.Context) *client {
+	c, _ := ctx.Value(clientKey{}).(*client)
+	return c
+}
+
+// expiresAt returns the time at which the credentials of c expire, or the
+// zero time if they do not.
+func (c *client) expiresAt() time.Time {
+	if c == nil || c.creds == nil {
+		return time.Time{}
+	}
+	return c.creds.ExpiresAt
+}
+
+// authorize returns an error if c may not call method, or if its credentials
+// expired. A nil client may call every method.
+func (c *client) authorize(method string) error {
+	if c == nil {
+		return nil
+	}
+	if exp := c.expiresAt(); !exp.IsZero() && !time.Now().Before(exp) {
+		return errCredentialsExpired
+	}
+	var roles []string
+	if c.creds != nil {
+		roles = c.creds.Roles
+	}
+	if c.config.Allowed(roles, method) {
+		return nil
+	}
+	return fmt.Errorf("method %s is not allowed for roles %v", method, append([]string{AnonymousRole}, roles...))
+}
+
+// AuthHandler wraps an HTTP handler, authenticating the clients of the
+// requests with the given config. Requests with invalid credentials are
+// rejected with an HTTP 401 error. The methods the clients may call are
+// checked by the handlers registered by RegisterRPCFuncs and by the
+// WebsocketManager. If limiter is not nil, the failed authentications are
+// rate limited per IP address, so that the credentials cannot be guessed:
+// once a client runs out of tokens, its requests are rejected with an HTTP
+// 429 error before being authenticated.
+func AuthHandler(next http.Handler, config *AuthConfig, limiter *RateLimiter, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if limiter != nil {
+			if wait := limiter.authFailureWait(r); wait > 0 {
+				err := fmt.Errorf("too many failed authentications, retry in %v", wait.Round(time.Millisecond))
+				writeTooManyRequests(w, types.RPCTooManyRequestsError(nil, err), wait, logger)
+				return
+			}
+		}
+		creds, err := config.authenticate(r)
+		if err != nil {
+			logger.Debug("Failed to authenticate RPC client", "remoteAddr", r.RemoteAddr, "err", err)
+			if limiter != nil {
+				limiter.chargeAuthFailure(r)
+			}
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			res := types.RPCUnauthorizedError(nil, err)
+			if wErr := WriteRPCResponseHTTPError(w, http.StatusUnauthorized, res); wErr != nil {
+				logger.Error("failed to write response", "err", wErr)
+			}
+			return
+		}
+		ctx := context.WithValue(r.Context(), clientKey{}, &client{config: config, creds: creds})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// CertAuthenticator authenticates the clients presenting a TLS client
+// certificate verified against Config.TLSClientCAs. A client is identified by
+// the subject of its certificate, and its roles are the organizations (O) of
+// the subject.
+type CertAuthenticator struct{}
+
+// Authenticate implements Authenticator.
+func (CertAuthenticator) Authenticate(r *http.Request) (*Credentials, error) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 {
+		return nil, nil
+	}
+	subject := r.TLS.VerifiedChains[0][0].Subject
+	return &Credentials{ID: "cert:" + subject.String(), Roles: subject.Organization}, nil
+}
+
+// JWTAuthenticator authenticates the clients presenting, as a bearer token in
+// the Authorization header, a JSON Web Token signed with HMAC-SHA256. A client
+// is identified by the "sub" claim of its token, or by the token itself if it
+// has none, and its roles are listed in the "roles" claim. The "exp" and "nbf"
+// claims are checked if present.
+type JWTAuthenticator struct {
+	secret []byte
+}
+
+// jwtHeader is the header of the tokens accepted by JWTAuthenticator.
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ,omitempty"`
+}
+
+// jwtClaims are the claims of the tokens accepted by JWTAuthenticator.
+type jwtClaims struct {
+	Subject   string   `json:"sub,omitempty"`
+	Roles     []string `json:"roles,omitempty"`
+	ExpiresAt int64    `json:"exp,omitempty"`
+	NotBefore int64    `json:"nbf,omitempty"`
+	IssuedAt  int64    `json:"iat,omitempty"`
+}
+
+// minJWTSecretSize is the minimum size of the secret of a JWTAuthenticator,
+// in bytes.
+const minJWTSecretSize = 32
+
+// NewJWTAuthenticator returns an authenticator of the tokens signed with the
+// given secret, which must be at least 32 bytes long.
+func NewJWTAuthenticator(secret []byte) (*JWTAuthenticator, error) {
+	if len(secret) < minJWTSecretSize {
+		return nil, fmt.Errorf("JWT secret must be at least %d bytes long, got %d", minJWTSecretSize, len(secret))
+	}
+	return &JWTAuthenticator{secret: secret}, nil
+}
+
+// LoadJWTAuthenticator returns an authenticator of the tokens signed with the
+// hex-encoded secret stored in the given file.
+func LoadJWTAuthenticator(file string) (*JWTAuthenticator, error) {
+	bz, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("reading JWT secret: %w", err)
+	}
+	secret, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(bz)), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("decoding JWT secret from %s: %w", file, err)
+	}
+	return NewJWTAuthenticator(secret)
+}
+
+// NewToken returns a token for a client with the given subject, which may be
+// empty, and roles, expiring at expiresAt, or never if it is zero.
+func (a *JWTAuthenticator) NewToken(subject string, roles []string, expiresAt time.Time) (string, error) {
+	claims := jwtClaims{Subject: subject, Roles: roles, IssuedAt: time.Now().Unix()}
+	if !expiresAt.IsZero() {
+		claims.ExpiresAt = expiresAt.Unix()
+	}
+	header, err := json.Marshal(jwtHeader{Alg: "HS256", Typ: "JWT"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(a.sign(signed)), nil
+}
+
+// Authenticate implements Authenticator.
+func (a *JWTAuthenticator) Authenticate(r *http.Request) (*Credentials, error) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return nil, nil
+	}
+	token = strings.TrimSpace(token)
+	claims, err := a.verify(token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	id := "jwt:" + claims.Subject
+	if claims.Subject == "" {
+		hash := sha256.Sum256([]byte(token))
+		id = "jwt:" + hex.EncodeToString(hash[:8])
+	}
+	creds := &Credentials{ID: id, Roles: claims.Roles}
+	if claims.ExpiresAt != 0 {
+		creds.ExpiresAt = time.Unix(claims.ExpiresAt, 0)
+	}
+	return creds, nil
+}
+
+func (a *JWTAuthenticator) sign(signed string) []byte {
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write([]byte(signed))
+	return mac.Sum(nil)
+}
+
+// verify checks the signature and the validity period of token, and returns
+// its claims.
+func (a *JWTAuthenticator) verify(token string, now time.Time) (*jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+
+	var header jwtHeader
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("decoding token header: %w", err)
+	}
+	if header.Alg != "HS256" {
+		return nil, fmt.Errorf("unsupported token algorithm %q", header.Alg)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("decoding token signature: %w", err)
+	}
+	if !hmac.Equal(sig, a.sign(parts[0]+"."+parts[1])) {
+		return nil, errors.New("invalid token signature")
+	}
+
+	var claims jwtClaims
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("decoding token claims: %w", err)
+	}
+	if claims.ExpiresAt != 0 && now.Unix() >= claims.ExpiresAt {
+		return nil, errors.New("token expired")
+	}
+	if claims.NotBefore != 0 && now.Unix() < claims.NotBefore {
+		return nil, errors.New("token not valid yet")
+	}
+	return &claims, nil
+}
+
+func decodeJWTPart(part string, v any) error {
+	bz, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, v)
+}
@@ -0,0 +1,212 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+	types "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+var testJWTSecret = []byte("0123456789abcdef0123456789abcdef")
+
+func newAuthServer(t *testing.T) (*httptest.Server, *JWTAuthenticator) {
+	t.Helper()
+	authenticator, err := NewJWTAuthenticator(testJWTSecret)
+	require.NoError(t, err)
+
+	funcMap := map[string]*RPCFunc{
+		"status":            NewRPCFunc(func(ctx *types.Context) (string, error) { return "ok", nil }, ""),
+		"broadcast_tx_sync": NewRPCFunc(func(ctx *types.Context) (string, error) { return "ok", nil }, ""),
+		"subscribe":         NewWSRPCFunc(func(ctx *types.Context) (string, error) { return "ok", nil }, ""),
+	}
+	mux := http.NewServeMux()
+	wm := NewWebsocketManager(funcMap)
+	wm.SetLogger(log.TestingLogger())
+	mux.HandleFunc("/websocket", wm.WebsocketHandler)
+	RegisterRPCFuncs(mux, funcMap, log.TestingLogger())
+
+	config := &AuthConfig{
+		Authenticators: []Authenticator{authenticator},
+		RoleMethods: map[string][]string{
+			AnonymousRole: {"status"},
+			"submitter":   {"broadcast_tx_*", "subscribe"},
+		},
+	}
+	s := httptest.NewServer(AuthHandler(mux, config, nil, log.TestingLogger()))
+	t.Cleanup(s.Close)
+	return s, authenticator
+}
+
+func TestAuthConfigAllowed(t *testing.T) {
+	config := &AuthConfig{RoleMethods: map[string][]string{
+		AnonymousRole: {"status"},
+		"submitter":   {"broadcast_tx_*"},
+		"admin":       {"*"},
+	}}
+	assert.True(t, config.Allowed(nil, "status"))
+	assert.False(t, config.Allowed(nil, "broadcast_tx_sync"))
+	assert.True(t, config.Allowed([]string{"submitter"}, "broadcast_tx_sync"))
+	assert.True(t, config.Allowed([]string{"submitter"}, "status"))
+	assert.False(t, config.Allowed([]string{"submitter"}, "dump_consensus_state"))
+	assert.True(t, config.Allowed([]string{"unknown", "admin"}, "dump_consensus_state"))
+}
+
+func TestJWTAuthenticatorVerify(t *testing.T) {
+	authenticator, err := NewJWTAuthenticator(testJWTSecret)
+	require.NoError(t, err)
+	now := time.Now()
+
+	token, err := authenticator.NewToken("", []string{"submitter"}, now.Add(time.Hour))
+	require.NoError(t, err)
+	claims, err := authenticator.verify(token, now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"submitter"}, claims.Roles)
+
+	// Expired.
+	_, err = authenticator.verify(token, now.Add(2*time.Hour))
+	require.Error(t, err)
+
+	// Signed with another secret.
+	other, err := NewJWTAuthenticator([]byte(strings.Repeat("x", 32)))
+	require.NoError(t, err)
+	_, err = other.verify(token, now)
+	require.Error(t, err)
+
+	// Unsigned.
+	parts := strings.Split(token, ".")
+	none := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none"}`))
+	_, err = authenticator.verify(none+"."+parts[1]+".", now)
+	require.Error(t, err)
+
+	_, err = NewJWTAuthenticator([]byte("short"))
+	require.Error(t, err)
+}
+
+func TestAuthHandlerHTTP(t *testing.T) {
+	s, authenticator := newAuthServer(t)
+	token, err := authenticator.NewToken("", []string{"submitter"}, time.Time{})
+	require.NoError(t, err)
+
+	get := func(path, authorization string) (int, types.RPCResponse) {
+		req, err := http.NewRequest(http.MethodGet, s.URL+path, nil)
+		require.NoError(t, err)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		var resp types.RPCResponse
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&resp))
+		return res.StatusCode, resp
+	}
+
+	code, _ := get("/status", "")
+	assert.Equal(t, http.StatusOK, code)
+	code, resp := get("/broadcast_tx_sync", "")
+	assert.Equal(t, http.StatusForbidden, code)
+	assert.Equal(t, -32002, resp.Error.Code)
+	code, _ = get("/broadcast_tx_sync", "Bearer "+token)
+	assert.Equal(t, http.StatusOK, code)
+	code, resp = get("/status", "Bearer invalid")
+	assert.Equal(t, http.StatusUnauthorized, code)
+	assert.Equal(t, -32001, resp.Error.Code)
+
+	// JSON-RPC batches are authorized per request.
+	body := `[{"jsonrpc":"2.0","id":1,"method":"status"},{"jsonrpc":"2.0","id":2,"method":"broadcast_tx_sync"}]`
+	res, err := http.Post(s.URL, "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	defer res.Body.Close()
+	var responses []types.RPCResponse
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&responses))
+	require.Len(t, responses, 2)
+	assert.Nil(t, responses[0].Error)
+	require.NotNil(t, responses[1].Error)
+	assert.Equal(t, -32002, responses[1].Error.Code)
+}
+
+func TestAuthHandlerWebsocket(t *testing.T) {
+	s, authenticator := newAuthServer(t)
+	url := "ws://" + s.Listener.Addr().String() + "/websocket"
+
+	call := func(header http.Header) *types.RPCError {
+		c, dialResp, err := websocket.DefaultDialer.Dial(url, header)
+		require.NoError(t, err)
+		defer dialResp.Body.Close()
+		defer c.Close()
+		req, err := types.MapToRequest(types.JSONRPCStringID("ws"), "subscribe", map[string]any{})
+		require.NoError(t, err)
+		require.NoError(t, c.WriteJSON(req))
+		var resp types.RPCResponse
+		require.NoError(t, c.ReadJSON(&resp))
+		return resp.Error
+	}
+
+	rpcErr := call(nil)
+	require.NotNil(t, rpcErr)
+	assert.Equal(t, -32002, rpcErr.Code)
+
+	token, err := authenticator.NewToken("", []string{"submitter"}, time.Time{})
+	require.NoError(t, err)
+	assert.Nil(t, call(http.Header{"Authorization": []string{"Bearer " + token}}))
+}
+
+func TestCertAuthenticator(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/status", nil)
+	creds, err := CertAuthenticator{}.Authenticate(r)
+	require.NoError(t, err)
+	assert.Nil(t, creds)
+
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "client", Organization: []string{"submitter"}}}
+	r.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+	creds, err = CertAuthenticator{}.Authenticate(r)
+	require.NoError(t, err)
+	require.NotNil(t, creds)
+	assert.Equal(t, "cert:CN=client,O=submitter", creds.ID)
+	assert.Equal(t, []string{"submitter"}, creds.Roles)
+}
+
+func TestAuthHandlerWebsocketExpiry(t *testing.T) {
+	s, authenticator := newAuthServer(t)
+	url := "ws://" + s.Listener.Addr().String() + "/websocket"
+
+	token, err := authenticator.NewToken("", []string{"submitter"}, time.Now().Add(2*time.Second))
+	require.NoError(t, err)
+	c, dialResp, err := websocket.DefaultDialer.Dial(url, http.Header{"Authorization": []string{"Bearer " + token}})
+	require.NoError(t, err)
+	defer dialResp.Body.Close()
+	defer c.Close()
+
+	req, err := types.MapToRequest(types.JSONRPCStringID("ws"), "subscribe", map[string]any{})
+	require.NoError(t, err)
+	require.NoError(t, c.WriteJSON(req))
+	var resp types.RPCResponse
+	require.NoError(t, c.ReadJSON(&resp))
+	require.Nil(t, resp.Error)
+
+	// The connection is closed once the token expires.
+	require.NoError(t, c.SetReadDeadline(time.Now().Add(5*time.Second)))
+	_, _, err = c.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.ClosePolicyViolation), "unexpected error: %v", err)
+}
+
+func TestClientAuthorizeExpired(t *testing.T) {
+	config := &AuthConfig{RoleMethods: map[string][]string{"submitter": {"broadcast_tx_*"}}}
+	c := &client{config: config, creds: &Credentials{Roles: []string{"submitter"}, ExpiresAt: time.Now().Add(time.Hour)}}
+	require.NoError(t, c.authorize("broadcast_tx_sync"))
+	c.creds.ExpiresAt = time.Now().Add(-time.Second)
+	require.ErrorIs(t, c.authorize("broadcast_tx_sync"), errCredentialsExpired)
+}
@@ -55,6 +55,17 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 			requests = []types.RPCRequest{request}
 		}
 
+		// Charge the whole batch up front, so that it is either served or
+		// rejected as a whole.
+		if wait, err := limitedClientFromContext(r.Context()).take(rateLimitedCalls(requests, funcMap)...); err != nil {
+			res := types.RPCTooManyRequestsError(nil, err)
+			if len(requests) == 1 {
+				res = types.RPCTooManyRequestsError(requests[0].ID, err)
+			}
+			writeTooManyRequests(w, res, wait, logger)
+			return
+		}
+
 		// Set the default response cache to true unless
 		// 1. Any RPC request error.
 		// 2. Any RPC request doesn't allow to be cached.
@@ -85,6 +96,11 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 				cache = false
 				continue
 			}
+			if err := clientFromContext(r.Context()).authorize(request.Method); err != nil {
+				responses = append(responses, types.RPCForbiddenError(request.ID, err))
+				cache = false
+				continue
+			}
 			ctx := &types.Context{JSONReq: &request, HTTPReq: r}
 			args := []reflect.Value{reflect.ValueOf(ctx)}
 			if len(request.Params) > 0 {
@@ -104,13 +120,12 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 				cache = false
 			}
 
-			returns := rpcFunc.f.Call(args)
-			result, err := unreflectResult(returns)
+			result, err := responseCacheFromContext(r.Context()).call(request.Method, rpcFunc, args)
 			if err != nil {
 				responses = append(responses, types.RPCInternalError(request.ID, err))
 				continue
 			}
-			responses = append(responses, types.NewRPCSuccessResponse(request.ID, result))
+			responses = append(responses, types.RPCResponse{JSONRPC: "2.0", ID: request.ID, Result: result})
 		}
 
 		if len(responses) > 0 {
@@ -127,6 +142,18 @@ func makeJSONRPCHandler(funcMap map[string]*RPCFunc, logger log.Logger) http.Han
 	}
 }
 
+// rateLimitedCalls returns the calls of requests to charge, skipping the
+// notifications and the unknown methods, which are not served.
+func rateLimitedCalls(requests []types.RPCRequest, funcMap map[string]*RPCFunc) []rateLimitedCall {
+	calls := make([]rateLimitedCall, 0, len(requests))
+	for _, request := range requests {
+		if rpcFunc, ok := funcMap[request.Method]; ok && !rpcFunc.ws && request.ID != nil {
+			calls = append(calls, rpcFunc.rateLimitedJSONRPCCall(request.Method, request.Params))
+		}
+	}
+	return calls
+}
+
 func handleInvalidJSONRPCPaths(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Since the pattern "/" matches all paths not matched by other registered patterns,
@@ -4,6 +4,8 @@ package server
 import (
 	"bufio"
 	"bytes"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -36,6 +38,17 @@ type Config struct {
 	MaxHeaderBytes int
 	// maximum number of requests in a batch request
 	MaxRequestBatchSize int
+	// certificate authorities of the client certificates, which ServeTLS
+	// requests and verifies if given (see CertAuthenticator)
+	TLSClientCAs *x509.CertPool
+	// authentication of the clients and the methods they may call, disabled
+	// if nil (see AuthHandler)
+	Auth *AuthConfig
+	// rate limits of the clients, disabled if nil (see RateLimitHandler)
+	RateLimiter *RateLimiter
+	// cache of the immutable results, disabled if nil (see
+	// ResponseCacheHandler)
+	ResponseCache *ResponseCache
 }
 
 // DefaultConfig returns a default configuration.
@@ -58,7 +71,7 @@ func DefaultConfig() *Config {
 func Serve(listener net.Listener, handler http.Handler, logger log.Logger, config *Config) error {
 	logger.Info("serve", "msg", log.NewLazySprintf("Starting RPC HTTP server on %s", listener.Addr()))
 	s := &http.Server{
-		Handler:           PreChecksHandler(RecoverAndLogHandler(defaultHandler{h: handler}, logger), config),
+		Handler:           serverHandler(handler, logger, config),
 		ReadTimeout:       config.ReadTimeout,
 		ReadHeaderTimeout: config.ReadTimeout,
 		WriteTimeout:      config.WriteTimeout,
@@ -71,7 +84,9 @@ func Serve(listener net.Listener, handler http.Handler, logger log.Logger, confi
 
 // ServeTLS creates a http.Server and calls ServeTLS with the given listener,
 // certFile and keyFile. It wraps handler with RecoverAndLogHandler and a
-// handler, which limits the max body size to config.MaxBodyBytes.
+// handler, which limits the max body size to config.MaxBodyBytes. If
+// config.TLSClientCAs is set, the client certificates are requested and
+// verified.
 //
 // NOTE: This function blocks - you may want to call it in a go-routine.
 func ServeTLS(
@@ -84,18 +99,40 @@ func ServeTLS(
 	logger.Info("serve tls", "msg", log.NewLazySprintf("Starting RPC HTTPS server on %s (cert: %q, key: %q)",
 		listener.Addr(), certFile, keyFile))
 	s := &http.Server{
-		Handler:           PreChecksHandler(RecoverAndLogHandler(defaultHandler{h: handler}, logger), config),
+		Handler:           serverHandler(handler, logger, config),
 		ReadTimeout:       config.ReadTimeout,
 		ReadHeaderTimeout: config.ReadTimeout,
 		WriteTimeout:      config.WriteTimeout,
 		MaxHeaderBytes:    config.MaxHeaderBytes,
 	}
+	if config.TLSClientCAs != nil {
+		s.TLSConfig = &tls.Config{
+			ClientCAs:  config.TLSClientCAs,
+			ClientAuth: tls.VerifyClientCertIfGiven,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
 	err := s.ServeTLS(listener, certFile, keyFile)
 
 	logger.Error("RPC HTTPS server stopped", "err", err)
 	return err
 }
 
+// serverHandler wraps handler with the middlewares of Serve and ServeTLS.
+func serverHandler(handler http.Handler, logger log.Logger, config *Config) http.Handler {
+	h := PreChecksHandler(RecoverAndLogHandler(defaultHandler{h: handler}, logger), config)
+	if config.ResponseCache != nil {
+		h = ResponseCacheHandler(h, config.ResponseCache)
+	}
+	if config.RateLimiter != nil {
+		h = RateLimitHandler(h, config.RateLimiter)
+	}
+	if config.Auth != nil {
+		h = AuthHandler(h, config.Auth, config.RateLimiter, logger)
+	}
+	return h
+}
+
 // WriteRPCResponseHTTPError marshals res as JSON (with indent) and writes it
 // to w.
 //
@@ -246,6 +283,11 @@ func (w *responseWriterWrapper) WriteHeader(status int) {
 	w.ResponseWriter.WriteHeader(status)
 }
 
+// Unwrap returns the wrapped ResponseWriter, for http.ResponseController.
+func (w *responseWriterWrapper) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 // implements http.Hijacker
 func (w *responseWriterWrapper) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	return w.ResponseWriter.(http.Hijacker).Hijack()
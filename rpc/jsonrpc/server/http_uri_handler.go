@@ -18,7 +18,7 @@ import (
 var reInt = regexp.MustCompile(`^-?[0-9]+$`)
 
 // convert from a function name to the http handler
-func makeHTTPHandler(rpcFunc *RPCFunc, logger log.Logger) func(http.ResponseWriter, *http.Request) {
+func makeHTTPHandler(method string, rpcFunc *RPCFunc, logger log.Logger) func(http.ResponseWriter, *http.Request) {
 	// Always return -1 as there's no ID here.
 	dummyID := types.JSONRPCIntID(-1) // URIClientRequestID
 
@@ -36,6 +36,13 @@ func makeHTTPHandler(rpcFunc *RPCFunc, logger log.Logger) func(http.ResponseWrit
 	return func(w http.ResponseWriter, r *http.Request) {
 		logger.Debug("HTTP HANDLER", "req", r)
 
+		if err := clientFromContext(r.Context()).authorize(method); err != nil {
+			if wErr := WriteRPCResponseHTTPError(w, http.StatusForbidden, types.RPCForbiddenError(dummyID, err)); wErr != nil {
+				logger.Error("failed to write response", "err", wErr)
+			}
+			return
+		}
+
 		ctx := &types.Context{HTTPReq: r}
 		args := []reflect.Value{reflect.ValueOf(ctx)}
 
@@ -50,11 +57,13 @@ func makeHTTPHandler(rpcFunc *RPCFunc, logger log.Logger) func(http.ResponseWrit
 			return
 		}
 		args = append(args, fnArgs...)
+		if wait, err := limitedClientFromContext(r.Context()).take(rpcFunc.rateLimitedCall(method, args)); err != nil {
+			writeTooManyRequests(w, types.RPCTooManyRequestsError(dummyID, err), wait, logger)
+			return
+		}
 
-		returns := rpcFunc.f.Call(args)
-
-		logger.Debug("HTTPRestRPC", "method", r.URL.Path, "args", args, "returns", returns)
-		result, err := unreflectResult(returns)
+		result, err := responseCacheFromContext(r.Context()).call(method, rpcFunc, args)
+		logger.Debug("HTTPRestRPC", "method", r.URL.Path, "args", args, "result", result)
 		if err != nil {
 			if err := WriteRPCResponseHTTPError(w, http.StatusInternalServerError,
 				types.RPCInternalError(dummyID, err)); err != nil {
@@ -64,7 +73,7 @@ func makeHTTPHandler(rpcFunc *RPCFunc, logger log.Logger) func(http.ResponseWrit
 			return
 		}
 
-		resp := types.NewRPCSuccessResponse(dummyID, result)
+		resp := types.RPCResponse{JSONRPC: "2.0", ID: dummyID, Result: result}
 		if rpcFunc.cacheableWithArgs(args) {
 			err = WriteCacheableRPCResponseHTTP(w, resp)
 		} else {
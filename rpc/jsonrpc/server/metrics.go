@@ -0,0 +1,34 @@
+package server
+
+import (
+	"github.com/go-kit/kit/metrics"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "rpc"
+)
+
+//go:generate go run ../../../scripts/metricsgen -struct=Metrics
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of requests rejected by the rate limits, by method.
+	RateLimitedRequests metrics.Counter `metrics_labels:"method"`
+
+	// Cost of the requests accepted by the rate limits, by method.
+	RequestCost metrics.Counter `metrics_labels:"method"`
+
+	// Number of clients tracked by the rate limits.
+	RateLimitClients metrics.Gauge
+
+	// Number of calls served from the response cache, by method.
+	ResponseCacheHits metrics.Counter `metrics_labels:"method"`
+
+	// Number of immutable calls not found in the response cache, by method.
+	ResponseCacheMisses metrics.Counter `metrics_labels:"method"`
+
+	// Size of the results held by the response cache, in bytes.
+	ResponseCacheSize metrics.Gauge
+}
@@ -0,0 +1,317 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	types "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+// RateLimitConfig defines the rate limits of the clients of the RPC server.
+// Each client has a bucket of tokens, refilled at a constant rate, from which
+// every call takes the cost of its method.
+type RateLimitConfig struct {
+	// Rate is the number of tokens per second of each remote IP address, and
+	// Burst the size of its bucket. A zero Rate disables the limits.
+	Rate  float64
+	Burst int
+	// KeyRate and KeyBurst, if KeyRate is not zero, limit the clients
+	// authenticated by AuthHandler per API key instead of per IP address.
+	KeyRate  float64
+	KeyBurst int
+	// Costs of the methods, 1 if not listed.
+	Costs map[string]int
+}
+
+// rateLimitSweepInterval is the interval at which the buckets of the idle
+// clients are dropped.
+const rateLimitSweepInterval = time.Minute
+
+// RateLimiter enforces a RateLimitConfig. It is safe for concurrent use, and
+// may be shared by several servers.
+type RateLimiter struct {
+	config  RateLimitConfig
+	metrics *Metrics
+	now     func() time.Time
+
+	mtx       sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+// NewRateLimiter returns a rate limiter enforcing config.
+func NewRateLimiter(config RateLimitConfig, metrics *Metrics) *RateLimiter {
+	return &RateLimiter{
+		config:    config,
+		metrics:   metrics,
+		now:       time.Now,
+		buckets:   make(map[string]*tokenBucket),
+		lastSweep: time.Now(),
+	}
+}
+
+// cost returns the cost of a call to method.
+func (l *RateLimiter) cost(method string) int {
+	if cost, ok := l.config.Costs[method]; ok {
+		return cost
+	}
+	return 1
+}
+
+// remoteHost returns the IP address of the client of r.
+func remoteHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// clientKey returns the key of the bucket of the client of r, or "" if it is
+// not limited.
+func (l *RateLimiter) clientKey(r *http.Request) string {
+	if c := clientFromContext(r.Context()); c != nil && c.creds != nil && l.config.KeyRate > 0 {
+		return "key:" + c.creds.ID
+	}
+	if l.config.Rate <= 0 {
+		return ""
+	}
+	return "ip:" + remoteHost(r)
+}
+
+// authFailureKey returns the key of the bucket charged for the failed
+// authentications of the client of r, or "" if they are not limited. They are
+// limited per IP address, at the rate of the anonymous clients if any, of the
+// authenticated ones otherwise.
+func (l *RateLimiter) authFailureKey(r *http.Request) string {
+	if l.config.Rate <= 0 && l.config.KeyRate <= 0 {
+		return ""
+	}
+	return "auth:" + remoteHost(r)
+}
+
+// rateLimitedCall is a call charged to a client.
+type rateLimitedCall struct {
+	method string
+	// items is the number of items of a call to a method charged per item,
+	// see CostPerItem, and 0 for the other methods.
+	items int
+}
+
+// callCost returns the cost of call.
+func (l *RateLimiter) callCost(call rateLimitedCall) int {
+	return l.cost(call.method) * max(call.items, 1)
+}
+
+// bucket returns the bucket with the given key, creating it if needed.
+// l.mtx must be held.
+func (l *RateLimiter) bucket(key string, now time.Time) *tokenBucket {
+	b, ok := l.buckets[key]
+	if !ok {
+		rate, burst := l.config.Rate, l.config.Burst
+		if strings.HasPrefix(key, "key:") || (strings.HasPrefix(key, "auth:") && rate <= 0) {
+			rate, burst = l.config.KeyRate, l.config.KeyBurst
+		}
+		b = &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst), last: now}
+		l.buckets[key] = b
+	}
+	return b
+}
+
+// take takes the cost of calls from the bucket with the given key. It returns
+// an error, and how long to wait for enough tokens, if there are not enough of
+// them. A single call costing more than the size of the bucket is accepted
+// when the bucket is full, and empties it, so that the costs of the methods
+// never make them unavailable; a batch of calls, or a call charged per item,
+// costing more than the size of the bucket is rejected, with no wait, since it
+// would never be accepted.
+func (l *RateLimiter) take(key string, calls ...rateLimitedCall) (time.Duration, error) {
+	cost := 0
+	for _, call := range calls {
+		cost += l.callCost(call)
+	}
+
+	l.mtx.Lock()
+	now := l.now()
+	l.sweep(now)
+	b := l.bucket(key, now)
+	var (
+		ok   bool
+		wait time.Duration
+		err  error
+	)
+	switch {
+	case float64(cost) <= b.burst:
+		ok, wait = b.take(now, float64(cost))
+	case len(calls) == 1 && calls[0].items <= 1:
+		ok, wait = b.take(now, b.burst)
+	default:
+		err = fmt.Errorf("the calls cost %d, more than the rate limit burst of %v: split them", cost, b.burst)
+	}
+	if !ok && err == nil {
+		err = fmt.Errorf("rate limit exceeded, retry in %v", wait.Round(time.Millisecond))
+	}
+	clients := len(l.buckets)
+	l.mtx.Unlock()
+
+	l.metrics.RateLimitClients.Set(float64(clients))
+	for _, call := range calls {
+		if ok {
+			l.metrics.RequestCost.With("method", call.method).Add(float64(l.callCost(call)))
+		} else {
+			l.metrics.RateLimitedRequests.With("method", call.method).Add(1)
+		}
+	}
+	return wait, err
+}
+
+// authFailureWait returns how long the client of r must wait before being
+// authenticated again, after too many failed authentications, or 0.
+func (l *RateLimiter) authFailureWait(r *http.Request) time.Duration {
+	key := l.authFailureKey(r)
+	if key == "" {
+		return 0
+	}
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	now := l.now()
+	b, ok := l.buckets[key]
+	if !ok {
+		return 0
+	}
+	if tokens := b.refill(now); tokens < 1 {
+		return time.Duration((1 - tokens) / b.rate * float64(time.Second))
+	}
+	return 0
+}
+
+// chargeAuthFailure charges the client of r for a failed authentication.
+func (l *RateLimiter) chargeAuthFailure(r *http.Request) {
+	key := l.authFailureKey(r)
+	if key == "" {
+		return
+	}
+	l.mtx.Lock()
+	now := l.now()
+	l.sweep(now)
+	b := l.bucket(key, now)
+	b.take(now, 1)
+	clients := len(l.buckets)
+	l.mtx.Unlock()
+
+	l.metrics.RateLimitClients.Set(float64(clients))
+}
+
+// sweep drops the buckets which have been refilled, since their clients
+// would get the same tokens from new ones. l.mtx must be held.
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		if b.refill(now) >= b.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// tokenBucket is the bucket of tokens of a client.
+type tokenBucket struct {
+	rate   float64 // tokens per second
+	burst  float64 // size of the bucket
+	tokens float64
+	last   time.Time // last refill
+}
+
+// refill adds the tokens accumulated since the last refill, and returns the
+// number of tokens.
+func (b *tokenBucket) refill(now time.Time) float64 {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed.Seconds()*b.rate)
+		b.last = now
+	}
+	return b.tokens
+}
+
+// take takes n tokens from the bucket, or returns false and how long to wait
+// for them.
+func (b *tokenBucket) take(now time.Time, n float64) (bool, time.Duration) {
+	if tokens := b.refill(now); tokens < n {
+		return false, time.Duration((n - tokens) / b.rate * float64(time.Second))
+	}
+	b.tokens -= n
+	return true, 0
+}
+
+type limitedClientKey struct{}
+
+// limitedClient is a client of the RPC server subject to rate limits.
+type limitedClient struct {
+	limiter *RateLimiter
+	key     string
+}
+
+// limitedClientFromContext returns the client limited by RateLimitHandler,
+// or nil if it is not limited.
+func limitedClientFromContext(ctx context.Context) *limitedClient {
+	c, _ := ctx.Value(limitedClientKey{}).(*limitedClient)
+	return c
+}
+
+// RateLimitKey returns the key identifying the client of the request of ctx
+// in the rate limits, i.e. its API key or its IP address, or "" if the client
+// is not rate limited.
+func RateLimitKey(ctx context.Context) string {
+	if c := limitedClientFromContext(ctx); c != nil {
+		return c.key
+	}
+	return ""
+}
+
+// take charges c for calls, returning an error and how long to wait before
+// retrying if it exceeds its rate limit. A nil client is never limited.
+func (c *limitedClient) take(calls ...rateLimitedCall) (time.Duration, error) {
+	if c == nil {
+		return 0, nil
+	}
+	return c.limiter.take(c.key, calls...)
+}
+
+// writeTooManyRequests responds to a client which exceeded its rate limit
+// with an HTTP 429 error, and a Retry-After header unless wait is 0.
+func writeTooManyRequests(w http.ResponseWriter, res types.RPCResponse, wait time.Duration, logger log.Logger) {
+	setRetryAfter(w, wait)
+	if wErr := WriteRPCResponseHTTPError(w, http.StatusTooManyRequests, res); wErr != nil {
+		logger.Error("failed to write response", "err", wErr)
+	}
+}
+
+// setRetryAfter sets the Retry-After header of w to wait, unless it is 0.
+func setRetryAfter(w http.ResponseWriter, wait time.Duration) {
+	if wait > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	}
+}
+
+// RateLimitHandler wraps an HTTP handler, identifying the clients to rate
+// limit with limiter: by API key if they were authenticated by an outer
+// AuthHandler, by remote IP address otherwise. The calls are charged by the
+// handlers registered by RegisterRPCFuncs and by the WebsocketManager.
+func RateLimitHandler(next http.Handler, limiter *RateLimiter) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if key := limiter.clientKey(r); key != "" {
+			ctx := context.WithValue(r.Context(), limitedClientKey{}, &limitedClient{limiter: limiter, key: key})
+			r = r.WithContext(ctx)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
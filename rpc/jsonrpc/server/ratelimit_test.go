@@ -0,0 +1,262 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+	types "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+func TestRateLimiterTake(t *testing.T) {
+	now := time.Now()
+	limiter := NewRateLimiter(RateLimitConfig{
+		Rate:  1,
+		Burst: 5,
+		Costs: map[string]int{"tx_search": 3, "blockchain": 10},
+	}, NopMetrics())
+	limiter.now = func() time.Time { return now }
+	take := func(key string, methods ...string) (time.Duration, error) {
+		calls := make([]rateLimitedCall, len(methods))
+		for i, method := range methods {
+			calls[i] = rateLimitedCall{method: method}
+		}
+		return limiter.take(key, calls...)
+	}
+
+	_, err := take("ip:a", "tx_search")
+	require.NoError(t, err)
+	_, err = take("ip:a", "status", "status")
+	require.NoError(t, err)
+	wait, err := take("ip:a", "status")
+	require.Error(t, err)
+	assert.Equal(t, time.Second, wait)
+
+	// Other clients have their own bucket.
+	_, err = take("ip:b", "status")
+	require.NoError(t, err)
+
+	// The bucket is refilled at the configured rate.
+	now = now.Add(2 * time.Second)
+	_, err = take("ip:a", "status", "status")
+	require.NoError(t, err)
+
+	// A call costing more than the bucket empties a full one.
+	now = now.Add(time.Minute)
+	_, err = take("ip:a", "blockchain")
+	require.NoError(t, err)
+	_, err = take("ip:a", "status")
+	require.Error(t, err)
+
+	// A batch costing more than the bucket is rejected, even by a full one,
+	// and is not worth retrying.
+	now = now.Add(time.Minute)
+	wait, err = take("ip:a", "tx_search", "tx_search")
+	require.Error(t, err)
+	assert.Zero(t, wait)
+	// So is a call charged per item.
+	wait, err = limiter.take("ip:a", rateLimitedCall{method: "tx_search", items: 2})
+	require.Error(t, err)
+	assert.Zero(t, wait)
+	_, err = limiter.take("ip:a", rateLimitedCall{method: "status", items: 5})
+	require.NoError(t, err)
+	_, err = take("ip:a", "status")
+	require.Error(t, err)
+
+	// The refilled buckets are dropped.
+	now = now.Add(time.Hour)
+	_, err = take("ip:c", "tx_search")
+	require.NoError(t, err)
+	assert.Len(t, limiter.buckets, 1)
+}
+
+func newRateLimitServer(t *testing.T, config RateLimitConfig, auth *AuthConfig) *httptest.Server {
+	t.Helper()
+	funcMap := map[string]*RPCFunc{
+		"status":    NewRPCFunc(func(ctx *types.Context) (string, error) { return "ok", nil }, ""),
+		"tx_search": NewRPCFunc(func(ctx *types.Context) (string, error) { return "ok", nil }, ""),
+		"broadcast": NewRPCFunc(func(ctx *types.Context, txs []string) (int, error) { return len(txs), nil }, "txs",
+			CostPerItem("txs")),
+		"subscribe": NewWSRPCFunc(func(ctx *types.Context) (string, error) { return RateLimitKey(ctx.Context()), nil }, ""),
+	}
+	mux := http.NewServeMux()
+	wm := NewWebsocketManager(funcMap)
+	wm.SetLogger(log.TestingLogger())
+	mux.HandleFunc("/websocket", wm.WebsocketHandler)
+	RegisterRPCFuncs(mux, funcMap, log.TestingLogger())
+
+	limiter := NewRateLimiter(config, NopMetrics())
+	var h http.Handler = RateLimitHandler(mux, limiter)
+	if auth != nil {
+		h = AuthHandler(h, auth, limiter, log.TestingLogger())
+	}
+	s := httptest.NewServer(h)
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestRateLimitHandlerHTTP(t *testing.T) {
+	s := newRateLimitServer(t, RateLimitConfig{
+		Rate:  0.001,
+		Burst: 4,
+		Costs: map[string]int{"tx_search": 2},
+	}, nil)
+
+	res, err := http.Get(s.URL + "/tx_search")
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	// The batch costs 3 while 2 tokens are left: it is rejected as a whole.
+	body := `[{"jsonrpc":"2.0","id":1,"method":"status"},{"jsonrpc":"2.0","id":2,"method":"tx_search"}]`
+	res, err = http.Post(s.URL, "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	var resp types.RPCResponse
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&resp))
+	res.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	assert.Equal(t, -32003, resp.Error.Code)
+
+	res, err = http.Post(s.URL, "application/json", strings.NewReader(body[:strings.Index(body, ",{")]+"]"))
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	res, err = http.Get(s.URL + "/tx_search")
+	require.NoError(t, err)
+	require.NoError(t, json.NewDecoder(res.Body).Decode(&resp))
+	res.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	assert.NotEmpty(t, res.Header.Get("Retry-After"))
+	assert.Equal(t, -32003, resp.Error.Code)
+}
+
+func TestRateLimitHandlerCostPerItem(t *testing.T) {
+	s := newRateLimitServer(t, RateLimitConfig{Rate: 0.001, Burst: 4}, nil)
+
+	post := func(body string) (int, types.RPCResponse) {
+		res, err := http.Post(s.URL, "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		defer res.Body.Close()
+		var resp types.RPCResponse
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&resp))
+		return res.StatusCode, resp
+	}
+
+	// 5 txs cost more than the bucket: the call is rejected for good.
+	code, resp := post(`{"jsonrpc":"2.0","id":1,"method":"broadcast","params":{"txs":["a","b","c","d","e"]}}`)
+	assert.Equal(t, http.StatusTooManyRequests, code)
+	assert.Equal(t, -32003, resp.Error.Code)
+	// 3 txs, by position, cost 3.
+	code, _ = post(`{"jsonrpc":"2.0","id":1,"method":"broadcast","params":[["a","b","c"]]}`)
+	assert.Equal(t, http.StatusOK, code)
+	code, _ = post(`{"jsonrpc":"2.0","id":1,"method":"broadcast","params":{"txs":["a","b"]}}`)
+	assert.Equal(t, http.StatusTooManyRequests, code)
+
+	res, err := http.Get(s.URL + `/broadcast?txs=["a"]`)
+	require.NoError(t, err)
+	res.Body.Close()
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+func TestRateLimitHandlerAuthFailures(t *testing.T) {
+	authenticator, err := NewJWTAuthenticator(testJWTSecret)
+	require.NoError(t, err)
+	s := newRateLimitServer(t, RateLimitConfig{
+		Rate:     0.001,
+		Burst:    2,
+		KeyRate:  0.001,
+		KeyBurst: 10,
+	}, &AuthConfig{
+		Authenticators: []Authenticator{authenticator},
+		RoleMethods:    map[string][]string{AnonymousRole: {"*"}},
+	})
+
+	get := func(token string) int {
+		req, err := http.NewRequest(http.MethodGet, s.URL+"/status", nil)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		res.Body.Close()
+		return res.StatusCode
+	}
+
+	token, err := authenticator.NewToken("alice", nil, time.Time{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, get("invalid"))
+	assert.Equal(t, http.StatusUnauthorized, get("invalid"))
+	// Once the failures used up the tokens of the IP address, even valid
+	// credentials are not checked.
+	assert.Equal(t, http.StatusTooManyRequests, get("invalid"))
+	assert.Equal(t, http.StatusTooManyRequests, get(token))
+}
+
+func TestRateLimitHandlerAPIKey(t *testing.T) {
+	authenticator, err := NewJWTAuthenticator(testJWTSecret)
+	require.NoError(t, err)
+	s := newRateLimitServer(t, RateLimitConfig{
+		Rate:     0.001,
+		Burst:    1,
+		KeyRate:  0.001,
+		KeyBurst: 2,
+	}, &AuthConfig{
+		Authenticators: []Authenticator{authenticator},
+		RoleMethods:    map[string][]string{AnonymousRole: {"*"}},
+	})
+
+	get := func(subject string) int {
+		req, err := http.NewRequest(http.MethodGet, s.URL+"/status", nil)
+		require.NoError(t, err)
+		if subject != "" {
+			token, err := authenticator.NewToken(subject, nil, time.Time{})
+			require.NoError(t, err)
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		res.Body.Close()
+		return res.StatusCode
+	}
+
+	assert.Equal(t, http.StatusOK, get(""))
+	assert.Equal(t, http.StatusTooManyRequests, get(""))
+	// Authenticated clients are limited per API key, whatever their IP.
+	assert.Equal(t, http.StatusOK, get("alice"))
+	assert.Equal(t, http.StatusOK, get("alice"))
+	assert.Equal(t, http.StatusTooManyRequests, get("alice"))
+	assert.Equal(t, http.StatusOK, get("bob"))
+}
+
+func TestRateLimitHandlerWebsocket(t *testing.T) {
+	s := newRateLimitServer(t, RateLimitConfig{Rate: 0.001, Burst: 1}, nil)
+	c, dialResp, err := websocket.DefaultDialer.Dial("ws://"+s.Listener.Addr().String()+"/websocket", nil)
+	require.NoError(t, err)
+	defer dialResp.Body.Close()
+	defer c.Close()
+
+	call := func() types.RPCResponse {
+		req, err := types.MapToRequest(types.JSONRPCStringID("ws"), "subscribe", map[string]any{})
+		require.NoError(t, err)
+		require.NoError(t, c.WriteJSON(req))
+		var resp types.RPCResponse
+		require.NoError(t, c.ReadJSON(&resp))
+		return resp
+	}
+
+	// The calls see the key of the client in the context of the connection.
+	resp := call()
+	require.Nil(t, resp.Error)
+	assert.JSONEq(t, `"ip:127.0.0.1"`, string(resp.Result))
+	resp = call()
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, -32003, resp.Error.Code)
+}
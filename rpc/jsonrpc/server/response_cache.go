@@ -0,0 +1,159 @@
+package server
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// ResponseCache is a thread-safe LRU cache of the results of the calls to the
+// immutable RPC functions (see Immutable and ImmutableAtHeights), keyed by
+// method and arguments. It is bounded by the size of the JSON encoding of the
+// results it holds, which are served without calling the functions nor
+// encoding their results again.
+type ResponseCache struct {
+	mtx      cmtsync.Mutex
+	maxBytes int
+	bytes    int
+	cacheMap map[string]*list.Element
+	list     *list.List
+
+	metrics *Metrics
+}
+
+type responseCacheEntry struct {
+	key    string
+	result json.RawMessage
+}
+
+// NewResponseCache returns a cache holding at most maxBytes bytes of results.
+func NewResponseCache(maxBytes int, metrics *Metrics) *ResponseCache {
+	return &ResponseCache{
+		maxBytes: maxBytes,
+		cacheMap: make(map[string]*list.Element),
+		list:     list.New(),
+		metrics:  metrics,
+	}
+}
+
+type responseCacheKey struct{}
+
+// responseCacheFromContext returns the cache set by ResponseCacheHandler, or
+// nil if caching is disabled.
+func responseCacheFromContext(ctx context.Context) *ResponseCache {
+	c, _ := ctx.Value(responseCacheKey{}).(*ResponseCache)
+	return c
+}
+
+// ResponseCacheHandler wraps an HTTP handler, making the handlers registered
+// by RegisterRPCFuncs and the WebsocketManager serve the immutable results
+// from cache.
+func ResponseCacheHandler(next http.Handler, cache *ResponseCache) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), responseCacheKey{}, cache)))
+	})
+}
+
+// call calls rpcFunc with args and returns its JSON-encoded result. The
+// results of the immutable calls are served from and added to c, unless it is
+// nil.
+func (c *ResponseCache) call(method string, rpcFunc *RPCFunc, args []reflect.Value) (json.RawMessage, error) {
+	if c == nil || !rpcFunc.immutableWithArgs(args) {
+		return callAndMarshal(rpcFunc, args)
+	}
+
+	key, err := cacheKey(method, args)
+	if err != nil {
+		return callAndMarshal(rpcFunc, args)
+	}
+	if result, ok := c.get(key); ok {
+		c.metrics.ResponseCacheHits.With("method", method).Add(1)
+		return result, nil
+	}
+	c.metrics.ResponseCacheMisses.With("method", method).Add(1)
+
+	result, err := callAndMarshal(rpcFunc, args)
+	if err != nil {
+		return nil, err
+	}
+	c.add(key, result)
+	return result, nil
+}
+
+func (c *ResponseCache) get(key string) (json.RawMessage, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	e, ok := c.cacheMap[key]
+	if !ok {
+		return nil, false
+	}
+	c.list.MoveToFront(e)
+	return e.Value.(*responseCacheEntry).result, true
+}
+
+// add adds a result to the cache, evicting the least recently used ones to
+// stay within the size limit. Results larger than the limit are not cached.
+func (c *ResponseCache) add(key string, result json.RawMessage) {
+	size := len(key) + len(result)
+	if size > c.maxBytes {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if _, ok := c.cacheMap[key]; ok {
+		// Added by a concurrent call.
+		return
+	}
+	for c.bytes+size > c.maxBytes {
+		c.remove(c.list.Back())
+	}
+	c.cacheMap[key] = c.list.PushFront(&responseCacheEntry{key: key, result: result})
+	c.bytes += size
+	c.metrics.ResponseCacheSize.Set(float64(c.bytes))
+}
+
+// remove removes an entry. c.mtx must be held.
+func (c *ResponseCache) remove(e *list.Element) {
+	entry := c.list.Remove(e).(*responseCacheEntry)
+	delete(c.cacheMap, entry.key)
+	c.bytes -= len(entry.key) + len(entry.result)
+}
+
+// cacheKey returns the key of a call, made of the method and the JSON
+// encoding of the arguments, so that the calls with the same arguments share
+// a key whether they come from a URI or a JSON-RPC request.
+func cacheKey(method string, args []reflect.Value) (string, error) {
+	var sb strings.Builder
+	sb.WriteString(method)
+	// Skip the context.
+	for _, arg := range args[1:] {
+		bz, err := cmtjson.Marshal(arg.Interface())
+		if err != nil {
+			return "", fmt.Errorf("encoding argument: %w", err)
+		}
+		sb.WriteByte(0)
+		sb.Write(bz)
+	}
+	return sb.String(), nil
+}
+
+// callAndMarshal calls rpcFunc with args and returns its JSON-encoded result.
+func callAndMarshal(rpcFunc *RPCFunc, args []reflect.Value) (json.RawMessage, error) {
+	result, err := unreflectResult(rpcFunc.f.Call(args))
+	if err != nil {
+		return nil, err
+	}
+	bz, err := cmtjson.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling response: %w", err)
+	}
+	return bz, nil
+}
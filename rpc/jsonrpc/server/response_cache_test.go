@@ -0,0 +1,98 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+	types "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+func TestResponseCacheEviction(t *testing.T) {
+	cache := NewResponseCache(10, NopMetrics())
+	cache.add("a", json.RawMessage("1234"))
+	cache.add("b", json.RawMessage("1234"))
+	_, ok := cache.get("a")
+	require.True(t, ok)
+
+	// "b" is the least recently used.
+	cache.add("c", json.RawMessage("1234"))
+	_, ok = cache.get("b")
+	assert.False(t, ok)
+	_, ok = cache.get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 10, cache.bytes)
+
+	// Too large to be cached.
+	cache.add("d", json.RawMessage("1234567890"))
+	_, ok = cache.get("d")
+	assert.False(t, ok)
+}
+
+func TestResponseCacheHandler(t *testing.T) {
+	latest := int64(10)
+	calls := 0
+	block := func(_ *types.Context, height *int64) (int64, error) {
+		calls++
+		if height == nil {
+			return latest, nil
+		}
+		return *height, nil
+	}
+	funcMap := map[string]*RPCFunc{
+		"block": NewRPCFunc(block, "height", Cacheable("height"),
+			ImmutableAtHeights("height", func(h int64) bool { return h < latest })),
+	}
+	mux := http.NewServeMux()
+	RegisterRPCFuncs(mux, funcMap, log.TestingLogger())
+	RegisterRESTRoutes(mux, "/v1", []RESTRoute{{Method: http.MethodGet, Path: "/blocks/{height}", RPC: "block"}},
+		funcMap, log.TestingLogger())
+	s := httptest.NewServer(ResponseCacheHandler(mux, NewResponseCache(1<<20, NopMetrics())))
+	defer s.Close()
+
+	get := func(path string) json.RawMessage {
+		res, err := http.Get(s.URL + path)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		var resp types.RPCResponse
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&resp))
+		require.Nil(t, resp.Error)
+		return resp.Result
+	}
+	post := func(body string) json.RawMessage {
+		res, err := http.Post(s.URL, "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		defer res.Body.Close()
+		var resp types.RPCResponse
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&resp))
+		require.Nil(t, resp.Error)
+		return resp.Result
+	}
+
+	assert.JSONEq(t, `"5"`, string(get("/block?height=5")))
+	assert.JSONEq(t, `"5"`, string(get("/block?height=5")))
+	// JSON-RPC requests share the cache with the URI ones.
+	assert.JSONEq(t, `"5"`, string(post(`{"jsonrpc":"2.0","id":1,"method":"block","params":{"height":"5"}}`)))
+	// And so do the REST ones.
+	res, err := http.Get(s.URL + "/v1/blocks/5")
+	require.NoError(t, err)
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	require.NoError(t, err)
+	assert.JSONEq(t, `"5"`, string(body))
+	assert.Equal(t, 1, calls)
+
+	// The latest height and the default one are not cached.
+	get("/block?height=10")
+	get("/block?height=10")
+	get("/block")
+	get("/block")
+	assert.Equal(t, 5, calls)
+}
@@ -0,0 +1,285 @@
+package server
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/log"
+	types "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+// REST gateway
+
+// RESTRoute maps a resource of the REST gateway to an RPC function. The path
+// parameters (e.g. {height}) and the query parameters are passed to the
+// arguments of the same names, and so are the fields of the JSON object in
+// the body of POST requests. The omitted arguments are set to their zero
+// values.
+type RESTRoute struct {
+	// HTTP method, GET or POST
+	Method string
+	// path of the resource, relative to the prefix of the gateway, e.g.
+	// /blocks/{height}
+	Path string
+	// name of the RPC function called
+	RPC string
+	// summary of the resource, for the OpenAPI spec
+	Summary string
+	// tag grouping the resource with others in the OpenAPI spec
+	Tag string
+}
+
+var rePathParam = regexp.MustCompile(`{([^}]+)}`)
+
+// pathParams returns the names of the parameters of the path of r.
+func (r RESTRoute) pathParams() map[string]bool {
+	params := make(map[string]bool)
+	for _, match := range rePathParam.FindAllStringSubmatch(r.Path, -1) {
+		params[match[1]] = true
+	}
+	return params
+}
+
+// RegisterRESTRoutes adds a handler under prefix (e.g. /v1) for each route
+// whose RPC function is in funcMap, as well as a handler serving the OpenAPI
+// spec of the routes at prefix/openapi.json. Unlike the URI and JSON-RPC
+// handlers, the REST handlers take plain (unquoted) parameters, and return
+// their results, with the same encoding, or an {"error": ...} object, without
+// the JSON-RPC envelope. The immutable results are served from the
+// ResponseCache of the server, shared with the other handlers. It panics if a
+// route is invalid.
+func RegisterRESTRoutes(
+	mux *http.ServeMux,
+	prefix string,
+	routes []RESTRoute,
+	funcMap map[string]*RPCFunc,
+	logger log.Logger,
+) {
+	var registered []RESTRoute
+	for _, route := range routes {
+		rpcFunc, ok := funcMap[route.RPC]
+		if !ok {
+			continue
+		}
+		if err := checkRESTRoute(route, rpcFunc); err != nil {
+			panic(fmt.Sprintf("invalid REST route %s %s: %v", route.Method, route.Path, err))
+		}
+		mux.HandleFunc(route.Method+" "+prefix+route.Path, makeRESTHandler(route, rpcFunc, logger))
+		registered = append(registered, route)
+	}
+
+	spec, err := marshalOpenAPISpec(prefix, registered, funcMap)
+	if err != nil {
+		panic(fmt.Sprintf("failed to encode the OpenAPI spec: %v", err))
+	}
+	mux.HandleFunc("GET "+prefix+"/openapi.json", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(spec); err != nil {
+			logger.Error("failed to write response", "err", err)
+		}
+	})
+}
+
+// checkRESTRoute returns an error if route cannot call rpcFunc.
+func checkRESTRoute(route RESTRoute, rpcFunc *RPCFunc) error {
+	if route.Method != http.MethodGet && route.Method != http.MethodPost {
+		return fmt.Errorf("unsupported method %s", route.Method)
+	}
+	if rpcFunc.ws {
+		return errors.New("websocket only RPC function")
+	}
+	pathParams := route.pathParams()
+	for i, argName := range rpcFunc.argNames {
+		if !pathParams[argName] && route.Method == http.MethodPost {
+			continue
+		}
+		if !isRESTParamType(rpcFunc.args[i+1]) {
+			return fmt.Errorf("unsupported type %v of parameter %s", rpcFunc.args[i+1], argName)
+		}
+		delete(pathParams, argName)
+	}
+	for param := range pathParams {
+		return fmt.Errorf("unknown path parameter %s", param)
+	}
+	return nil
+}
+
+// makeRESTHandler returns the HTTP handler of route, calling rpcFunc.
+func makeRESTHandler(route RESTRoute, rpcFunc *RPCFunc, logger log.Logger) http.HandlerFunc {
+	pathParams := route.pathParams()
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := clientFromContext(r.Context()).authorize(route.RPC); err != nil {
+			writeRESTError(w, http.StatusForbidden, err, logger)
+			return
+		}
+		args, err := restArgs(r, rpcFunc, pathParams)
+		if err != nil {
+			writeRESTError(w, http.StatusBadRequest, err, logger)
+			return
+		}
+		if wait, err := limitedClientFromContext(r.Context()).take(rpcFunc.rateLimitedCall(route.RPC, args)); err != nil {
+			setRetryAfter(w, wait)
+			writeRESTError(w, http.StatusTooManyRequests, err, logger)
+			return
+		}
+
+		bz, err := responseCacheFromContext(r.Context()).call(route.RPC, rpcFunc, args)
+		if err != nil {
+			writeRESTError(w, http.StatusInternalServerError, err, logger)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if rpcFunc.cacheableWithArgs(args) {
+			w.Header().Set("Cache-Control", "public, max-age=86400")
+		}
+		if _, err := w.Write(bz); err != nil {
+			logger.Error("failed to write response", "err", err)
+		}
+	}
+}
+
+// restArgs returns the arguments of rpcFunc, from the parameters of r.
+func restArgs(r *http.Request, rpcFunc *RPCFunc, pathParams map[string]bool) ([]reflect.Value, error) {
+	query := r.URL.Query()
+	var body map[string]json.RawMessage
+	if r.Method == http.MethodPost {
+		bz, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("error reading body: %w", err)
+		}
+		if err := cmtjson.Unmarshal(bz, &body); err != nil {
+			return nil, fmt.Errorf("invalid JSON body: %w", err)
+		}
+	}
+
+	args := make([]reflect.Value, len(rpcFunc.args))
+	args[0] = reflect.ValueOf(&types.Context{HTTPReq: r})
+	for i, argName := range rpcFunc.argNames {
+		ty := rpcFunc.args[i+1]
+		var (
+			arg reflect.Value
+			err error
+		)
+		switch {
+		case pathParams[argName]:
+			arg, err = restParamToArg(ty, r.PathValue(argName))
+		case query.Has(argName):
+			arg, err = restParamToArg(ty, query.Get(argName))
+			query.Del(argName)
+		case body[argName] != nil:
+			arg = reflect.New(ty)
+			err = cmtjson.Unmarshal(body[argName], arg.Interface())
+			arg = arg.Elem()
+			delete(body, argName)
+		default:
+			arg = reflect.Zero(ty)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid parameter %s: %w", argName, err)
+		}
+		args[i+1] = arg
+	}
+
+	for param := range query {
+		return nil, fmt.Errorf("unknown parameter %s", param)
+	}
+	for param := range body {
+		return nil, fmt.Errorf("unknown parameter %s", param)
+	}
+	return args, nil
+}
+
+// isRESTParamType reports whether the path and query parameters can be
+// converted to ty by restParamToArg.
+func isRESTParamType(ty reflect.Type) bool {
+	if ty.Kind() == reflect.Ptr {
+		ty = ty.Elem()
+	}
+	switch ty.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	case reflect.Slice:
+		return ty.Elem().Kind() == reflect.Uint8
+	default:
+		return false
+	}
+}
+
+// restParamToArg converts a path or query parameter to an argument of type
+// ty. Byte slices are hex encoded, with an optional 0x prefix.
+func restParamToArg(ty reflect.Type, param string) (reflect.Value, error) {
+	if ty.Kind() == reflect.Ptr {
+		arg, err := restParamToArg(ty.Elem(), param)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		ptr := reflect.New(ty.Elem())
+		ptr.Elem().Set(arg)
+		return ptr, nil
+	}
+
+	arg := reflect.New(ty).Elem()
+	switch ty.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(param)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		arg.SetBool(b)
+	case reflect.String:
+		arg.SetString(param)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(param, 10, ty.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		arg.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(param, 10, ty.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		arg.SetUint(u)
+	case reflect.Slice:
+		b, err := hex.DecodeString(strings.TrimPrefix(param, "0x"))
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		arg.SetBytes(b)
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported type %v", ty)
+	}
+	return arg, nil
+}
+
+// writeRESTError writes err as an {"error": ...} object with the given HTTP
+// status code.
+func writeRESTError(w http.ResponseWriter, httpCode int, err error, logger log.Logger) {
+	bz, mErr := cmtjson.Marshal(restError{Error: err.Error()})
+	if mErr != nil {
+		panic(mErr)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpCode)
+	if _, wErr := w.Write(bz); wErr != nil {
+		logger.Error("failed to write response", "err", wErr)
+	}
+}
+
+// restError is the body of the error responses of the REST handlers.
+type restError struct {
+	Error string `json:"error"`
+}
@@ -0,0 +1,377 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// OpenAPI spec of the REST gateway
+
+const openAPIVersion = "3.0.3"
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	rawMessageType    = reflect.TypeOf(json.RawMessage{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+	reNonAlphanumeric = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+)
+
+// marshalOpenAPISpec returns the JSON encoding of the OpenAPI spec of routes,
+// served under prefix. Unlike the results of the routes, the spec is a
+// document of its own, encoded with the standard JSON encoding.
+func marshalOpenAPISpec(prefix string, routes []RESTRoute, funcMap map[string]*RPCFunc) ([]byte, error) {
+	return json.Marshal(newOpenAPISpec(prefix, routes, funcMap))
+}
+
+// newOpenAPISpec returns the OpenAPI spec of routes, served under prefix.
+// The schemas of the parameters and of the results are derived from the types
+// of the arguments and of the results of the RPC functions of funcMap,
+// following the rules of the JSON encoding of the RPC (libs/json).
+func newOpenAPISpec(prefix string, routes []RESTRoute, funcMap map[string]*RPCFunc) map[string]any {
+	schemas := openAPISchemas{
+		"Error": map[string]any{
+			"type":       "object",
+			"properties": map[string]any{"error": map[string]any{"type": "string"}},
+			"required":   []string{"error"},
+		},
+	}
+	errorResponse := func(description string) map[string]any {
+		return map[string]any{
+			"description": description,
+			"content": map[string]any{
+				"application/json": map[string]any{"schema": map[string]any{"$ref": "#/components/schemas/Error"}},
+			},
+		}
+	}
+
+	paths := make(map[string]map[string]any)
+	for _, route := range routes {
+		rpcFunc := funcMap[route.RPC]
+		pathParams := route.pathParams()
+
+		parameters := []map[string]any{}
+		bodyProperties := make(map[string]any)
+		for i, argName := range rpcFunc.argNames {
+			ty := rpcFunc.args[i+1]
+			switch {
+			case pathParams[argName]:
+				parameters = append(parameters, map[string]any{
+					"name": argName, "in": "path", "required": true, "schema": paramSchema(ty),
+				})
+			case route.Method == http.MethodPost:
+				bodyProperties[argName] = schemas.typeSchema(ty)
+			default:
+				parameters = append(parameters, map[string]any{
+					"name": argName, "in": "query", "schema": paramSchema(ty),
+				})
+			}
+		}
+
+		operation := map[string]any{
+			"operationId": strings.ToLower(route.Method) + strings.TrimRight(reNonAlphanumeric.ReplaceAllString(route.Path, "_"), "_"),
+			"summary":     route.Summary,
+			"description": "Calls the " + route.RPC + " RPC method.",
+			"parameters":  parameters,
+			"responses": map[string]any{
+				"200": map[string]any{
+					"description": "OK",
+					"content": map[string]any{
+						"application/json": map[string]any{"schema": schemas.typeSchema(rpcFunc.returns[0])},
+					},
+				},
+				"400": errorResponse("Invalid parameters"),
+				"403": errorResponse("Method not allowed for the client"),
+				"429": errorResponse("Too many requests"),
+				"500": errorResponse("Error"),
+			},
+		}
+		if route.Tag != "" {
+			operation["tags"] = []string{route.Tag}
+		}
+		if route.Method == http.MethodPost {
+			operation["requestBody"] = map[string]any{
+				"required": true,
+				"content": map[string]any{
+					"application/json": map[string]any{
+						"schema": map[string]any{"type": "object", "properties": bodyProperties},
+					},
+				},
+			}
+		}
+
+		path := prefix + route.Path
+		if paths[path] == nil {
+			paths[path] = make(map[string]any)
+		}
+		paths[path][strings.ToLower(route.Method)] = operation
+	}
+
+	return map[string]any{
+		"openapi": openAPIVersion,
+		"info": map[string]any{
+			"title":   "CometBFT REST API",
+			"version": strings.TrimPrefix(prefix, "/"),
+		},
+		"paths":      paths,
+		"components": map[string]any{"schemas": schemas},
+	}
+}
+
+// paramSchema returns the schema of the path and query parameters converted
+// to ty by restParamToArg.
+func paramSchema(ty reflect.Type) map[string]any {
+	if ty.Kind() == reflect.Ptr {
+		ty = ty.Elem()
+	}
+	switch ty.Kind() {
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice:
+		return map[string]any{"type": "string", "pattern": "^(0x)?([0-9a-fA-F]{2})*$"}
+	default:
+		return integerSchema(ty)
+	}
+}
+
+// integerSchema returns the schema of an integer of type ty.
+func integerSchema(ty reflect.Type) map[string]any {
+	schema := map[string]any{"type": "integer"}
+	switch ty.Kind() {
+	case reflect.Int64:
+		schema["format"] = "int64"
+	case reflect.Int32:
+		schema["format"] = "int32"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		schema["minimum"] = 0
+	}
+	return schema
+}
+
+// nullable returns schema, allowing null values.
+func nullable(schema map[string]any) map[string]any {
+	if _, ok := schema["$ref"]; ok {
+		return map[string]any{"allOf": []any{schema}, "nullable": true}
+	}
+	if len(schema) == 0 {
+		// Any value, including null.
+		return schema
+	}
+	s := make(map[string]any, len(schema)+1)
+	for k, v := range schema {
+		s[k] = v
+	}
+	s["nullable"] = true
+	return s
+}
+
+// openAPISchemas are the named schemas of a spec, the components referenced
+// by the other schemas.
+type openAPISchemas map[string]any
+
+// typeSchema returns the schema of the JSON encoding of ty, in which the
+// 64-bit integers are strings. The named structs are added to s and
+// referenced.
+func (s openAPISchemas) typeSchema(ty reflect.Type) map[string]any {
+	switch {
+	case ty == timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case ty.Kind() != reflect.Ptr && ty.Kind() != reflect.Interface &&
+		(ty.Implements(jsonMarshalerType) || reflect.PointerTo(ty).Implements(jsonMarshalerType)):
+		return s.marshalerSchema(ty)
+	}
+
+	switch ty.Kind() {
+	case reflect.Ptr:
+		return nullable(s.typeSchema(ty.Elem()))
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		return map[string]any{"type": "string", "format": "int64"}
+	case reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return integerSchema(ty)
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Slice:
+		if ty.Elem().Kind() == reflect.Uint8 {
+			return map[string]any{"type": "string", "format": "byte", "nullable": true}
+		}
+		return map[string]any{"type": "array", "items": s.typeSchema(ty.Elem()), "nullable": true}
+	case reflect.Array:
+		return map[string]any{"type": "array", "items": s.typeSchema(ty.Elem())}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": s.typeSchema(ty.Elem()), "nullable": true}
+	case reflect.Struct:
+		if ty.Name() == "" {
+			return s.structSchema(ty)
+		}
+		return s.ref(ty, s.structSchema)
+	default:
+		// Interfaces are encoded as their dynamic values.
+		return map[string]any{}
+	}
+}
+
+// ref adds the schema of the named type ty, built by schema, to s if it is
+// not there yet, and returns a reference to it.
+func (s openAPISchemas) ref(ty reflect.Type, schema func(reflect.Type) map[string]any) map[string]any {
+	name := strings.ReplaceAll(strings.TrimPrefix(ty.PkgPath(), "github.com/cometbft/cometbft/"), "/", ".") + "." + ty.Name()
+	if _, ok := s[name]; !ok {
+		s[name] = nil // for recursive types
+		s[name] = schema(ty)
+	}
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
+
+// structSchema returns the schema of the JSON encoding of the struct ty.
+func (s openAPISchemas) structSchema(ty reflect.Type) map[string]any {
+	properties := make(map[string]any)
+	s.addFieldSchemas(ty, properties)
+	return map[string]any{"type": "object", "properties": properties}
+}
+
+// addFieldSchemas adds the schemas of the fields of the struct ty to
+// properties. The fields of the embedded structs are added last, as they are
+// shadowed by the fields of ty.
+func (s openAPISchemas) addFieldSchemas(ty reflect.Type, properties map[string]any) {
+	var embedded []reflect.Type
+	for i := 0; i < ty.NumField(); i++ {
+		field := ty.Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" && opts == "" {
+			continue
+		}
+		if field.Anonymous && name == "" {
+			fieldTy := field.Type
+			if fieldTy.Kind() == reflect.Ptr {
+				fieldTy = fieldTy.Elem()
+			}
+			if fieldTy.Kind() == reflect.Struct {
+				embedded = append(embedded, fieldTy)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		schema := s.typeSchema(field.Type)
+		if strings.Contains(opts, "string") {
+			switch field.Type.Kind() {
+			case reflect.Bool, reflect.Float32, reflect.Float64,
+				reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+				reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+				schema = map[string]any{"type": "string"}
+			}
+		}
+		properties[name] = schema
+	}
+
+	for _, ty := range embedded {
+		fields := make(map[string]any)
+		s.addFieldSchemas(ty, fields)
+		for name, schema := range fields {
+			if _, ok := properties[name]; !ok {
+				properties[name] = schema
+			}
+		}
+	}
+}
+
+// marshalerSchema returns the schema of the JSON encoding of ty, which
+// implements json.Marshaler.
+func (s openAPISchemas) marshalerSchema(ty reflect.Type) map[string]any {
+	switch {
+	case ty == rawMessageType:
+		return map[string]any{}
+	case ty.Kind() == reflect.Slice && ty.Elem().Kind() == reflect.Uint8:
+		// Such as HexBytes.
+		return map[string]any{"type": "string"}
+	case ty.Kind() == reflect.Struct && isProtoMessage(ty):
+		// The ABCI types are encoded with jsonpb.
+		return s.ref(ty, protoSchema)
+	default:
+		return map[string]any{}
+	}
+}
+
+// isProtoMessage reports whether ty is a protobuf message.
+func isProtoMessage(ty reflect.Type) bool {
+	_, ok := reflect.PointerTo(ty).MethodByName("ProtoMessage")
+	return ok
+}
+
+// protoSchema returns the schema of the jsonpb encoding of the protobuf
+// message ty, with the default values emitted and the enums encoded as
+// integers.
+func protoSchema(ty reflect.Type) map[string]any {
+	properties := make(map[string]any)
+	for i := 0; i < ty.NumField(); i++ {
+		field := ty.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			// Such as a result embedding a message, which is encoded as the
+			// message, with its promoted MarshalJSON method.
+			for name, schema := range protoSchema(field.Type)["properties"].(map[string]any) {
+				properties[name] = schema
+			}
+			continue
+		}
+		tag := field.Tag.Get("protobuf")
+		if tag == "" {
+			continue
+		}
+		var name, jsonName string
+		for _, opt := range strings.Split(tag, ",") {
+			if n, ok := strings.CutPrefix(opt, "name="); ok {
+				name = n
+			} else if n, ok := strings.CutPrefix(opt, "json="); ok {
+				jsonName = n
+			}
+		}
+		if jsonName != "" {
+			name = jsonName
+		}
+		properties[name] = protoFieldSchema(field.Type)
+	}
+	return map[string]any{"type": "object", "properties": properties}
+}
+
+// protoFieldSchema returns the schema of the jsonpb encoding of a field of
+// type ty of a protobuf message.
+func protoFieldSchema(ty reflect.Type) map[string]any {
+	switch ty.Kind() {
+	case reflect.Ptr:
+		return nullable(protoFieldSchema(ty.Elem()))
+	case reflect.Int64, reflect.Uint64:
+		return map[string]any{"type": "string", "format": "int64"}
+	case reflect.Slice:
+		if ty.Elem().Kind() == reflect.Uint8 {
+			return map[string]any{"type": "string", "format": "byte", "nullable": true}
+		}
+		return map[string]any{"type": "array", "items": protoFieldSchema(ty.Elem()), "nullable": true}
+	case reflect.Map:
+		return map[string]any{"type": "object", "additionalProperties": protoFieldSchema(ty.Elem()), "nullable": true}
+	case reflect.Struct:
+		if ty == timeType {
+			return map[string]any{"type": "string", "format": "date-time"}
+		}
+		return protoSchema(ty)
+	case reflect.Interface:
+		// oneof fields
+		return map[string]any{}
+	default:
+		return (openAPISchemas{}).typeSchema(ty)
+	}
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/libs/log"
+	types "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+type restTestResult struct {
+	Height int64          `json:"height"`
+	Hash   bytes.HexBytes `json:"hash"`
+	Data   []byte         `json:"data"`
+	Prove  bool           `json:"prove"`
+	Items  []string       `json:"items,omitempty"`
+}
+
+func newRESTServer() *httptest.Server {
+	funcMap := map[string]*RPCFunc{
+		"item": NewRPCFunc(func(_ *types.Context, height *int64, hash []byte, prove bool) (*restTestResult, error) {
+			if height != nil && *height < 0 {
+				return nil, errors.New("negative height")
+			}
+			res := &restTestResult{Hash: hash, Prove: prove}
+			if height != nil {
+				res.Height = *height
+			}
+			return res, nil
+		}, "height,hash,prove", Cacheable("height")),
+		"put": NewRPCFunc(func(_ *types.Context, data []byte, items []string) (*restTestResult, error) {
+			return &restTestResult{Data: data, Items: items}, nil
+		}, "data,items"),
+		"subscribe": NewWSRPCFunc(func(*types.Context) (*restTestResult, error) { return nil, nil }, ""),
+	}
+	routes := []RESTRoute{
+		{Method: http.MethodGet, Path: "/items/{height}", RPC: "item", Summary: "Item at a height"},
+		{Method: http.MethodPost, Path: "/items", RPC: "put", Summary: "Put an item"},
+		{Method: http.MethodGet, Path: "/unknown", RPC: "unknown"},
+	}
+
+	mux := http.NewServeMux()
+	RegisterRESTRoutes(mux, "/v1", routes, funcMap, log.TestingLogger())
+	return httptest.NewServer(mux)
+}
+
+func TestRESTHandler(t *testing.T) {
+	s := newRESTServer()
+	defer s.Close()
+
+	testCases := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		wantCode int
+		wantBody string
+	}{
+		{"path and query", "GET", "/v1/items/5?hash=0xabcd&prove=true", "", 200,
+			`{"height":"5","hash":"ABCD","data":null,"prove":true}`},
+		{"defaults", "GET", "/v1/items/5", "", 200,
+			`{"height":"5","hash":"","data":null,"prove":false}`},
+		{"invalid path param", "GET", "/v1/items/a", "", 400,
+			`{"error":"invalid parameter height: strconv.ParseInt: parsing \"a\": invalid syntax"}`},
+		{"invalid query param", "GET", "/v1/items/5?hash=xyz", "", 400,
+			`{"error":"invalid parameter hash: encoding/hex: invalid byte: U+0078 'x'"}`},
+		{"unknown param", "GET", "/v1/items/5?foo=1", "", 400,
+			`{"error":"unknown parameter foo"}`},
+		{"error", "GET", "/v1/items/-1", "", 500,
+			`{"error":"negative height"}`},
+		{"body", "POST", "/v1/items", `{"data":"AQI=","items":["a","b"]}`, 200,
+			`{"height":"0","hash":"","data":"AQI=","prove":false,"items":["a","b"]}`},
+		{"invalid body", "POST", "/v1/items", `{"data":1}`, 400,
+			`{"error":"invalid parameter data: json: cannot unmarshal number into Go value of type []uint8"}`},
+		{"unknown body param", "POST", "/v1/items", `{"foo":1}`, 400,
+			`{"error":"unknown parameter foo"}`},
+		{"wrong method", "DELETE", "/v1/items", "", 405, ""},
+		{"unknown route", "GET", "/v1/unknown", "", 404, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(tc.method, s.URL+tc.path, strings.NewReader(tc.body))
+			require.NoError(t, err)
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.wantCode, resp.StatusCode)
+			if tc.wantBody != "" {
+				assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+				assert.JSONEq(t, tc.wantBody, string(body))
+			}
+		})
+	}
+}
+
+func TestRESTOpenAPISpec(t *testing.T) {
+	s := newRESTServer()
+	defer s.Close()
+
+	resp, err := http.Get(s.URL + "/v1/openapi.json")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var spec struct {
+		OpenAPI    string                               `json:"openapi"`
+		Paths      map[string]map[string]map[string]any `json:"paths"`
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&spec))
+	assert.Equal(t, openAPIVersion, spec.OpenAPI)
+
+	// The routes of unknown RPC functions are skipped.
+	require.Len(t, spec.Paths, 2)
+	get := spec.Paths["/v1/items/{height}"]["get"]
+	require.NotNil(t, get)
+	assert.Equal(t, "get_items_height", get["operationId"])
+	params, err := json.Marshal(get["parameters"])
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"name":"height","in":"path","required":true,"schema":{"type":"integer","format":"int64"}},
+		{"name":"hash","in":"query","schema":{"type":"string","pattern":"^(0x)?([0-9a-fA-F]{2})*$"}},
+		{"name":"prove","in":"query","schema":{"type":"boolean"}}
+	]`, string(params))
+
+	post := spec.Paths["/v1/items"]["post"]
+	require.NotNil(t, post)
+	body, err := json.Marshal(post["requestBody"])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"required":true,"content":{"application/json":{"schema":{"type":"object","properties":{
+		"data":{"type":"string","format":"byte","nullable":true},
+		"items":{"type":"array","items":{"type":"string"},"nullable":true}
+	}}}}}`, string(body))
+
+	assert.JSONEq(t, `{"type":"object","properties":{
+		"height":{"type":"string","format":"int64"},
+		"hash":{"type":"string"},
+		"data":{"type":"string","format":"byte","nullable":true},
+		"prove":{"type":"boolean"},
+		"items":{"type":"array","items":{"type":"string"},"nullable":true}
+	}}`, string(spec.Components.Schemas["rpc.jsonrpc.server.restTestResult"]))
+}
+
+func TestRegisterRESTRoutesInvalid(t *testing.T) {
+	funcMap := map[string]*RPCFunc{
+		"f": NewRPCFunc(func(*types.Context, []string) (*restTestResult, error) { return nil, nil }, "items"),
+		"g": NewRPCFunc(func(*types.Context, int64) (*restTestResult, error) { return nil, nil }, "height"),
+	}
+	for _, route := range []RESTRoute{
+		{Method: http.MethodGet, Path: "/f", RPC: "f"},
+		{Method: http.MethodGet, Path: "/g/{hash}", RPC: "g"},
+		{Method: http.MethodPut, Path: "/g", RPC: "g"},
+	} {
+		assert.Panics(t, func() {
+			RegisterRESTRoutes(http.NewServeMux(), "/v1", []RESTRoute{route}, funcMap, log.TestingLogger())
+		}, route.Path)
+	}
+}
@@ -1,6 +1,7 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -16,7 +17,7 @@ import (
 func RegisterRPCFuncs(mux *http.ServeMux, funcMap map[string]*RPCFunc, logger log.Logger) {
 	// HTTP endpoints
 	for funcName, rpcFunc := range funcMap {
-		mux.HandleFunc("/"+funcName, makeHTTPHandler(rpcFunc, logger))
+		mux.HandleFunc("/"+funcName, makeHTTPHandler(funcName, rpcFunc, logger))
 	}
 
 	// JSONRPC endpoints
@@ -41,6 +42,63 @@ func Cacheable(noCacheDefArgs ...string) Option {
 	}
 }
 
+// Immutable marks the results of the RPC function to which it is applied as
+// immutable, so that they are kept in the ResponseCache of the server.
+func Immutable() Option {
+	return func(r *RPCFunc) {
+		r.immutable = func([]reflect.Value) bool { return true }
+	}
+}
+
+// ImmutableAtHeights marks the results of the RPC function to which it is
+// applied as immutable when its heightArg argument, an int64 or *int64, is set
+// to a height for which immutable returns true, so that they are kept in the
+// ResponseCache of the server.
+func ImmutableAtHeights(heightArg string, immutable func(height int64) bool) Option {
+	return func(r *RPCFunc) {
+		i := -1
+		for j, argName := range r.argNames {
+			if argName == heightArg {
+				i = j + 1 // skip the context
+			}
+		}
+		if i < 0 {
+			panic(fmt.Sprintf("unknown height argument %q", heightArg))
+		}
+		r.immutable = func(args []reflect.Value) bool {
+			if i >= len(args) {
+				return false
+			}
+			switch height := args[i].Interface().(type) {
+			case int64:
+				return immutable(height)
+			case *int64:
+				return height != nil && immutable(*height)
+			default:
+				return false
+			}
+		}
+	}
+}
+
+// CostPerItem makes the rate limits charge the cost of the RPC function to
+// which it is applied once per item of its itemsArg argument, a slice, e.g.
+// once per tx of a batch of txs.
+func CostPerItem(itemsArg string) Option {
+	return func(r *RPCFunc) {
+		r.itemsArg = -1
+		for j, argName := range r.argNames {
+			if argName == itemsArg {
+				r.itemsArg = j
+			}
+		}
+		if r.itemsArg < 0 {
+			panic(fmt.Sprintf("unknown items argument %q", itemsArg))
+		}
+		r.costPerItem = true
+	}
+}
+
 // Ws enables WebSocket communication.
 func Ws() Option {
 	return func(r *RPCFunc) {
@@ -57,6 +115,13 @@ type RPCFunc struct {
 	cacheable      bool           // enable cache control
 	ws             bool           // enable websocket communication
 	noCacheDefArgs map[string]any // a lookup table of args that, if not supplied or are set to default values, cause us to not cache
+	// reports whether the result of a call with the given args is immutable,
+	// nil if it never is
+	immutable func(args []reflect.Value) bool
+	// whether the calls are charged per item of the argument at index
+	// itemsArg of argNames
+	costPerItem bool
+	itemsArg    int
 }
 
 // NewRPCFunc wraps a function for introspection.
@@ -95,6 +160,49 @@ func (f *RPCFunc) cacheableWithArgs(args []reflect.Value) bool {
 	return true
 }
 
+// immutableWithArgs returns whether or not the result of a call to this
+// function is immutable, given the specified arguments.
+func (f *RPCFunc) immutableWithArgs(args []reflect.Value) bool {
+	return f.immutable != nil && f.immutable(args)
+}
+
+// rateLimitedCall returns the call to this function named method, with the
+// given arguments, to charge to a client.
+func (f *RPCFunc) rateLimitedCall(method string, args []reflect.Value) rateLimitedCall {
+	call := rateLimitedCall{method: method}
+	// Skip the context variable common to all RPC functions
+	if i := f.itemsArg + 1; f.costPerItem && i < len(args) && args[i].Kind() == reflect.Slice {
+		call.items = args[i].Len()
+	}
+	return call
+}
+
+// rateLimitedJSONRPCCall returns the call to this function named method, with
+// the given JSON-RPC params, by name or by position, to charge to a client.
+// Unlike rateLimitedCall, it does not need the params to be valid arguments,
+// so that batches may be charged before they are served.
+func (f *RPCFunc) rateLimitedJSONRPCCall(method string, params json.RawMessage) rateLimitedCall {
+	call := rateLimitedCall{method: method}
+	if !f.costPerItem || len(params) == 0 {
+		return call
+	}
+	var items json.RawMessage
+	var named map[string]json.RawMessage
+	if err := json.Unmarshal(params, &named); err == nil {
+		items = named[f.argNames[f.itemsArg]]
+	} else {
+		var positional []json.RawMessage
+		if err := json.Unmarshal(params, &positional); err == nil && f.itemsArg < len(positional) {
+			items = positional[f.itemsArg]
+		}
+	}
+	var list []json.RawMessage
+	if err := json.Unmarshal(items, &list); err == nil {
+		call.items = len(list)
+	}
+	return call
+}
+
 func newRPCFunc(f any, args string, options ...Option) *RPCFunc {
 	var argNames []string
 	if args != "" {
@@ -0,0 +1,368 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	types "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+// Server-Sent Events handler
+
+const (
+	// eventStreamMethod is the websocket RPC function called to subscribe the
+	// event streams.
+	eventStreamMethod = "subscribe"
+
+	defaultEventStreamHeartbeatPeriod   = 15 * time.Second
+	defaultEventStreamWriteChanCapacity = defaultWSWriteChanCapacity
+)
+
+// EventStreamManager provides a Server-Sent Events (SSE) handler, for the
+// clients which cannot keep a websocket open. Each request subscribes to the
+// events matching the query in its URL parameters, by calling the "subscribe"
+// websocket RPC function of funcMap, and streams them until the client
+// disconnects.
+// NOTE: The event stream path is defined externally, e.g. in node/node.go
+type EventStreamManager struct {
+	funcMap map[string]*RPCFunc
+	logger  log.Logger
+
+	// a comment is sent to the clients with this period, to keep the
+	// connections alive
+	heartbeatPeriod time.Duration
+	// capacity of the channel of events of each stream
+	writeChanCapacity int
+	// callback which is called when a client disconnects
+	onDisconnect func(remoteAddr string)
+	// maximum number of streams open by a client at once, 0 if unlimited
+	maxStreamsPerClient int
+
+	// number of streams opened, to identify them
+	streams atomic.Uint64
+
+	mtx sync.Mutex
+	// number of streams open by each client, by clientStreamsKey
+	clientStreams map[string]int
+}
+
+// NewEventStreamManager returns a new EventStreamManager subscribing the
+// clients with the "subscribe" function of funcMap.
+func NewEventStreamManager(
+	funcMap map[string]*RPCFunc,
+	options ...func(*EventStreamManager),
+) *EventStreamManager {
+	em := &EventStreamManager{
+		funcMap:           funcMap,
+		logger:            log.NewNopLogger(),
+		heartbeatPeriod:   defaultEventStreamHeartbeatPeriod,
+		writeChanCapacity: defaultEventStreamWriteChanCapacity,
+		clientStreams:     make(map[string]int),
+	}
+	for _, option := range options {
+		option(em)
+	}
+	return em
+}
+
+// EventStreamOnDisconnect sets a callback which is called with the remote
+// address of a stream when its client disconnects, to unsubscribe it. Nop by
+// default.
+func EventStreamOnDisconnect(onDisconnect func(remoteAddr string)) func(*EventStreamManager) {
+	return func(em *EventStreamManager) {
+		em.onDisconnect = onDisconnect
+	}
+}
+
+// EventStreamHeartbeatPeriod sets the period of the heartbeat comments sent
+// to keep the streams alive.
+func EventStreamHeartbeatPeriod(period time.Duration) func(*EventStreamManager) {
+	return func(em *EventStreamManager) {
+		em.heartbeatPeriod = period
+	}
+}
+
+// EventStreamWriteChanCapacity sets the number of events buffered for each
+// stream.
+func EventStreamWriteChanCapacity(cap int) func(*EventStreamManager) {
+	return func(em *EventStreamManager) {
+		em.writeChanCapacity = cap
+	}
+}
+
+// EventStreamMaxStreamsPerClient sets the maximum number of streams a client,
+// identified by its API key if it is authenticated and by its IP address
+// otherwise, may have open at once. Since every stream has its own
+// subscriber, this is the limit of the subscriptions of a client over the
+// streams. Unlimited (0) by default.
+func EventStreamMaxStreamsPerClient(maxStreams int) func(*EventStreamManager) {
+	return func(em *EventStreamManager) {
+		em.maxStreamsPerClient = maxStreams
+	}
+}
+
+// SetLogger sets the logger.
+func (em *EventStreamManager) SetLogger(l log.Logger) {
+	em.logger = l
+}
+
+// EventStreamHandler subscribes the client to the events matching the URL
+// parameters of the request, which are those of the "subscribe" function
+// (e.g. /events?query=tm.event='NewBlock'), and streams them as SSE messages
+// whose data is the JSON encoding of the results. A comment is sent
+// periodically to keep the stream alive. If the subscription is canceled, or
+// the credentials of the client expire, an "error" message is sent and the
+// stream is closed.
+func (em *EventStreamManager) EventStreamHandler(w http.ResponseWriter, r *http.Request) {
+	dummyID := types.JSONRPCIntID(-1) // URIClientRequestID
+
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	rpcFunc := em.funcMap[eventStreamMethod]
+	if rpcFunc == nil {
+		if wErr := WriteRPCResponseHTTPError(w, http.StatusNotFound, types.RPCMethodNotFoundError(dummyID)); wErr != nil {
+			em.logger.Error("failed to write response", "err", wErr)
+		}
+		return
+	}
+	if err := clientFromContext(r.Context()).authorize(eventStreamMethod); err != nil {
+		if wErr := WriteRPCResponseHTTPError(w, http.StatusForbidden, types.RPCForbiddenError(dummyID, err)); wErr != nil {
+			em.logger.Error("failed to write response", "err", wErr)
+		}
+		return
+	}
+	if wait, err := limitedClientFromContext(r.Context()).take(rateLimitedCall{method: eventStreamMethod}); err != nil {
+		writeTooManyRequests(w, types.RPCTooManyRequestsError(dummyID, err), wait, em.logger)
+		return
+	}
+
+	// The parameters are passed as they would be in a JSON request.
+	params := make(map[string]string)
+	for name, values := range r.URL.Query() {
+		params[name] = values[0]
+	}
+	rawParams, err := json.Marshal(params)
+	if err != nil {
+		em.logger.Error("Failed to marshal the parameters of an event stream", "err", err)
+		if wErr := WriteRPCResponseHTTPError(w, http.StatusInternalServerError, types.RPCInternalError(dummyID, err)); wErr != nil {
+			em.logger.Error("failed to write response", "err", wErr)
+		}
+		return
+	}
+	fnArgs, err := jsonParamsToArgs(rpcFunc, rawParams)
+	if err != nil {
+		res := types.RPCInvalidParamsError(dummyID,
+			fmt.Errorf("error converting http params to arguments: %w", err),
+		)
+		if wErr := WriteRPCResponseHTTPError(w, http.StatusBadRequest, res); wErr != nil {
+			em.logger.Error("failed to write response", "err", wErr)
+		}
+		return
+	}
+
+	clientKey := clientStreamsKey(r)
+	if !em.openStream(clientKey) {
+		err := fmt.Errorf("max streams per client %d reached", em.maxStreamsPerClient)
+		writeTooManyRequests(w, types.RPCTooManyRequestsError(dummyID, err), 0, em.logger)
+		return
+	}
+	defer em.closeStream(clientKey)
+
+	// The streams are identified by a sequence number, since the requests of
+	// a client may share a connection: the subscriptions of a stream are
+	// unsubscribed by this identifier, while the streams of a client are
+	// limited by clientKey. A stream is closed when the credentials of its
+	// client expire.
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+	if exp := clientFromContext(r.Context()).expiresAt(); !exp.IsZero() {
+		ctx, cancel = context.WithDeadlineCause(r.Context(), exp, errCredentialsExpired)
+	} else {
+		ctx, cancel = context.WithCancel(r.Context())
+	}
+	defer cancel()
+	conn := &eventStreamConnection{
+		remoteAddr: r.RemoteAddr + "/events/" + strconv.FormatUint(em.streams.Add(1), 10),
+		writeChan:  make(chan types.RPCResponse, em.writeChanCapacity),
+		ctx:        ctx,
+	}
+	defer func() {
+		if em.onDisconnect != nil {
+			em.onDisconnect(conn.remoteAddr)
+		}
+	}()
+
+	req := &types.RPCRequest{JSONRPC: "2.0", ID: dummyID, Method: eventStreamMethod, Params: rawParams}
+	args := append([]reflect.Value{reflect.ValueOf(&types.Context{JSONReq: req, WSConn: conn})}, fnArgs...)
+	if _, err := callAndMarshal(rpcFunc, args); err != nil {
+		if wErr := WriteRPCResponseHTTPError(w, http.StatusInternalServerError, types.RPCInternalError(dummyID, err)); wErr != nil {
+			em.logger.Error("failed to write response", "err", wErr)
+		}
+		return
+	}
+
+	// The stream is not bound by the write timeout of the server.
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		em.logger.Error("Failed to clear the write deadline of an event stream", "err", err)
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	em.logger.Info("New event stream", "remote", conn.remoteAddr)
+	if err := em.writeEvents(w, rc, conn); err != nil {
+		em.logger.Info("Event stream closed", "remote", conn.remoteAddr, "err", err)
+	}
+}
+
+// clientStreamsKey returns the key of the client of r in the limit of the
+// streams per client: its API key if it is authenticated, its IP address
+// otherwise.
+func clientStreamsKey(r *http.Request) string {
+	if c := clientFromContext(r.Context()); c != nil && c.creds != nil {
+		return "key:" + c.creds.ID
+	}
+	return "ip:" + remoteHost(r)
+}
+
+// openStream counts a new stream of the client with the given key, returning
+// false if the client already has the maximum number of streams open.
+func (em *EventStreamManager) openStream(key string) bool {
+	em.mtx.Lock()
+	defer em.mtx.Unlock()
+	if em.maxStreamsPerClient > 0 && em.clientStreams[key] >= em.maxStreamsPerClient {
+		return false
+	}
+	em.clientStreams[key]++
+	return true
+}
+
+// closeStream uncounts a stream of the client with the given key.
+func (em *EventStreamManager) closeStream(key string) {
+	em.mtx.Lock()
+	defer em.mtx.Unlock()
+	if em.clientStreams[key]--; em.clientStreams[key] <= 0 {
+		delete(em.clientStreams, key)
+	}
+}
+
+// writeEvents writes the responses pushed to conn to w as SSE messages, and
+// heartbeat comments, until the request is done or an error response is
+// written.
+func (em *EventStreamManager) writeEvents(w http.ResponseWriter, rc *http.ResponseController, conn *eventStreamConnection) error {
+	heartbeat := time.NewTicker(em.heartbeatPeriod)
+	defer heartbeat.Stop()
+
+	// The headers are sent right away.
+	if err := rc.Flush(); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-conn.ctx.Done():
+			if cause := context.Cause(conn.ctx); errors.Is(cause, errCredentialsExpired) {
+				return writeErrorEvent(w, rc, types.RPCUnauthorizedError(types.JSONRPCIntID(-1), cause).Error)
+			}
+			return conn.ctx.Err()
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return err
+			}
+		case resp := <-conn.writeChan:
+			if resp.Error != nil {
+				return writeErrorEvent(w, rc, resp.Error)
+			}
+			// The results are encoded on a single line, as the data of SSE
+			// messages cannot contain newlines.
+			if _, err := fmt.Fprintf(w, "data: %s\n\n", resp.Result); err != nil {
+				return err
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return err
+		}
+	}
+}
+
+// writeErrorEvent writes rpcErr to w as an "error" SSE message, and returns
+// it.
+func writeErrorEvent(w http.ResponseWriter, rc *http.ResponseController, rpcErr *types.RPCError) error {
+	data, err := json.Marshal(rpcErr)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "event: error\ndata: %s\n\n", data); err != nil {
+		return err
+	}
+	if err := rc.Flush(); err != nil {
+		return err
+	}
+	return rpcErr
+}
+
+// eventStreamConnection is the connection of an event stream passed to the
+// "subscribe" function. It implements WSRPCConnection.
+type eventStreamConnection struct {
+	remoteAddr string
+	// writeChan is never closed, to allow WriteRPCResponse() to fail.
+	writeChan chan types.RPCResponse
+	// ctx is canceled when the stream is closed
+	ctx context.Context
+}
+
+// GetRemoteAddr returns the remote address of the client, followed by the
+// number of the stream.
+// It implements WSRPCConnection.
+func (c *eventStreamConnection) GetRemoteAddr() string {
+	return c.remoteAddr
+}
+
+// WriteRPCResponse pushes a response to the writeChan, and blocks until it is
+// accepted.
+// It implements WSRPCConnection. It is Goroutine-safe.
+func (c *eventStreamConnection) WriteRPCResponse(ctx context.Context, resp types.RPCResponse) error {
+	select {
+	case <-c.ctx.Done():
+		return errors.New("stream was closed")
+	case <-ctx.Done():
+		return ctx.Err()
+	case c.writeChan <- resp:
+		return nil
+	}
+}
+
+// TryWriteRPCResponse attempts to push a response to the writeChan, but does
+// not block.
+// It implements WSRPCConnection. It is Goroutine-safe.
+func (c *eventStreamConnection) TryWriteRPCResponse(resp types.RPCResponse) bool {
+	select {
+	case <-c.ctx.Done():
+		return false
+	case c.writeChan <- resp:
+		return true
+	default:
+		return false
+	}
+}
+
+// Context returns the stream's context.
+// The context is canceled when the client disconnects.
+func (c *eventStreamConnection) Context() context.Context {
+	return c.ctx
+}
@@ -0,0 +1,161 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+	types "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+type testEvent struct {
+	Query string `json:"query"`
+	I     int    `json:"i"`
+}
+
+func TestEventStreamHandler(t *testing.T) {
+	disconnected := make(chan string, 10)
+	var subscriber string
+	funcMap := map[string]*RPCFunc{
+		"subscribe": NewWSRPCFunc(func(ctx *types.Context, query string, n *int64) (string, error) {
+			if query == "" {
+				return "", errors.New("empty query")
+			}
+			subscriber = ctx.RemoteAddr()
+			go func() {
+				if n == nil {
+					<-ctx.WSConn.Context().Done()
+					return
+				}
+				for i := int64(0); i < *n; i++ {
+					resp := types.NewRPCSuccessResponse(ctx.JSONReq.ID, testEvent{Query: query, I: int(i)})
+					if err := ctx.WSConn.WriteRPCResponse(context.Background(), resp); err != nil {
+						return
+					}
+				}
+				ctx.WSConn.TryWriteRPCResponse(types.RPCServerError(ctx.JSONReq.ID, errors.New("canceled")))
+			}()
+			return "", nil
+		}, "query,n"),
+	}
+	em := NewEventStreamManager(funcMap,
+		EventStreamHeartbeatPeriod(10*time.Millisecond),
+		EventStreamOnDisconnect(func(remoteAddr string) { disconnected <- remoteAddr }),
+	)
+	em.SetLogger(log.TestingLogger())
+	mux := http.NewServeMux()
+	mux.HandleFunc("/events", em.EventStreamHandler)
+	s := httptest.NewServer(RecoverAndLogHandler(mux, log.TestingLogger()))
+	defer s.Close()
+
+	t.Run("stream", func(t *testing.T) {
+		resp, err := http.Get(s.URL + "/events?" + url.Values{"query": {"a='b'"}, "n": {"2"}}.Encode())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		var lines []string
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			if line := scanner.Text(); line != "" && line != ": heartbeat" {
+				lines = append(lines, line)
+			}
+		}
+		assert.Equal(t, []string{
+			`data: {"query":"a='b'","i":"0"}`,
+			`data: {"query":"a='b'","i":"1"}`,
+			`event: error`,
+			`data: {"code":-32000,"message":"Server error","data":"canceled"}`,
+		}, lines)
+
+		select {
+		case remoteAddr := <-disconnected:
+			assert.Equal(t, subscriber, remoteAddr)
+		case <-time.After(time.Second):
+			t.Fatal("client was not unsubscribed")
+		}
+	})
+
+	t.Run("heartbeat", func(t *testing.T) {
+		resp, err := http.Get(s.URL + "/events?" + url.Values{"query": {"a='b'"}}.Encode())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			if scanner.Text() == ": heartbeat" {
+				break
+			}
+		}
+		require.NoError(t, scanner.Err())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		resp, err := http.Get(s.URL + "/events?n=1")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	})
+
+	t.Run("method", func(t *testing.T) {
+		resp, err := http.Post(s.URL+"/events", "text/plain", nil)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+}
+
+func TestEventStreamMaxStreamsPerClient(t *testing.T) {
+	disconnected := make(chan string, 10)
+	funcMap := map[string]*RPCFunc{
+		"subscribe": NewWSRPCFunc(func(ctx *types.Context, query string) (string, error) {
+			return "", nil
+		}, "query"),
+	}
+	em := NewEventStreamManager(funcMap,
+		EventStreamMaxStreamsPerClient(1),
+		EventStreamOnDisconnect(func(remoteAddr string) { disconnected <- remoteAddr }),
+	)
+	em.SetLogger(log.TestingLogger())
+	mux := http.NewServeMux()
+	mux.HandleFunc("/events", em.EventStreamHandler)
+	s := httptest.NewServer(mux)
+	defer s.Close()
+
+	open := func() *http.Response {
+		resp, err := http.Get(s.URL + "/events?" + url.Values{"query": {"a='b'"}}.Encode())
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := open()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// The streams of a client are limited together, although each has its
+	// own subscriber.
+	second := open()
+	second.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, second.StatusCode)
+
+	resp.Body.Close()
+	select {
+	case <-disconnected:
+	case <-time.After(time.Second):
+		t.Fatal("client was not unsubscribed")
+	}
+	require.Eventually(t, func() bool {
+		resp := open()
+		resp.Body.Close()
+		return resp.StatusCode == http.StatusOK
+	}, time.Second, 10*time.Millisecond)
+}
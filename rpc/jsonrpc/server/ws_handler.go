@@ -86,6 +86,12 @@ func (wm *WebsocketManager) WebsocketHandler(w http.ResponseWriter, r *http.Requ
 
 	// register connection
 	con := newWSConnection(wsConn, wm.funcMap, wm.wsConnOptions...)
+	con.client = clientFromContext(r.Context())
+	con.limits = limitedClientFromContext(r.Context())
+	con.cache = responseCacheFromContext(r.Context())
+	// The context of the connection carries the values of the request, like
+	// the rate limits of the client.
+	con.ctx, con.cancel = context.WithCancel(context.WithoutCancel(r.Context()))
 	con.SetLogger(wm.logger.With("remote", wsConn.RemoteAddr()))
 	wm.logger.Info("New websocket connection", "remote", con.remoteAddr)
 	err = con.Start() // BLOCKING
@@ -118,6 +124,15 @@ type wsConnection struct {
 
 	funcMap map[string]*RPCFunc
 
+	// client authenticated when the connection was opened, nil if
+	// authentication is disabled
+	client *client
+	// rate limits of the client, charged for each call, nil if it is not
+	// limited
+	limits *limitedClient
+	// cache of the immutable results, nil if caching is disabled
+	cache *ResponseCache
+
 	// write channel capacity
 	writeChanCapacity int
 
@@ -363,6 +378,19 @@ func (wsc *wsConnection) readRoutine() {
 				continue
 			}
 
+			if err := wsc.client.authorize(request.Method); err != nil {
+				if err := wsc.WriteRPCResponse(writeCtx, types.RPCForbiddenError(request.ID, err)); err != nil {
+					wsc.Logger.Error("Error writing RPC response", "err", err)
+				}
+				continue
+			}
+			if _, err := wsc.limits.take(rpcFunc.rateLimitedJSONRPCCall(request.Method, request.Params)); err != nil {
+				if err := wsc.WriteRPCResponse(writeCtx, types.RPCTooManyRequestsError(request.ID, err)); err != nil {
+					wsc.Logger.Error("Error writing RPC response", "err", err)
+				}
+				continue
+			}
+
 			ctx := &types.Context{JSONReq: &request, WSConn: wsc}
 			args := []reflect.Value{reflect.ValueOf(ctx)}
 			if len(request.Params) > 0 {
@@ -378,12 +406,11 @@ func (wsc *wsConnection) readRoutine() {
 				args = append(args, fnArgs...)
 			}
 
-			returns := rpcFunc.f.Call(args)
+			result, err := wsc.cache.call(request.Method, rpcFunc, args)
 
 			// TODO: Need to encode args/returns to string if we want to log them
 			wsc.Logger.Info("WSJSONRPC", "method", request.Method)
 
-			result, err := unreflectResult(returns)
 			if err != nil {
 				if err := wsc.WriteRPCResponse(writeCtx, types.RPCInternalError(request.ID, err)); err != nil {
 					wsc.Logger.Error("Error writing RPC response", "err", err)
@@ -391,7 +418,7 @@ func (wsc *wsConnection) readRoutine() {
 				continue
 			}
 
-			if err := wsc.WriteRPCResponse(writeCtx, types.NewRPCSuccessResponse(request.ID, result)); err != nil {
+			if err := wsc.WriteRPCResponse(writeCtx, types.RPCResponse{JSONRPC: "2.0", ID: request.ID, Result: result}); err != nil {
 				wsc.Logger.Error("Error writing RPC response", "err", err)
 			}
 		}
@@ -403,6 +430,14 @@ func (wsc *wsConnection) writeRoutine() {
 	pingTicker := time.NewTicker(wsc.pingPeriod)
 	defer pingTicker.Stop()
 
+	// The connection is closed when the credentials of the client expire.
+	var expired <-chan time.Time
+	if exp := wsc.client.expiresAt(); !exp.IsZero() {
+		expiryTimer := time.NewTimer(time.Until(exp))
+		defer expiryTimer.Stop()
+		expired = expiryTimer.C
+	}
+
 	// https://github.com/gorilla/websocket/issues/97
 	pongs := make(chan string, 1)
 	wsc.baseConn.SetPingHandler(func(m string) error {
@@ -419,6 +454,13 @@ func (wsc *wsConnection) writeRoutine() {
 			return
 		case <-wsc.readRoutineQuit: // error in readRoutine
 			return
+		case <-expired:
+			wsc.Logger.Info("Closing connection", "err", errCredentialsExpired)
+			msg := websocket.FormatCloseMessage(websocket.ClosePolicyViolation, errCredentialsExpired.Error())
+			if err := wsc.writeMessageWithDeadline(websocket.CloseMessage, msg); err != nil {
+				wsc.Logger.Error("Failed to write close message", "err", err)
+			}
+			return
 		case m := <-pongs:
 			err := wsc.writeMessageWithDeadline(websocket.PongMessage, []byte(m))
 			if err != nil {
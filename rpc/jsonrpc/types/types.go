@@ -246,6 +246,22 @@ func RPCServerError(id jsonrpcid, err error) RPCResponse {
 	return NewRPCErrorResponse(id, -32000, "Server error", err.Error())
 }
 
+// RPCUnauthorizedError is returned when the credentials of the client are
+// invalid.
+func RPCUnauthorizedError(id jsonrpcid, err error) RPCResponse {
+	return NewRPCErrorResponse(id, -32001, "Unauthorized", err.Error())
+}
+
+// RPCForbiddenError is returned when the client may not call the method.
+func RPCForbiddenError(id jsonrpcid, err error) RPCResponse {
+	return NewRPCErrorResponse(id, -32002, "Forbidden", err.Error())
+}
+
+// RPCTooManyRequestsError is returned when the client exceeds its rate limit.
+func RPCTooManyRequestsError(id jsonrpcid, err error) RPCResponse {
+	return NewRPCErrorResponse(id, -32003, "Too many requests", err.Error())
+}
+
 //----------------------------------------
 
 // WSRPCConnection represents a websocket connection.
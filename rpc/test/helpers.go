@@ -103,6 +103,8 @@ func createConfig() *cfg.Config {
 	c.RPC.ListenAddress = rpc
 	c.RPC.CORSAllowedOrigins = []string{"https://cometbft.com/"}
 	c.RPC.GRPCListenAddress = grpc
+	// record the consensus timeline, for the consensus_trace tests
+	c.Consensus.TraceHeights = 100
 	return c
 }
 
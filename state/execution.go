@@ -50,6 +50,18 @@ type BlockExecutor struct {
 
 	// blockTimeTolerance is the maximum allowed difference between proposed block time and wall clock.
 	blockTimeTolerance time.Duration
+
+	// cache of verified signatures, shared with the other components
+	// verifying commits. May be nil.
+	signatureCache *types.SignatureCache
+
+	// if set, blocks are only pruned below both the retain height requested
+	// by the application and the one set by the data companion.
+	dataCompanion bool
+
+	// number of recent heights whose FinalizeBlock responses are kept, or 0
+	// to keep them as long as their blocks.
+	abciResponsesRetainBlocks int64
 }
 
 type BlockExecutorOption func(executor *BlockExecutor)
@@ -66,6 +78,32 @@ func BlockExecutorWithBlockTimeTolerance(d time.Duration) BlockExecutorOption {
 	}
 }
 
+// BlockExecutorWithSignatureCache sets the cache of verified signatures used
+// when verifying the LastCommit of a block.
+func BlockExecutorWithSignatureCache(cache *types.SignatureCache) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.signatureCache = cache
+	}
+}
+
+// BlockExecutorWithDataCompanion makes the block executor take the data
+// companion retain height into account when pruning blocks: a block is only
+// pruned once both the application and the data companion no longer need it.
+func BlockExecutorWithDataCompanion() BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.dataCompanion = true
+	}
+}
+
+// BlockExecutorWithABCIResponsesRetainBlocks makes the block executor prune
+// the FinalizeBlock responses of the heights older than the n most recent
+// ones, independently of the pruning of blocks.
+func BlockExecutorWithABCIResponsesRetainBlocks(n int64) BlockExecutorOption {
+	return func(blockExec *BlockExecutor) {
+		blockExec.abciResponsesRetainBlocks = n
+	}
+}
+
 // NewBlockExecutor returns a new BlockExecutor with a NopEventBus.
 // Call SetEventBus to provide one.
 func NewBlockExecutor(
@@ -99,6 +137,12 @@ func (blockExec *BlockExecutor) Store() Store {
 	return blockExec.store
 }
 
+// SignatureCache returns the cache of verified signatures, or nil if none was
+// set.
+func (blockExec *BlockExecutor) SignatureCache() *types.SignatureCache {
+	return blockExec.signatureCache
+}
+
 // SetEventBus - sets the event bus for publishing block related events.
 // If not called, it defaults to types.NopEventBus.
 func (blockExec *BlockExecutor) SetEventBus(eventBus types.BlockEventPublisher) {
@@ -225,7 +269,7 @@ func (blockExec *BlockExecutor) validateBlockAndCheckEvidence(state State, block
 	// safe to call with nil
 	if !lastValidated.HashesTo(block.Hash()) || block.Height != expectedHeight {
 		// always use blocktime tolerance set on the struct
-		if err := validateBlock(state, block, append(opts, blockExec.withBlockTimeTolerance)...); err != nil {
+		if err := validateBlock(state, block, append(opts, blockExec.withBlockTimeTolerance, blockExec.withSignatureCache)...); err != nil {
 			return err
 		}
 		blockExec.setLastValidatedBlock(lastValidated, block)
@@ -238,6 +282,10 @@ func (blockExec *BlockExecutor) withBlockTimeTolerance(opts *blockValidationOpti
 	opts.blockTimeTolerance = blockExec.blockTimeTolerance
 }
 
+func (blockExec *BlockExecutor) withSignatureCache(opts *blockValidationOptions) {
+	opts.signatureCache = blockExec.signatureCache
+}
+
 func withSkipLastCommit(opts *blockValidationOptions) {
 	opts.skipLastCommitVerification = true
 }
@@ -267,7 +315,7 @@ func (blockExec *BlockExecutor) ApplyBlock(
 
 	// safe to call with nil
 	if !lastValidated.HashesTo(block.Hash()) || block.Height != expectedHeight {
-		if err := validateBlock(state, block, blockExec.withBlockTimeTolerance); err != nil {
+		if err := validateBlock(state, block, blockExec.withBlockTimeTolerance, blockExec.withSignatureCache); err != nil {
 			return state, ErrInvalidBlock(err)
 		}
 		blockExec.setLastValidatedBlock(lastValidated, block)
@@ -371,6 +419,15 @@ func (blockExec *BlockExecutor) applyBlock(state State, blockID types.BlockID, b
 			blockExec.logger.Debug("pruned blocks", "pruned", pruned, "retain_height", retainHeight)
 		}
 	}
+	if n := blockExec.abciResponsesRetainBlocks; n > 0 && block.Height > n {
+		abciResRetainHeight := block.Height - n + 1
+		pruned, err := blockExec.store.PruneABCIResponses(abciResRetainHeight)
+		if err != nil {
+			blockExec.logger.Error("failed to prune ABCI responses", "retain_height", abciResRetainHeight, "err", err)
+		} else if pruned > 0 {
+			blockExec.logger.Debug("pruned ABCI responses", "pruned", pruned, "retain_height", abciResRetainHeight)
+		}
+	}
 
 	// Events are fired after everything else.
 	// NOTE: if we crash between Commit and Save, events wont be fired during replay
@@ -871,6 +928,20 @@ func ExecCommitBlock(
 }
 
 func (blockExec *BlockExecutor) pruneBlocks(retainHeight int64, state State) (uint64, error) {
+	if blockExec.dataCompanion {
+		companionRetainHeight, err := blockExec.store.GetCompanionBlockRetainHeight()
+		if err != nil {
+			return 0, fmt.Errorf("failed to load companion block retain height: %w", err)
+		}
+		// The data companion has not consumed any block yet.
+		if companionRetainHeight == 0 {
+			return 0, nil
+		}
+		if companionRetainHeight < retainHeight {
+			retainHeight = companionRetainHeight
+		}
+	}
+
 	base := blockExec.blockStore.Base()
 	if retainHeight <= base {
 		return 0, nil
@@ -1218,6 +1218,39 @@ func TestValidateBlockCacheHeightAware(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestPruneBlocksWithDataCompanion(t *testing.T) {
+	state, _, _ := makeState(1, 1)
+
+	companionRetainHeight := int64(0)
+	stateStore := &mocks.Store{}
+	stateStore.On("GetCompanionBlockRetainHeight").Return(
+		func() int64 { return companionRetainHeight }, nil)
+	stateStore.On("PruneStates", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	blockStore := &mocks.BlockStore{}
+	blockStore.On("Base").Return(int64(1))
+	blockStore.On("PruneBlocks", mock.Anything, mock.Anything).Return(uint64(0), int64(0), nil)
+
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), nil, nil,
+		sm.EmptyEvidencePool{}, blockStore, sm.BlockExecutorWithDataCompanion())
+
+	// Nothing is pruned until the data companion sets a retain height.
+	_, err := blockExec.PruneBlocks(10, state)
+	require.NoError(t, err)
+	blockStore.AssertNotCalled(t, "PruneBlocks", mock.Anything, mock.Anything)
+
+	// The lowest of the application and data companion retain heights is used.
+	companionRetainHeight = 5
+	_, err = blockExec.PruneBlocks(10, state)
+	require.NoError(t, err)
+	blockStore.AssertCalled(t, "PruneBlocks", int64(5), mock.Anything)
+
+	companionRetainHeight = 20
+	_, err = blockExec.PruneBlocks(8, state)
+	require.NoError(t, err)
+	blockStore.AssertCalled(t, "PruneBlocks", int64(8), mock.Anything)
+}
+
 func stripSignatures(ec *types.ExtendedCommit) {
 	for i, commitSig := range ec.ExtendedSignatures {
 		commitSig.Extension = nil
@@ -61,3 +61,9 @@ func Int64ToBytes(val int64) []byte {
 func Int64FromBytes(val []byte) int64 {
 	return int64FromBytes(val)
 }
+
+// PruneBlocks is an alias for the private pruneBlocks method in execution.go,
+// exported exclusively and explicitly for testing.
+func (blockExec *BlockExecutor) PruneBlocks(retainHeight int64, state State) (uint64, error) {
+	return blockExec.pruneBlocks(retainHeight, state)
+}
@@ -23,5 +23,23 @@ type BlockIndexer interface {
 	// event search criteria.
 	Search(ctx context.Context, q *query.Query) ([]int64, error)
 
+	// SearchPage returns a page of the heights of the blocks matching the
+	// query, in the order of the page, and reports whether more heights follow
+	// the page. The cursors of blocks have a zero index.
+	SearchPage(ctx context.Context, q *query.Query, page Page) ([]int64, bool, error)
+
+	// SetRetainHeight sets the height below which the indexed block events
+	// are pruned. The retain height can only be increased.
+	SetRetainHeight(height int64) error
+
+	// GetRetainHeight returns the retain height, or 0 if it was never set.
+	GetRetainHeight() (int64, error)
+
+	// Prune removes part of the block events indexed below the retain height,
+	// doing an amount of work proportional to batchSize. It returns the number
+	// of deleted keys and whether all the block events below the retain height
+	// have been removed.
+	Prune(batchSize int) (int, bool, error)
+
 	SetLogger(l log.Logger)
 }
@@ -5,7 +5,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -19,12 +21,27 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
 	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/types"
 )
 
 var _ indexer.BlockIndexer = (*BlockerIndexer)(nil)
 
+var (
+	// retainHeightKey stores the height below which block events are pruned.
+	retainHeightKey = []byte("blockIndexerRetainHeight")
+	// prunedHeightKey stores the lowest height which was not pruned yet.
+	prunedHeightKey = []byte("blockIndexerPrunedHeight")
+	// legacyScanKey stores the key from which the scan of the event keys
+	// indexed by earlier versions, which did not record the lists of event
+	// keys, resumes.
+	legacyScanKey = []byte("blockIndexerLegacyScan")
+	// legacyScanDoneKey is set once the scan of the legacy event keys is
+	// complete.
+	legacyScanDoneKey = []byte("blockIndexerLegacyScanDone")
+)
+
 // BlockerIndexer implements a block indexer, indexing FinalizeBlock
 // events with an underlying KV store. Block events are indexed by their height,
 // such that matching search criteria returns the respective block height(s).
@@ -35,6 +52,9 @@ type BlockerIndexer struct {
 	// Matching will be done both on height AND eventSeq
 	eventSeq int64
 	log      log.Logger
+
+	// serializes the updates of the lists of event keys with pruning
+	mtx cmtsync.Mutex
 }
 
 func New(store dbm.DB) *BlockerIndexer {
@@ -63,7 +83,11 @@ func (idx *BlockerIndexer) Has(height int64) (bool, error) {
 //
 // primary key: encode(block.height | height) => encode(height)
 // FinalizeBlock events: encode(eventType.eventAttr|eventValue|height|finalize_block|eventSeq) => encode(height)
+// event list: encode(blockEventList | height) => list of the event keys
 func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockEvents) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
 	batch := idx.store.NewBatch()
 	defer batch.Close()
 
@@ -79,10 +103,27 @@ func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockEvents) error {
 	}
 
 	// 2. index block events
-	if err := idx.indexEvents(batch, bh.Events, height); err != nil {
+	keys, err := idx.indexEvents(batch, bh.Events, height)
+	if err != nil {
 		return fmt.Errorf("failed to index FinalizeBlock events: %w", err)
 	}
 
+	// 3. record the event keys, so that the height can be pruned without
+	// scanning the store
+	if len(keys) > 0 {
+		listKey, err := eventListKey(height)
+		if err != nil {
+			return fmt.Errorf("failed to create block event list key: %w", err)
+		}
+		list, err := idx.store.Get(listKey)
+		if err != nil {
+			return err
+		}
+		if err := batch.Set(listKey, appendEventList(list, keys...)); err != nil {
+			return err
+		}
+	}
+
 	return batch.WriteSync()
 }
 
@@ -91,6 +132,10 @@ func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockEvents) error {
 // one or more block heights. In the case of height queries, i.e. block.height=H,
 // if the height is indexed, that height alone will be returned. An error and
 // nil slice is returned. Otherwise, a non-nil slice and nil error is returned.
+//
+// The query is put in disjunctive normal form: the heights matching each
+// conjunction, minus the heights matching any of its negated conditions, are
+// merged and returned in ascending order.
 func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
 	results := make([]int64, 0)
 	select {
@@ -100,7 +145,327 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 	default:
 	}
 
-	conditions := q.Syntax()
+	conjunctions, err := q.DNF()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(conjunctions) == 1 && len(conjunctions[0].Negated) == 0 {
+		results, err = idx.searchConjunction(ctx, conjunctions[0].Conditions)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		resultMap := make(map[int64]struct{})
+		for _, conj := range conjunctions {
+			if len(conj.Conditions) == 0 {
+				return nil, errors.New("negated conditions must be combined with at least one other condition")
+			}
+			heights, err := idx.searchConjunction(ctx, conj.Conditions)
+			if err != nil {
+				return nil, err
+			}
+			excluded := make(map[int64]struct{})
+			for _, c := range conj.Negated {
+				if len(heights) == 0 {
+					break
+				}
+				negHeights, err := idx.searchConjunction(ctx, []syntax.Condition{c})
+				if err != nil {
+					return nil, err
+				}
+				for _, h := range negHeights {
+					excluded[h] = struct{}{}
+				}
+			}
+			for _, h := range heights {
+				if _, ok := excluded[h]; !ok {
+					resultMap[h] = struct{}{}
+				}
+			}
+		}
+		for h := range resultMap {
+			results = append(results, h)
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+
+	return results, nil
+}
+
+// SearchPage returns a page of the heights of the blocks matching the query,
+// and reports whether more heights follow the page.
+//
+// A query made of equalities on event attributes and of conditions on
+// block.height, combined in any way, is served lazily: the heights are
+// visited in the order of the page from its cursor, and the conditions are
+// checked at each height until the page is full. The heights are read from
+// the index keys of an attribute value if the query is a conjunction with an
+// equality on an event attribute, and from the height keys within the height
+// bounds of the query otherwise. Other queries are evaluated like Search
+// before selecting the page.
+func (idx *BlockerIndexer) SearchPage(ctx context.Context, q *query.Query, page indexer.Page) ([]int64, bool, error) {
+	if page.Limit <= 0 {
+		return nil, false, errors.New("page limit must be positive")
+	}
+
+	conjunctions, err := q.DNF()
+	if err != nil {
+		return nil, false, err
+	}
+	if !checkablePerHeight(conjunctions) {
+		return idx.searchPageAll(ctx, q, page)
+	}
+
+	lo, hi := indexer.HeightBounds(conjunctions, types.BlockHeightKey)
+	if after := page.After; after != nil && page.Desc {
+		hi = min(hi, after.Height-1)
+	} else if after != nil {
+		lo = max(lo, after.Height+1)
+	}
+	if lo > hi {
+		return []int64{}, false, nil
+	}
+
+	// The keys of both the heights and the values of an attribute are made of
+	// a prefix followed by the height.
+	prefix, err := orderedcode.Append(nil, types.BlockHeightKey)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create prefix key: %w", err)
+	}
+	parseHeight := func(key []byte) (int64, error) {
+		var compositeKey string
+		var height int64
+		_, err := orderedcode.Parse(string(key), &compositeKey, &height)
+		return height, err
+	}
+	byEvent := false
+	if len(conjunctions) == 1 {
+		for _, c := range conjunctions[0].Conditions {
+			if c.Tag != types.BlockHeightKey {
+				if prefix, err = orderedcode.Append(nil, c.Tag, c.Arg.Value()); err != nil {
+					return nil, false, fmt.Errorf("failed to create prefix key: %w", err)
+				}
+				parseHeight = parseHeightFromEventKey
+				byEvent = true
+				break
+			}
+		}
+	}
+	// Clip the prefix so that the start and end keys do not share its array.
+	prefix = slices.Clip(prefix)
+	start, err := orderedcode.Append(prefix, lo)
+	if err != nil {
+		return nil, false, err
+	}
+	end := prefixEnd(prefix)
+	if hi < math.MaxInt64 {
+		if end, err = orderedcode.Append(prefix, hi+1); err != nil {
+			return nil, false, err
+		}
+	}
+
+	var it dbm.Iterator
+	if page.Desc {
+		it, err = idx.store.ReverseIterator(start, end)
+	} else {
+		it, err = idx.store.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create iterator: %w", err)
+	}
+	defer it.Close()
+
+	results := make([]int64, 0, page.Limit)
+	last := int64(-1)
+	for ; it.Valid(); it.Next() {
+		height, err := parseHeight(it.Key())
+		if err != nil {
+			idx.log.Error("failure to parse height from key:", err)
+			continue
+		}
+		if height == last {
+			continue // another event of the same block
+		}
+		last = height
+		if byEvent {
+			ok, err := idx.Has(height)
+			if err != nil {
+				return nil, false, err
+			}
+			if !ok {
+				continue
+			}
+		}
+		ok, err := idx.matchesHeight(conjunctions, height)
+		if err != nil {
+			return nil, false, err
+		}
+		if !ok {
+			continue
+		}
+		if len(results) == page.Limit {
+			return results, true, nil
+		}
+		results = append(results, height)
+
+		select {
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+
+		default:
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, false, err
+	}
+	return results, false, nil
+}
+
+// searchPageAll returns a page of the heights of the blocks matching the
+// query, after evaluating the query like Search.
+func (idx *BlockerIndexer) searchPageAll(ctx context.Context, q *query.Query, page indexer.Page) ([]int64, bool, error) {
+	heights, err := idx.Search(ctx, q)
+	if err != nil {
+		return nil, false, err
+	}
+	if page.Desc {
+		slices.Reverse(heights)
+	}
+
+	results := make([]int64, 0, page.Limit)
+	for _, h := range heights {
+		if !page.Follows(indexer.Cursor{Height: h}) {
+			continue
+		}
+		if len(results) == page.Limit {
+			return results, true, nil
+		}
+		results = append(results, h)
+	}
+	return results, false, nil
+}
+
+// checkablePerHeight reports whether the conditions of the conjunctions can
+// be checked at a given height with a few lookups: they must be equalities on
+// event attributes or numeric conditions on block.height, and each
+// conjunction needs a condition which is not negated.
+func checkablePerHeight(conjunctions []syntax.Conjunction) bool {
+	checkable := func(c syntax.Condition) bool {
+		if c.Tag != types.BlockHeightKey {
+			return c.Op == syntax.TEq && c.Arg != nil
+		}
+		switch c.Op {
+		case syntax.TEq, syntax.TLt, syntax.TLeq, syntax.TGt, syntax.TGeq:
+			return c.Arg.Number() != nil
+		default:
+			return false
+		}
+	}
+	for _, conj := range conjunctions {
+		if len(conj.Conditions) == 0 {
+			return false
+		}
+		for _, c := range conj.Conditions {
+			if !checkable(c) {
+				return false
+			}
+		}
+		for _, c := range conj.Negated {
+			if !checkable(c) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matchesHeight reports whether the block at the given height matches any of
+// the conjunctions, whose conditions are checkable per height. Like in Search,
+// the conditions of a conjunction on event attributes must be satisfied by the
+// same event, which is identified by its event sequence, while a negated
+// condition excludes the block if any of its events satisfies it.
+func (idx *BlockerIndexer) matchesHeight(conjunctions []syntax.Conjunction, height int64) (bool, error) {
+	inBounds := func(c syntax.Condition) bool {
+		lo, hi := indexer.HeightBounds([]syntax.Conjunction{{Conditions: []syntax.Condition{c}}}, c.Tag)
+		return lo <= height && height <= hi
+	}
+
+CONJ_LOOP:
+	for _, conj := range conjunctions {
+		var events map[int64]struct{} // events satisfying the conditions so far
+		for _, c := range conj.Conditions {
+			if c.Tag == types.BlockHeightKey {
+				if !inBounds(c) {
+					continue CONJ_LOOP
+				}
+				continue
+			}
+			seqs, err := idx.eventSeqsAt(c, height)
+			if err != nil {
+				return false, err
+			}
+			if events != nil {
+				for seq := range events {
+					if _, ok := seqs[seq]; !ok {
+						delete(events, seq)
+					}
+				}
+			} else {
+				events = seqs
+			}
+			if len(events) == 0 {
+				continue CONJ_LOOP
+			}
+		}
+		for _, c := range conj.Negated {
+			if c.Tag == types.BlockHeightKey {
+				if inBounds(c) {
+					continue CONJ_LOOP
+				}
+				continue
+			}
+			seqs, err := idx.eventSeqsAt(c, height)
+			if err != nil {
+				return false, err
+			}
+			if len(seqs) > 0 {
+				continue CONJ_LOOP
+			}
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// eventSeqsAt returns the sequences of the events of the block at the given
+// height which satisfy the equality condition c.
+func (idx *BlockerIndexer) eventSeqsAt(c syntax.Condition, height int64) (map[int64]struct{}, error) {
+	prefix, err := orderedcode.Append(nil, c.Tag, c.Arg.Value(), height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create prefix key: %w", err)
+	}
+	it, err := dbm.IteratePrefix(idx.store, prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	seqs := make(map[int64]struct{})
+	for ; it.Valid(); it.Next() {
+		// keys indexed without an event sequence share the sequence 0, like
+		// in setTmpHeights
+		eventSeq, _ := parseEventSeqFromEventKey(it.Key())
+		seqs[eventSeq] = struct{}{}
+	}
+	return seqs, it.Error()
+}
+
+// searchConjunction returns the heights of the blocks matching all the given
+// conditions, in no particular order.
+func (idx *BlockerIndexer) searchConjunction(ctx context.Context, conditions []syntax.Condition) ([]int64, error) {
+	results := make([]int64, 0)
 
 	// conditions to skip because they're handled before "everything else"
 	skipIndexes := make([]int, 0)
@@ -244,8 +609,6 @@ FOR_LOOP:
 		}
 	}
 
-	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
-
 	return results, nil
 }
 
@@ -493,11 +856,15 @@ func (idx *BlockerIndexer) match(
 			return nil, err
 		}
 
-	case syntax.TContains:
+	case syntax.TContains, syntax.TStartsWith:
 		prefix, err := orderedcode.Append(nil, c.Tag)
 		if err != nil {
 			return nil, err
 		}
+		matches := strings.Contains
+		if c.Op == syntax.TStartsWith {
+			matches = strings.HasPrefix
+		}
 
 		it, err := dbm.IteratePrefix(idx.store, prefix)
 		if err != nil {
@@ -512,7 +879,7 @@ func (idx *BlockerIndexer) match(
 				continue
 			}
 
-			if strings.Contains(eventValue, c.Arg.Value()) {
+			if matches(eventValue, c.Arg.Value()) {
 				keyHeight, err := parseHeightFromEventKey(it.Key())
 				if err != nil {
 					idx.log.Error("failure to parse height from key:", err)
@@ -575,7 +942,302 @@ FOR_LOOP:
 	return filteredHeights, nil
 }
 
-func (idx *BlockerIndexer) indexEvents(batch dbm.Batch, events []abci.Event, height int64) error {
+// SetRetainHeight sets the height below which block events are pruned. It
+// returns an error if the height is lower than the current retain height.
+func (idx *BlockerIndexer) SetRetainHeight(height int64) error {
+	current, err := idx.GetRetainHeight()
+	if err != nil {
+		return err
+	}
+	if height < current {
+		return fmt.Errorf("cannot decrease the block indexer retain height from %d to %d", current, height)
+	}
+	return idx.store.SetSync(retainHeightKey, int64ToBytes(height))
+}
+
+// GetRetainHeight returns the height below which block events are pruned, or
+// 0 if it was never set.
+func (idx *BlockerIndexer) GetRetainHeight() (int64, error) {
+	bz, err := idx.store.Get(retainHeightKey)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return int64FromBytes(bz), nil
+}
+
+// Prune deletes the block events indexed below the retain height, one height
+// at a time starting from the lowest height not pruned yet, until the number
+// of visited heights and deleted keys reaches batchSize. It returns the number
+// of deleted keys and whether all the heights below the retain height have
+// been pruned.
+//
+// The event keys of a height are found from the list recorded when indexing
+// it. Earlier versions did not record these lists, so before pruning the first
+// time, Prune scans the store in batches and adds the event keys missing from
+// them, deleting the ones of heights already pruned.
+func (idx *BlockerIndexer) Prune(batchSize int) (int, bool, error) {
+	retainHeight, err := idx.GetRetainHeight()
+	if err != nil {
+		return 0, false, err
+	}
+	bz, err := idx.store.Get(prunedHeightKey)
+	if err != nil {
+		return 0, false, err
+	}
+	height := int64FromBytes(bz)
+	if height < 1 {
+		height = 1
+	}
+
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if height < retainHeight {
+		scanned, err := idx.store.Has(legacyScanDoneKey)
+		if err != nil {
+			return 0, false, err
+		}
+		if !scanned {
+			deleted, err := idx.scanLegacyEvents(height, batchSize)
+			if err != nil {
+				return 0, false, fmt.Errorf("scanning legacy block events: %w", err)
+			}
+			return deleted, false, nil
+		}
+	}
+
+	batch := idx.store.NewBatch()
+	defer batch.Close()
+
+	work, deleted := 0, 0
+	for ; height < retainHeight && work < batchSize; height++ {
+		n, err := idx.pruneHeight(height, batch)
+		if err != nil {
+			return 0, false, fmt.Errorf("pruning height %d: %w", height, err)
+		}
+		work += n + 1
+		deleted += n
+	}
+	if err := batch.Set(prunedHeightKey, int64ToBytes(height)); err != nil {
+		return 0, false, err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return 0, false, err
+	}
+	return deleted, height >= retainHeight, nil
+}
+
+// pruneHeight adds the deletion of the height key, the event keys and the
+// list of event keys of the given height to the batch, and returns the number
+// of deleted keys.
+func (idx *BlockerIndexer) pruneHeight(height int64, batch dbm.Batch) (int, error) {
+	deleted := 0
+	key, err := heightKey(height)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create block height index key: %w", err)
+	}
+	ok, err := idx.store.Has(key)
+	if err != nil {
+		return 0, err
+	}
+	if ok {
+		if err := batch.Delete(key); err != nil {
+			return 0, err
+		}
+		deleted++
+	}
+
+	listKey, err := eventListKey(height)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create block event list key: %w", err)
+	}
+	list, err := idx.store.Get(listKey)
+	if err != nil {
+		return 0, err
+	}
+	if list == nil {
+		return deleted, nil
+	}
+	keys, err := parseEventList(list)
+	if err != nil {
+		return 0, err
+	}
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return 0, err
+		}
+	}
+	if err := batch.Delete(listKey); err != nil {
+		return 0, err
+	}
+	return deleted + len(keys) + 1, nil
+}
+
+// scanLegacyEvents visits up to batchSize keys of the store, resuming from
+// where the previous call stopped, and adds the event keys which are missing
+// from the list of event keys of their height. The event keys of heights below
+// prunedHeight are deleted instead. It returns the number of deleted keys.
+func (idx *BlockerIndexer) scanLegacyEvents(prunedHeight int64, batchSize int) (int, error) {
+	start, err := idx.store.Get(legacyScanKey)
+	if err != nil {
+		return 0, err
+	}
+	it, err := idx.store.Iterator(start, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		pruned  [][]byte
+		lists   = make(map[int64][][]byte)
+		changed = make(map[int64]bool)
+	)
+	for visited := 0; it.Valid() && visited < batchSize; it.Next() {
+		visited++
+		height, ok := eventKeyHeight(it.Key())
+		if !ok {
+			continue
+		}
+		key := append([]byte(nil), it.Key()...)
+		if height < prunedHeight {
+			pruned = append(pruned, key)
+			continue
+		}
+		keys, ok := lists[height]
+		if !ok {
+			listKey, err := eventListKey(height)
+			if err != nil {
+				it.Close()
+				return 0, fmt.Errorf("failed to create block event list key: %w", err)
+			}
+			list, err := idx.store.Get(listKey)
+			if err != nil {
+				it.Close()
+				return 0, err
+			}
+			if keys, err = parseEventList(list); err != nil {
+				it.Close()
+				return 0, err
+			}
+		}
+		if !slices.ContainsFunc(keys, func(k []byte) bool { return bytes.Equal(k, key) }) {
+			keys = append(keys, key)
+			changed[height] = true
+		}
+		lists[height] = keys
+	}
+	if err := it.Error(); err != nil {
+		it.Close()
+		return 0, err
+	}
+	var next []byte
+	if it.Valid() {
+		next = append([]byte(nil), it.Key()...)
+	}
+	// the iterator is closed before writing, as some backends do not support
+	// writes while iterating
+	it.Close()
+
+	batch := idx.store.NewBatch()
+	defer batch.Close()
+
+	for _, key := range pruned {
+		if err := batch.Delete(key); err != nil {
+			return 0, err
+		}
+	}
+	for height := range changed {
+		listKey, err := eventListKey(height)
+		if err != nil {
+			return 0, fmt.Errorf("failed to create block event list key: %w", err)
+		}
+		if err := batch.Set(listKey, appendEventList(nil, lists[height]...)); err != nil {
+			return 0, err
+		}
+	}
+	if next != nil {
+		err = batch.Set(legacyScanKey, next)
+	} else {
+		err = batch.Set(legacyScanDoneKey, []byte{1})
+	}
+	if err != nil {
+		return 0, err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return 0, err
+	}
+	return len(pruned), nil
+}
+
+// DeleteEvents deletes the keys indexed at the given height for the
+// attributes of events flagged for indexing, whatever their event sequence,
+// along with the keys recorded in the event list of the height. Re-indexing a
+// height after deleting its events drops the keys of the events which are no
+// longer indexed.
+func (idx *BlockerIndexer) DeleteEvents(height int64, events []abci.Event) error {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	batch := idx.store.NewBatch()
+	defer batch.Close()
+
+	listKey, err := eventListKey(height)
+	if err != nil {
+		return fmt.Errorf("failed to create block event list key: %w", err)
+	}
+	list, err := idx.store.Get(listKey)
+	if err != nil {
+		return err
+	}
+	keys, err := parseEventList(list)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return err
+		}
+	}
+	if err := batch.Delete(listKey); err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		if len(event.Type) == 0 {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if len(attr.Key) == 0 || !attr.GetIndex() {
+				continue
+			}
+			prefix, err := orderedcode.Append(nil, event.Type+"."+attr.Key, attr.Value, height)
+			if err != nil {
+				return fmt.Errorf("failed to create block index key: %w", err)
+			}
+			it, err := dbm.IteratePrefix(idx.store, prefix)
+			if err != nil {
+				return err
+			}
+			for ; it.Valid(); it.Next() {
+				if err := batch.Delete(append([]byte(nil), it.Key()...)); err != nil {
+					it.Close()
+					return err
+				}
+			}
+			if err := it.Error(); err != nil {
+				it.Close()
+				return err
+			}
+			it.Close()
+		}
+	}
+	return batch.WriteSync()
+}
+
+// indexEvents adds the keys of the attributes of the events flagged for
+// indexing to the batch, and returns them.
+func (idx *BlockerIndexer) indexEvents(batch dbm.Batch, events []abci.Event, height int64) ([][]byte, error) {
+	var keys [][]byte
 	heightBz := int64ToBytes(height)
 
 	for _, event := range events {
@@ -593,21 +1255,22 @@ func (idx *BlockerIndexer) indexEvents(batch dbm.Batch, events []abci.Event, hei
 			// index iff the event specified index:true and it's not a reserved event
 			compositeKey := event.Type + "." + attr.Key
 			if compositeKey == types.BlockHeightKey {
-				return fmt.Errorf("event type and attribute key \"%s\" is reserved; please use a different key", compositeKey)
+				return nil, fmt.Errorf("event type and attribute key \"%s\" is reserved; please use a different key", compositeKey)
 			}
 
 			if attr.GetIndex() {
 				key, err := eventKey(compositeKey, attr.Value, height, idx.eventSeq)
 				if err != nil {
-					return fmt.Errorf("failed to create block index key: %w", err)
+					return nil, fmt.Errorf("failed to create block index key: %w", err)
 				}
 
 				if err := batch.Set(key, heightBz); err != nil {
-					return err
+					return nil, err
 				}
+				keys = append(keys, key)
 			}
 		}
 	}
 
-	return nil
+	return keys, nil
 }
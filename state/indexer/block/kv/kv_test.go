@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/google/orderedcode"
 	"github.com/stretchr/testify/require"
 
 	db "github.com/cometbft/cometbft-db"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+	idxpkg "github.com/cometbft/cometbft/state/indexer"
 	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	"github.com/cometbft/cometbft/types"
 )
@@ -130,6 +132,34 @@ func TestBlockIndexer(t *testing.T) {
 			q:       query.MustCompile("end_event.foo CONTAINS '1'"),
 			results: []int64{1, 10},
 		},
+		"end_event.foo STARTS_WITH '1'": {
+			q:       query.MustCompile("end_event.foo STARTS_WITH '1'"),
+			results: []int64{1, 10},
+		},
+		"end_event.foo STARTS_WITH '0'": {
+			q:       query.MustCompile("end_event.foo STARTS_WITH '0'"),
+			results: []int64{},
+		},
+		"end_event.foo IN (2, 6, 100)": {
+			q:       query.MustCompile("end_event.foo IN (2, 6, 100)"),
+			results: []int64{1, 2, 6},
+		},
+		"end_event.foo = 4 OR end_event.foo = 8": {
+			q:       query.MustCompile("end_event.foo = 4 OR end_event.foo = 8"),
+			results: []int64{4, 8},
+		},
+		"begin_event.proposer STARTS_WITH 'FCA' AND NOT end_event.foo EXISTS": {
+			q:       query.MustCompile("begin_event.proposer STARTS_WITH 'FCA' AND NOT end_event.foo EXISTS"),
+			results: []int64{3, 5, 7, 9, 11},
+		},
+		"end_event.foo <= 8 AND NOT (end_event.foo = 2 OR block.height = 6)": {
+			q:       query.MustCompile("end_event.foo <= 8 AND NOT (end_event.foo = 2 OR block.height = 6)"),
+			results: []int64{4, 8},
+		},
+		"(block.height = 4 OR block.height = 10) AND end_event.foo >= 6": {
+			q:       query.MustCompile("(block.height = 4 OR block.height = 10) AND end_event.foo >= 6"),
+			results: []int64{10},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -142,6 +172,79 @@ func TestBlockIndexer(t *testing.T) {
 	}
 }
 
+func TestBlockIndexerSearchPage(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	indexer := blockidxkv.New(store)
+
+	for i := int64(1); i <= 12; i++ {
+		require.NoError(t, indexer.Index(types.EventDataNewBlockEvents{
+			Height: i,
+			Events: []abci.Event{
+				{
+					Type: "begin_event",
+					Attributes: []abci.EventAttribute{
+						{Key: "proposer", Value: fmt.Sprintf("FCAA00%d", i%2), Index: true},
+						{Key: "round", Value: "0", Index: true},
+					},
+				},
+				{
+					// Two events of the same block with the same attribute.
+					Type: "end_event",
+					Attributes: []abci.EventAttribute{
+						{Key: "foo", Value: "bar", Index: true},
+					},
+				},
+				{
+					Type: "end_event",
+					Attributes: []abci.EventAttribute{
+						{Key: "foo", Value: "bar", Index: true},
+					},
+				},
+			},
+		}))
+	}
+
+	testCases := []struct {
+		q    string
+		desc bool
+		want []int64
+	}{
+		{"begin_event.proposer = 'FCAA001'", false, []int64{1, 3, 5, 7, 9, 11}},
+		{"begin_event.proposer = 'FCAA001'", true, []int64{11, 9, 7, 5, 3, 1}},
+		{"end_event.foo = 'bar'", false, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}},
+		{"end_event.foo = 'baz'", false, []int64{}},
+		{"block.height > 4 AND begin_event.proposer CONTAINS '0'", true, []int64{12, 11, 10, 9, 8, 7, 6, 5}},
+		{"begin_event.proposer = 'FCAA000' OR block.height = 3", false, []int64{2, 3, 4, 6, 8, 10, 12}},
+		{"begin_event.proposer = 'FCAA001' AND block.height <= 9 AND NOT block.height = 5", true, []int64{9, 7, 3, 1}},
+		{"block.height >= 3 AND block.height < 9", true, []int64{8, 7, 6, 5, 4, 3}},
+		{"end_event.foo = 'bar' AND NOT begin_event.proposer = 'FCAA000'", false, []int64{1, 3, 5, 7, 9, 11}},
+		{"block.height > 12", false, []int64{}},
+		// the conditions of a conjunction must be satisfied by the same event
+		{"begin_event.proposer = 'FCAA001' AND begin_event.round = '0'", false, []int64{1, 3, 5, 7, 9, 11}},
+		{"begin_event.proposer = 'FCAA001' AND end_event.foo = 'bar'", false, []int64{}},
+		{"block.height < 5 AND (end_event.foo = 'bar' AND begin_event.round = '0')", true, []int64{}},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%s/desc=%v", tc.q, tc.desc), func(t *testing.T) {
+			q := query.MustCompile(tc.q)
+			got := []int64{}
+			page := idxpkg.Page{Limit: 4, Desc: tc.desc}
+			for {
+				heights, more, err := indexer.SearchPage(context.Background(), q, page)
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(heights), page.Limit)
+				got = append(got, heights...)
+				if !more {
+					break
+				}
+				require.Len(t, heights, page.Limit)
+				page.After = &idxpkg.Cursor{Height: got[len(got)-1]}
+			}
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
 func TestBlockIndexerMulti(t *testing.T) {
 	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
 	indexer := blockidxkv.New(store)
@@ -434,3 +537,136 @@ func TestBigInt(t *testing.T) {
 		})
 	}
 }
+
+func TestBlockIndexerPrune(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	indexer := blockidxkv.New(store)
+
+	for height := int64(1); height <= 10; height++ {
+		require.NoError(t, indexer.Index(types.EventDataNewBlockEvents{
+			Height: height,
+			Events: []abci.Event{
+				{
+					Type: "begin_event",
+					Attributes: []abci.EventAttribute{
+						{Key: "proposer", Value: "FCAA001", Index: true},
+						{Key: "round", Value: fmt.Sprint(height), Index: true},
+					},
+				},
+			},
+		}))
+	}
+
+	// nothing to prune without a retain height
+	deleted, done, err := indexer.Prune(100)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Zero(t, deleted)
+
+	require.NoError(t, indexer.SetRetainHeight(6))
+	retainHeight, err := indexer.GetRetainHeight()
+	require.NoError(t, err)
+	require.EqualValues(t, 6, retainHeight)
+	require.Error(t, indexer.SetRetainHeight(5))
+
+	// prune in small batches
+	calls, total := 0, 0
+	for done = false; !done; {
+		deleted, done, err = indexer.Prune(4)
+		require.NoError(t, err)
+		total += deleted
+		calls++
+	}
+	require.Greater(t, calls, 1)
+	// a height key, 2 event keys and the list of event keys per pruned height
+	require.Equal(t, 5*4, total)
+
+	for height := int64(1); height <= 10; height++ {
+		has, err := indexer.Has(height)
+		require.NoError(t, err)
+		require.Equal(t, height >= 6, has)
+	}
+	results, err := indexer.Search(context.Background(), query.MustCompile("begin_event.proposer = 'FCAA001'"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{6, 7, 8, 9, 10}, results)
+
+	// pruning is complete until the retain height is increased
+	deleted, done, err = indexer.Prune(100)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Zero(t, deleted)
+
+	require.NoError(t, indexer.SetRetainHeight(8))
+	deleted, done, err = indexer.Prune(100)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, 2*4, deleted)
+
+	// only the pruned heights are visited
+	deleted, done, err = indexer.Prune(100)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Zero(t, deleted)
+	results, err = indexer.Search(context.Background(), query.MustCompile("begin_event.round >= 1"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{8, 9, 10}, results)
+}
+
+func TestBlockIndexerPruneLegacyEvents(t *testing.T) {
+	store := db.NewPrefixDB(db.NewMemDB(), []byte("block_events"))
+	indexer := blockidxkv.New(store)
+
+	var legacyKeys [][]byte
+	for height := int64(1); height <= 4; height++ {
+		require.NoError(t, indexer.Index(types.EventDataNewBlockEvents{
+			Height: height,
+			Events: []abci.Event{
+				{
+					Type: "begin_event",
+					Attributes: []abci.EventAttribute{
+						{Key: "proposer", Value: "FCAA001", Index: true},
+					},
+				},
+			},
+		}))
+		// keys indexed by earlier versions, which are missing from the list
+		// of event keys of the height
+		key, err := orderedcode.Append(nil, "end_event.foo", "100", height, "end_block")
+		require.NoError(t, err)
+		require.NoError(t, store.Set(key, []byte{1}))
+		legacyKeys = append(legacyKeys, key)
+	}
+
+	require.NoError(t, indexer.SetRetainHeight(3))
+	total := 0
+	for done := false; !done; {
+		deleted, d, err := indexer.Prune(2)
+		require.NoError(t, err)
+		total += deleted
+		done = d
+	}
+	// a height key, an event key, a legacy event key and the list of event
+	// keys per pruned height
+	require.Equal(t, 2*4, total)
+
+	for i, key := range legacyKeys {
+		has, err := store.Has(key)
+		require.NoError(t, err)
+		require.Equal(t, i >= 2, has)
+	}
+	results, err := indexer.Search(context.Background(), query.MustCompile("begin_event.proposer = 'FCAA001'"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{3, 4}, results)
+
+	// the legacy keys of the heights which are left are pruned with them
+	require.NoError(t, indexer.SetRetainHeight(5))
+	deleted, done, err := indexer.Prune(100)
+	require.NoError(t, err)
+	require.True(t, done)
+	require.Equal(t, 2*4, deleted)
+	for _, key := range legacyKeys {
+		has, err := store.Has(key)
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+}
@@ -2,6 +2,7 @@ package kv
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -14,6 +15,10 @@ import (
 	"github.com/cometbft/cometbft/types"
 )
 
+// eventListKeyName is the name of the keys of the lists of the event keys
+// indexed at each height.
+const eventListKeyName = "blockEventList"
+
 type HeightInfo struct {
 	heightRange     indexer.QueryRange
 	height          int64
@@ -61,6 +66,67 @@ func eventKey(compositeKey, eventValue string, height int64, eventSeq int64) ([]
 	)
 }
 
+// prefixEnd returns the smallest key greater than all the keys starting with
+// prefix, or nil if there is none.
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+// eventListKey returns the key of the list of the event keys indexed at the
+// given height. Its name has no dot, so it cannot be the composite key of an
+// event attribute.
+func eventListKey(height int64) ([]byte, error) {
+	return orderedcode.Append(nil, eventListKeyName, height)
+}
+
+// appendEventList appends the given keys to an encoded list of event keys.
+// Each key is prefixed by its length.
+func appendEventList(list []byte, keys ...[]byte) []byte {
+	for _, key := range keys {
+		list = binary.AppendUvarint(list, uint64(len(key)))
+		list = append(list, key...)
+	}
+	return list
+}
+
+// parseEventList decodes a list of event keys encoded by appendEventList.
+func parseEventList(list []byte) ([][]byte, error) {
+	var keys [][]byte
+	for len(list) > 0 {
+		n, m := binary.Uvarint(list)
+		if m <= 0 || uint64(len(list)-m) < n {
+			return nil, errors.New("invalid block event list")
+		}
+		keys = append(keys, list[m:m+int(n)])
+		list = list[m+int(n):]
+	}
+	return keys, nil
+}
+
+// eventKeyHeight returns the height of an event key, and false if key is not
+// an event key.
+func eventKeyHeight(key []byte) (int64, bool) {
+	var (
+		compositeKey, eventValue string
+		height                   int64
+	)
+	if _, err := orderedcode.Parse(string(key), &compositeKey, &eventValue, &height); err != nil {
+		return 0, false
+	}
+	if compositeKey == types.BlockHeightKey || compositeKey == eventListKeyName {
+		return 0, false
+	}
+	return height, true
+}
+
 func parseValueFromPrimaryKey(key []byte) (string, error) {
 	var (
 		compositeKey string
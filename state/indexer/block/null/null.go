@@ -27,5 +27,21 @@ func (idx *BlockerIndexer) Search(context.Context, *query.Query) ([]int64, error
 	return []int64{}, nil
 }
 
+func (idx *BlockerIndexer) SearchPage(context.Context, *query.Query, indexer.Page) ([]int64, bool, error) {
+	return []int64{}, false, nil
+}
+
+func (idx *BlockerIndexer) SetRetainHeight(int64) error {
+	return errors.New(`indexing is disabled (set 'tx_index = "kv"' in config)`)
+}
+
+func (idx *BlockerIndexer) GetRetainHeight() (int64, error) {
+	return 0, nil
+}
+
+func (idx *BlockerIndexer) Prune(int) (int, bool, error) {
+	return 0, true, nil
+}
+
 func (idx *BlockerIndexer) SetLogger(log.Logger) {
 }
@@ -11,6 +11,8 @@ import (
 
 	query "github.com/cometbft/cometbft/libs/pubsub/query"
 
+	indexer "github.com/cometbft/cometbft/state/indexer"
+
 	types "github.com/cometbft/cometbft/types"
 )
 
@@ -19,6 +21,34 @@ type BlockIndexer struct {
 	mock.Mock
 }
 
+// GetRetainHeight provides a mock function with no fields
+func (_m *BlockIndexer) GetRetainHeight() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRetainHeight")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Has provides a mock function with given fields: height
 func (_m *BlockIndexer) Has(height int64) (bool, error) {
 	ret := _m.Called(height)
@@ -65,6 +95,41 @@ func (_m *BlockIndexer) Index(_a0 types.EventDataNewBlockEvents) error {
 	return r0
 }
 
+// Prune provides a mock function with given fields: batchSize
+func (_m *BlockIndexer) Prune(batchSize int) (int, bool, error) {
+	ret := _m.Called(batchSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Prune")
+	}
+
+	var r0 int
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int) (int, bool, error)); ok {
+		return rf(batchSize)
+	}
+	if rf, ok := ret.Get(0).(func(int) int); ok {
+		r0 = rf(batchSize)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int) bool); ok {
+		r1 = rf(batchSize)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(int) error); ok {
+		r2 = rf(batchSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Search provides a mock function with given fields: ctx, q
 func (_m *BlockIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
 	ret := _m.Called(ctx, q)
@@ -95,11 +160,66 @@ func (_m *BlockIndexer) Search(ctx context.Context, q *query.Query) ([]int64, er
 	return r0, r1
 }
 
+// SearchPage provides a mock function with given fields: ctx, q, page
+func (_m *BlockIndexer) SearchPage(ctx context.Context, q *query.Query, page indexer.Page) ([]int64, bool, error) {
+	ret := _m.Called(ctx, q, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchPage")
+	}
+
+	var r0 []int64
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *query.Query, indexer.Page) ([]int64, bool, error)); ok {
+		return rf(ctx, q, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *query.Query, indexer.Page) []int64); ok {
+		r0 = rf(ctx, q, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *query.Query, indexer.Page) bool); ok {
+		r1 = rf(ctx, q, page)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *query.Query, indexer.Page) error); ok {
+		r2 = rf(ctx, q, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // SetLogger provides a mock function with given fields: l
 func (_m *BlockIndexer) SetLogger(l log.Logger) {
 	_m.Called(l)
 }
 
+// SetRetainHeight provides a mock function with given fields: height
+func (_m *BlockIndexer) SetRetainHeight(height int64) error {
+	ret := _m.Called(height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRetainHeight")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(height)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewBlockIndexer creates a new instance of BlockIndexer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBlockIndexer(t interface {
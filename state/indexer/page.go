@@ -0,0 +1,149 @@
+package indexer
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+)
+
+// cursorLen is the length of the binary encoding of a cursor: a big-endian
+// height followed by a big-endian index.
+const cursorLen = 8 + 4
+
+// A Cursor is the position of a search result: the height of its block and,
+// for transactions, its index in the block. Search results are ordered by
+// position, and a cursor selects the results following it.
+type Cursor struct {
+	Height int64
+	Index  uint32
+}
+
+// FirstCursor is the cursor selecting the results from the first one. It
+// fetches the first page of results like a cursor, without computing their
+// total count.
+const FirstCursor = "first"
+
+// ParseAfter decodes a cursor encoded by Cursor.String, or returns nil for
+// FirstCursor.
+func ParseAfter(s string) (*Cursor, error) {
+	if s == FirstCursor {
+		return nil, nil
+	}
+	c, err := ParseCursor(s)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// ParseCursor decodes a cursor encoded by Cursor.String.
+func ParseCursor(s string) (Cursor, error) {
+	bz, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("invalid cursor: %w", err)
+	}
+	if len(bz) != cursorLen {
+		return Cursor{}, errors.New("invalid cursor: wrong length")
+	}
+	c := Cursor{
+		Height: int64(binary.BigEndian.Uint64(bz[:8])),
+		Index:  binary.BigEndian.Uint32(bz[8:]),
+	}
+	if c.Height <= 0 {
+		return Cursor{}, errors.New("invalid cursor: height must be positive")
+	}
+	return c, nil
+}
+
+// String encodes c as an opaque string, safe for use in URLs.
+func (c Cursor) String() string {
+	bz := make([]byte, cursorLen)
+	binary.BigEndian.PutUint64(bz[:8], uint64(c.Height))
+	binary.BigEndian.PutUint32(bz[8:], c.Index)
+	return base64.RawURLEncoding.EncodeToString(bz)
+}
+
+// Less reports whether c comes before other in ascending order.
+func (c Cursor) Less(other Cursor) bool {
+	if c.Height != other.Height {
+		return c.Height < other.Height
+	}
+	return c.Index < other.Index
+}
+
+// A Page selects a page of search results: at most Limit results, following
+// After in ascending order, or in descending order if Desc is set. A nil After
+// selects the first page.
+type Page struct {
+	After *Cursor
+	Limit int
+	Desc  bool
+}
+
+// Follows reports whether a result at position c belongs after the cursor of
+// p, in the order of p.
+func (p Page) Follows(c Cursor) bool {
+	switch {
+	case p.After == nil:
+		return true
+	case p.Desc:
+		return c.Less(*p.After)
+	default:
+		return p.After.Less(c)
+	}
+}
+
+// Before reports whether position a comes before position b in the order of
+// p.
+func (p Page) Before(a, b Cursor) bool {
+	if p.Desc {
+		return b.Less(a)
+	}
+	return a.Less(b)
+}
+
+// HeightBounds returns the lowest and highest heights of the results which
+// may match any of the given conjunctions, according to their conditions on
+// the height attribute heightKey. The bounds are inclusive; a conjunction
+// without a lower or upper bound yields 0 or math.MaxInt64 respectively.
+func HeightBounds(conjunctions []syntax.Conjunction, heightKey string) (lo, hi int64) {
+	lo, hi = math.MaxInt64, 0
+	for _, conj := range conjunctions {
+		cLo, cHi := int64(0), int64(math.MaxInt64)
+		for _, c := range conj.Conditions {
+			if c.Tag != heightKey {
+				continue
+			}
+			f := c.Arg.Number()
+			if f == nil {
+				continue
+			}
+			floor, acc := f.Int64()
+			ceil := floor
+			if acc == big.Above {
+				floor--
+			} else if acc == big.Below {
+				ceil++
+			}
+			switch c.Op {
+			case syntax.TEq:
+				cLo, cHi = max(cLo, ceil), min(cHi, floor)
+			case syntax.TGt:
+				cLo = max(cLo, floor+1)
+			case syntax.TGeq:
+				cLo = max(cLo, ceil)
+			case syntax.TLt:
+				cHi = min(cHi, ceil-1)
+			case syntax.TLeq:
+				cHi = min(cHi, floor)
+			}
+		}
+		lo, hi = min(lo, cLo), max(hi, cHi)
+	}
+	return lo, hi
+}
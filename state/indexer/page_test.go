@@ -0,0 +1,92 @@
+package indexer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	for _, c := range []Cursor{
+		{Height: 1},
+		{Height: 1000, Index: 1},
+		{Height: 1<<63 - 1, Index: 1<<32 - 1},
+	} {
+		got, err := ParseCursor(c.String())
+		require.NoError(t, err)
+		require.Equal(t, c, got)
+	}
+}
+
+func TestParseCursorInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"not base64!",
+		"AAAAAAAAA-g",                          // too short
+		"AAAAAAAAAAAAAAAA",                     // zero height
+		Cursor{Height: -1}.String(),            // negative height
+		Cursor{Height: 1}.String() + "AAAAAAA", // too long
+	} {
+		_, err := ParseCursor(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestParseAfter(t *testing.T) {
+	after, err := ParseAfter(FirstCursor)
+	require.NoError(t, err)
+	require.Nil(t, after)
+
+	c := Cursor{Height: 1000, Index: 1}
+	after, err = ParseAfter(c.String())
+	require.NoError(t, err)
+	require.Equal(t, &c, after)
+
+	_, err = ParseAfter("")
+	require.Error(t, err)
+}
+
+func TestPageFollows(t *testing.T) {
+	after := &Cursor{Height: 5, Index: 2}
+	testCases := []struct {
+		page Page
+		c    Cursor
+		want bool
+	}{
+		{Page{}, Cursor{Height: 1}, true},
+		{Page{After: after}, Cursor{Height: 5, Index: 3}, true},
+		{Page{After: after}, Cursor{Height: 5, Index: 2}, false},
+		{Page{After: after}, Cursor{Height: 4, Index: 9}, false},
+		{Page{After: after, Desc: true}, Cursor{Height: 5, Index: 1}, true},
+		{Page{After: after, Desc: true}, Cursor{Height: 5, Index: 2}, false},
+		{Page{After: after, Desc: true}, Cursor{Height: 6}, false},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.want, tc.page.Follows(tc.c), "%+v %+v", tc.page, tc.c)
+	}
+}
+
+func TestHeightBounds(t *testing.T) {
+	testCases := []struct {
+		q      string
+		lo, hi int64
+	}{
+		{"tx.height = 5", 5, 5},
+		{"tx.height > 5 AND tx.height <= 10", 6, 10},
+		{"tx.height >= 5.5 AND tx.height < 10.5", 6, 10},
+		{"tx.height < 3 OR tx.height > 8", 0, math.MaxInt64},
+		{"(tx.height < 3 OR tx.height = 8) AND account.owner = 'Ivan'", 0, 8},
+		{"tx.height IN (4, 7)", 4, 7},
+		{"account.owner = 'Ivan' AND NOT tx.height = 5", 0, math.MaxInt64},
+	}
+	for _, tc := range testCases {
+		conjunctions, err := query.MustCompile(tc.q).DNF()
+		require.NoError(t, err)
+		lo, hi := HeightBounds(conjunctions, "tx.height")
+		require.Equal(t, tc.lo, lo, tc.q)
+		require.Equal(t, tc.hi, hi, tc.q)
+	}
+}
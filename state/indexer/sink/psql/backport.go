@@ -21,6 +21,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/types"
 )
@@ -48,16 +49,40 @@ func (b BackportTxIndexer) Index(txr *abci.TxResult) error {
 	return b.psql.IndexTxEvents([]*abci.TxResult{txr})
 }
 
-// Get is implemented to satisfy the TxIndexer interface, but is not supported
-// by the psql event sink and reports an error for all inputs.
-func (BackportTxIndexer) Get([]byte) (*abci.TxResult, error) {
-	return nil, errors.New("the TxIndexer.Get method is not supported")
+// Get looks up the transaction with the given hash in Postgres, as part of
+// TxIndexer. It returns nil if the transaction was not indexed.
+func (b BackportTxIndexer) Get(hash []byte) (*abci.TxResult, error) {
+	return b.psql.GetTxByHash(hash)
 }
 
-// Search is implemented to satisfy the TxIndexer interface, but it is not
-// supported by the psql event sink and reports an error for all inputs.
-func (BackportTxIndexer) Search(context.Context, *query.Query) ([]*abci.TxResult, error) {
-	return nil, errors.New("the TxIndexer.Search method is not supported")
+// Search returns the transactions matching the query from Postgres, as part
+// of TxIndexer.
+func (b BackportTxIndexer) Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	return b.psql.SearchTxEvents(ctx, q)
+}
+
+// SearchPage returns a page of the transactions matching the query from
+// Postgres, as part of TxIndexer.
+func (b BackportTxIndexer) SearchPage(ctx context.Context, q *query.Query, page indexer.Page) ([]*abci.TxResult, bool, error) {
+	return b.psql.SearchTxEventsPage(ctx, q, page)
+}
+
+// SetRetainHeight is implemented to satisfy the TxIndexer interface, but
+// pruning is not supported by the psql event sink and it reports an error.
+func (BackportTxIndexer) SetRetainHeight(int64) error {
+	return errors.New("the TxIndexer.SetRetainHeight method is not supported")
+}
+
+// GetRetainHeight is implemented to satisfy the TxIndexer interface. It always
+// returns 0, as pruning is not supported by the psql event sink.
+func (BackportTxIndexer) GetRetainHeight() (int64, error) {
+	return 0, nil
+}
+
+// Prune is implemented to satisfy the TxIndexer interface. It is a no-op, as
+// pruning is not supported by the psql event sink.
+func (BackportTxIndexer) Prune(int) (int, bool, error) {
+	return 0, true, nil
 }
 
 func (BackportTxIndexer) SetLogger(log.Logger) {}
@@ -72,10 +97,10 @@ func (es *EventSink) BlockIndexer() BackportBlockIndexer {
 // delegating indexing operations to an underlying PostgreSQL event sink.
 type BackportBlockIndexer struct{ psql *EventSink }
 
-// Has is implemented to satisfy the BlockIndexer interface, but it is not
-// supported by the psql event sink and reports an error for all inputs.
-func (BackportBlockIndexer) Has(_ int64) (bool, error) {
-	return false, errors.New("the BlockIndexer.Has method is not supported")
+// Has reports whether the events of the block at the given height have been
+// indexed in Postgres. It is part of the BlockIndexer interface.
+func (b BackportBlockIndexer) Has(height int64) (bool, error) {
+	return b.psql.HasBlock(height)
 }
 
 // Index indexes block begin and end events for the specified block.  It is
@@ -84,10 +109,34 @@ func (b BackportBlockIndexer) Index(block types.EventDataNewBlockEvents) error {
 	return b.psql.IndexBlockEvents(block)
 }
 
-// Search is implemented to satisfy the BlockIndexer interface, but it is not
-// supported by the psql event sink and reports an error for all inputs.
-func (BackportBlockIndexer) Search(context.Context, *query.Query) ([]int64, error) {
-	return nil, errors.New("the BlockIndexer.Search method is not supported")
+// Search returns the heights of the blocks matching the query from Postgres.
+// It is part of the BlockIndexer interface.
+func (b BackportBlockIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
+	return b.psql.SearchBlockEvents(ctx, q)
+}
+
+// SearchPage returns a page of the heights of the blocks matching the query
+// from Postgres. It is part of the BlockIndexer interface.
+func (b BackportBlockIndexer) SearchPage(ctx context.Context, q *query.Query, page indexer.Page) ([]int64, bool, error) {
+	return b.psql.SearchBlockEventsPage(ctx, q, page)
+}
+
+// SetRetainHeight is implemented to satisfy the BlockIndexer interface, but
+// pruning is not supported by the psql event sink and it reports an error.
+func (BackportBlockIndexer) SetRetainHeight(int64) error {
+	return errors.New("the BlockIndexer.SetRetainHeight method is not supported")
+}
+
+// GetRetainHeight is implemented to satisfy the BlockIndexer interface. It
+// always returns 0, as pruning is not supported by the psql event sink.
+func (BackportBlockIndexer) GetRetainHeight() (int64, error) {
+	return 0, nil
+}
+
+// Prune is implemented to satisfy the BlockIndexer interface. It is a no-op,
+// as pruning is not supported by the psql event sink.
+func (BackportBlockIndexer) Prune(int) (int, bool, error) {
+	return 0, true, nil
 }
 
 func (BackportBlockIndexer) SetLogger(log.Logger) {}
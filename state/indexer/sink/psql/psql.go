@@ -16,6 +16,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/types"
 )
 
@@ -25,6 +26,9 @@ const (
 	tableEvents     = "events"
 	tableAttributes = "attributes"
 	driverName      = "postgres"
+
+	// tableEventAttributes is the view joining events with their attributes.
+	tableEventAttributes = "event_attributes"
 )
 
 // EventSink is an indexer backend providing the tx/block index services.  This
@@ -253,24 +257,188 @@ func (es *EventSink) IndexTxEvents(txrs []*abci.TxResult) error {
 	return nil
 }
 
-// SearchBlockEvents is not implemented by this sink, and reports an error for all queries.
-func (es *EventSink) SearchBlockEvents(_ context.Context, _ *query.Query) ([]int64, error) {
-	return nil, errors.New("block search is not supported via the postgres event sink")
+// SearchBlockEvents returns the heights of the blocks whose events satisfy
+// the query, in increasing order. It is part of the indexer.EventSink
+// interface.
+func (es *EventSink) SearchBlockEvents(ctx context.Context, q *query.Query) ([]int64, error) {
+	heights, _, err := es.searchBlockEvents(ctx, q, nil)
+	return heights, err
 }
 
-// SearchTxEvents is not implemented by this sink, and reports an error for all queries.
-func (es *EventSink) SearchTxEvents(_ context.Context, _ *query.Query) ([]*abci.TxResult, error) {
-	return nil, errors.New("tx search is not supported via the postgres event sink")
+// SearchBlockEventsPage returns a page of the heights of the blocks whose
+// events satisfy the query, and reports whether more heights follow the page.
+func (es *EventSink) SearchBlockEventsPage(ctx context.Context, q *query.Query, page indexer.Page) ([]int64, bool, error) {
+	if page.Limit <= 0 {
+		return nil, false, errors.New("page limit must be positive")
+	}
+	return es.searchBlockEvents(ctx, q, &page)
 }
 
-// GetTxByHash is not implemented by this sink, and reports an error for all queries.
-func (es *EventSink) GetTxByHash(_ []byte) (*abci.TxResult, error) {
-	return nil, errors.New("getTxByHash is not supported via the postgres event sink")
+// searchBlockEvents returns the heights of the blocks whose events satisfy
+// the query, restricted to the given page if it is not nil.
+func (es *EventSink) searchBlockEvents(ctx context.Context, q *query.Query, page *indexer.Page) ([]int64, bool, error) {
+	if q == nil {
+		return nil, false, errors.New("missing block search query")
+	}
+	sq := &sqlQuery{}
+	chainID := sq.arg(es.chainID)
+	preds, err := sq.where(q, "block_id = "+tableBlocks+".rowid AND tx_id IS NULL",
+		types.BlockHeightKey)
+	if err != nil {
+		return nil, false, fmt.Errorf("translating block search query: %w", err)
+	}
+
+	order, limit := "ORDER BY height", ""
+	if page != nil {
+		if page.Desc {
+			order = "ORDER BY height DESC"
+		}
+		if page.After != nil {
+			cmp := ">"
+			if page.Desc {
+				cmp = "<"
+			}
+			preds += ") AND (height " + cmp + " " + sq.arg(page.After.Height)
+		}
+		limit = " LIMIT " + sq.arg(page.Limit+1)
+	}
+
+	rows, err := es.store.QueryContext(ctx, `
+SELECT height FROM `+tableBlocks+`
+  WHERE chain_id = `+chainID+` AND (`+preds+`)
+  `+order+limit+`;`, sq.args...)
+	if err != nil {
+		return nil, false, fmt.Errorf("searching block events: %w", err)
+	}
+	defer rows.Close()
+
+	var heights []int64
+	for rows.Next() {
+		var height int64
+		if err := rows.Scan(&height); err != nil {
+			return nil, false, fmt.Errorf("reading block search results: %w", err)
+		}
+		heights = append(heights, height)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, fmt.Errorf("reading block search results: %w", err)
+	}
+	if page != nil && len(heights) > page.Limit {
+		return heights[:page.Limit], true, nil
+	}
+	return heights, false, nil
+}
+
+// SearchTxEvents returns the results of the transactions whose events satisfy
+// the query, ordered by height and index. It is part of the
+// indexer.EventSink interface.
+func (es *EventSink) SearchTxEvents(ctx context.Context, q *query.Query) ([]*abci.TxResult, error) {
+	results, _, err := es.searchTxEvents(ctx, q, nil)
+	return results, err
 }
 
-// HasBlock is not implemented by this sink, and reports an error for all queries.
-func (es *EventSink) HasBlock(_ int64) (bool, error) {
-	return false, errors.New("hasBlock is not supported via the postgres event sink")
+// SearchTxEventsPage returns a page of the results of the transactions whose
+// events satisfy the query, and reports whether more results follow the page.
+func (es *EventSink) SearchTxEventsPage(ctx context.Context, q *query.Query, page indexer.Page) ([]*abci.TxResult, bool, error) {
+	if page.Limit <= 0 {
+		return nil, false, errors.New("page limit must be positive")
+	}
+	return es.searchTxEvents(ctx, q, &page)
+}
+
+// searchTxEvents returns the results of the transactions whose events satisfy
+// the query, restricted to the given page if it is not nil.
+func (es *EventSink) searchTxEvents(ctx context.Context, q *query.Query, page *indexer.Page) ([]*abci.TxResult, bool, error) {
+	if q == nil {
+		return nil, false, errors.New("missing tx search query")
+	}
+	sq := &sqlQuery{}
+	chainID := sq.arg(es.chainID)
+	preds, err := sq.where(q, "tx_id = "+tableTxResults+".rowid",
+		types.TxHeightKey, types.TxHashKey)
+	if err != nil {
+		return nil, false, fmt.Errorf("translating tx search query: %w", err)
+	}
+
+	order, limit := "ORDER BY height, index", ""
+	if page != nil {
+		if page.Desc {
+			order = "ORDER BY height DESC, index DESC"
+		}
+		if page.After != nil {
+			cmp := ">"
+			if page.Desc {
+				cmp = "<"
+			}
+			preds += ") AND ((height, index) " + cmp + " (" +
+				sq.arg(page.After.Height) + ", " + sq.arg(int64(page.After.Index)) + ")"
+		}
+		limit = " LIMIT " + sq.arg(page.Limit+1)
+	}
+
+	rows, err := es.store.QueryContext(ctx, `
+SELECT tx_result FROM `+tableTxResults+`
+  JOIN `+tableBlocks+` ON (`+tableBlocks+`.rowid = `+tableTxResults+`.block_id)
+  WHERE chain_id = `+chainID+` AND (`+preds+`)
+  `+order+limit+`;`, sq.args...)
+	if err != nil {
+		return nil, false, fmt.Errorf("searching tx events: %w", err)
+	}
+	defer rows.Close()
+
+	var results []*abci.TxResult
+	for rows.Next() {
+		var resultData []byte
+		if err := rows.Scan(&resultData); err != nil {
+			return nil, false, fmt.Errorf("reading tx search results: %w", err)
+		}
+		txr := new(abci.TxResult)
+		if err := proto.Unmarshal(resultData, txr); err != nil {
+			return nil, false, fmt.Errorf("unmarshaling tx_result: %w", err)
+		}
+		results = append(results, txr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, false, fmt.Errorf("reading tx search results: %w", err)
+	}
+	if page != nil && len(results) > page.Limit {
+		return results[:page.Limit], true, nil
+	}
+	return results, false, nil
+}
+
+// GetTxByHash returns the result of the transaction with the given hash, or
+// nil if it was not indexed. It is part of the indexer.EventSink interface.
+func (es *EventSink) GetTxByHash(hash []byte) (*abci.TxResult, error) {
+	var resultData []byte
+	err := es.store.QueryRow(`
+SELECT tx_result FROM `+tableTxResults+`
+  JOIN `+tableBlocks+` ON (`+tableBlocks+`.rowid = `+tableTxResults+`.block_id)
+  WHERE tx_hash = $1 AND chain_id = $2
+  LIMIT 1;`, fmt.Sprintf("%X", hash), es.chainID).Scan(&resultData)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("looking up tx by hash: %w", err)
+	}
+
+	txr := new(abci.TxResult)
+	if err := proto.Unmarshal(resultData, txr); err != nil {
+		return nil, fmt.Errorf("unmarshaling tx_result: %w", err)
+	}
+	return txr, nil
+}
+
+// HasBlock reports whether the events of the block at the given height have
+// been indexed. It is part of the indexer.EventSink interface.
+func (es *EventSink) HasBlock(height int64) (bool, error) {
+	var exists bool
+	if err := es.store.QueryRow(`
+SELECT EXISTS(SELECT 1 FROM `+tableBlocks+` WHERE height = $1 AND chain_id = $2);`,
+		height, es.chainID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("looking up block: %w", err)
+	}
+	return exists, nil
 }
 
 // Stop closes the underlying PostgreSQL database.
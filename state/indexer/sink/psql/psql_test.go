@@ -20,6 +20,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmlog "github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/types"
 
@@ -145,13 +146,32 @@ func TestIndexing(t *testing.T) {
 		verifyBlock(t, 1)
 		verifyBlock(t, 2)
 
-		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(1) })
-		verifyNotImplemented(t, "hasBlock", func() (bool, error) { return indexer.HasBlock(2) })
-
-		verifyNotImplemented(t, "block search", func() (bool, error) {
-			v, err := indexer.SearchBlockEvents(context.Background(), nil)
-			return v != nil, err
-		})
+		has, err := indexer.HasBlock(1)
+		require.NoError(t, err)
+		assert.True(t, has)
+		has, err = indexer.HasBlock(3)
+		require.NoError(t, err)
+		assert.False(t, has)
+
+		for q, want := range map[string][]int64{
+			"block.height = 1":                                  {1},
+			"block.height > 1":                                  nil,
+			"end_event.foo >= 100":                              {1},
+			"end_event.foo < 100":                               nil,
+			"thingy.whatzit = '-.O'":                            {1},
+			"thingy.whatzit CONTAINS 'O' AND block.height <= 1": {1},
+			"begin_event.proposer EXISTS":                       {1},
+			"begin_event.other EXISTS":                          nil,
+			"end_event.foo IN (1, 100)":                         {1},
+			"thingy.whatzit STARTS_WITH '-.'":                   {1},
+			"thingy.whatzit STARTS_WITH 'O'":                    nil,
+			"begin_event.other EXISTS OR end_event.foo = 100":   {1},
+			"block.height = 1 AND NOT end_event.foo = 100":      nil,
+		} {
+			heights, err := indexer.SearchBlockEvents(context.Background(), query.MustCompile(q))
+			require.NoError(t, err, q)
+			assert.Equal(t, want, heights, q)
+		}
 
 		require.NoError(t, verifyTimeStamp(tableBlocks))
 
@@ -166,6 +186,10 @@ func TestIndexing(t *testing.T) {
 			makeIndexedEvent("account.number", "1"),
 			makeIndexedEvent("account.owner", "Ivan"),
 			makeIndexedEvent("account.owner", "Yulieta"),
+			{Type: "transfer", Attributes: []abci.EventAttribute{
+				{Key: "sender", Value: "Ivan", Index: true},
+				{Key: "amount", Value: "10", Index: true},
+			}},
 
 			{Type: "", Attributes: []abci.EventAttribute{
 				{
@@ -184,14 +208,44 @@ func TestIndexing(t *testing.T) {
 		require.NoError(t, verifyTimeStamp(tableTxResults))
 		require.NoError(t, verifyTimeStamp(viewTxEvents))
 
-		verifyNotImplemented(t, "getTxByHash", func() (bool, error) {
-			txr, err := indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
-			return txr != nil, err
-		})
-		verifyNotImplemented(t, "tx search", func() (bool, error) {
-			txr, err := indexer.SearchTxEvents(context.Background(), nil)
-			return txr != nil, err
-		})
+		txr, err = indexer.GetTxByHash(types.Tx(txResult.Tx).Hash())
+		require.NoError(t, err)
+		assert.Equal(t, txResult, txr)
+		txr, err = indexer.GetTxByHash(types.Tx("unknown").Hash())
+		require.NoError(t, err)
+		assert.Nil(t, txr)
+
+		for q, found := range map[string]bool{
+			fmt.Sprintf("tx.hash = '%X'", types.Tx(txResult.Tx).Hash()): true,
+			"tx.height = 1":      true,
+			"tx.height = 2":      false,
+			"account.number = 1": true,
+			"account.number > 1": false,
+			"account.owner = 'Ivan' AND account.owner = 'Yulieta'": false,
+			"transfer.sender = 'Ivan' AND transfer.amount = 10":    true,
+			"transfer.sender = 'Ivan' AND transfer.amount > 10":    false,
+			"transfer.sender = 'Ivan' AND tx.height = 1":           true,
+			"account.number = 1 AND transfer.amount = 10":          false,
+			"account.owner = 'Vlad'":                               false,
+			"account.owner CONTAINS 'uli'":                         true,
+			"account.number EXISTS AND tx.height >= 1":             true,
+			"not_allowed EXISTS":                                   false,
+			"account.owner IN ('Vlad', 'Yulieta')":                 true,
+			"account.owner STARTS_WITH 'Yu'":                       true,
+			"account.owner STARTS_WITH 'uli'":                      false,
+			"account.owner = 'Vlad' OR account.number = 1":         true,
+			"account.number = 1 AND NOT account.owner = 'Ivan'":    false,
+			"tx.height IN (1, 2) AND NOT account.owner = 'Vlad'":   true,
+		} {
+			results, err := indexer.SearchTxEvents(context.Background(), query.MustCompile(q))
+			require.NoError(t, err, q)
+			if found {
+				require.Len(t, results, 1, q)
+				assert.Equal(t, txResult, results[0], q)
+			} else {
+				assert.Empty(t, results, q)
+			}
+		}
 
 		// try to insert the duplicate tx events.
 		err = indexer.IndexTxEvents([]*abci.TxResult{txResult})
@@ -357,20 +411,6 @@ SELECT type, height, chain_id FROM `+viewBlockEvents+`
 	}
 }
 
-// verifyNotImplemented calls f and verifies that it returns both a
-// false-valued flag and a non-nil error whose string matching the expected
-// "not supported" message with label prefixed.
-func verifyNotImplemented(t *testing.T, label string, f func() (bool, error)) {
-	t.Helper()
-	t.Logf("Verifying that %q reports it is not implemented", label)
-
-	want := label + " is not supported via the postgres event sink"
-	ok, err := f()
-	assert.False(t, ok)
-	require.NotNil(t, err)
-	assert.Equal(t, want, err.Error())
-}
-
 // waitForInterrupt blocks until a SIGINT is received by the process.
 func waitForInterrupt() {
 	ch := make(chan os.Signal, 1)
@@ -0,0 +1,176 @@
+package psql
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+)
+
+// Patterns used to guard the casts of attribute values, which are stored as
+// text, before comparing them to numbers, dates and timestamps. They match
+// the values accepted by the pubsub query matcher.
+const (
+	numberPattern = `^-?[0-9]+(\.[0-9]+)?$`
+	datePattern   = `^[0-9]{4}-[0-9]{2}-[0-9]{2}$`
+	timePattern   = `^[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:[0-9]{2}(\.[0-9]+)?(Z|[+-][0-9]{2}:[0-9]{2})$`
+)
+
+// sqlQuery accumulates the positional arguments of a SQL statement.
+type sqlQuery struct {
+	args []any
+}
+
+// arg adds v to the arguments and returns its placeholder.
+func (q *sqlQuery) arg(v any) string {
+	q.args = append(q.args, v)
+	return "$" + strconv.Itoa(len(q.args))
+}
+
+// where translates a query into a SQL predicate. Like in the kv indexer, the
+// conditions of each conjunction of the query's disjunctive normal form must
+// be satisfied by a single event in scope, except for the conditions on the
+// pseudo events keyed by pseudoTags (e.g. tx.height), and negated conditions,
+// which exclude a match if any event in scope satisfies them. scope is a SQL
+// predicate over the events table selecting the events in scope (e.g. the
+// events of one transaction).
+func (q *sqlQuery) where(qry *query.Query, scope string, pseudoTags ...string) (string, error) {
+	conjunctions, err := qry.DNF()
+	if err != nil {
+		return "", err
+	}
+	preds := make([]string, 0, len(conjunctions))
+	for _, conj := range conjunctions {
+		pred, err := q.conjunction(conj, scope, pseudoTags)
+		if err != nil {
+			return "", err
+		}
+		preds = append(preds, "("+pred+")")
+	}
+	return strings.Join(preds, " OR "), nil
+}
+
+// conjunction translates a conjunction of conditions into a SQL predicate,
+// see where.
+func (q *sqlQuery) conjunction(conj syntax.Conjunction, scope string, pseudoTags []string) (string, error) {
+	var preds, eventPreds []string
+	for _, c := range conj.Conditions {
+		if slices.Contains(pseudoTags, c.Tag) {
+			pred, err := q.anyEvent(c, scope)
+			if err != nil {
+				return "", err
+			}
+			preds = append(preds, pred)
+			continue
+		}
+		valuePred, err := q.valuePredicate(c)
+		if err != nil {
+			return "", err
+		}
+		eventPreds = append(eventPreds, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM %s WHERE event_id = %s.rowid AND composite_key = %s AND %s)",
+			tableAttributes, tableEvents, q.arg(c.Tag), valuePred))
+	}
+	if len(eventPreds) > 0 {
+		preds = append(preds, fmt.Sprintf("EXISTS (SELECT 1 FROM %s WHERE %s AND %s)",
+			tableEvents, scope, strings.Join(eventPreds, " AND ")))
+	}
+	for _, c := range conj.Negated {
+		pred, err := q.anyEvent(c, scope)
+		if err != nil {
+			return "", err
+		}
+		preds = append(preds, "NOT "+pred)
+	}
+	if len(preds) == 0 {
+		return "TRUE", nil
+	}
+	return strings.Join(preds, " AND "), nil
+}
+
+// anyEvent translates a condition into a predicate satisfied if any of the
+// events in scope satisfies it.
+func (q *sqlQuery) anyEvent(c syntax.Condition, scope string) (string, error) {
+	valuePred, err := q.valuePredicate(c)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("EXISTS (SELECT 1 FROM %s WHERE %s AND composite_key = %s AND %s)",
+		tableEventAttributes, scope, q.arg(c.Tag), valuePred), nil
+}
+
+// valuePredicate translates the comparison of a condition into a predicate
+// over the value column of the attributes.
+func (q *sqlQuery) valuePredicate(c syntax.Condition) (string, error) {
+	if c.Op == syntax.TExists {
+		return "TRUE", nil
+	}
+	if c.Op == syntax.TIn {
+		if len(c.Args) == 0 {
+			return "", fmt.Errorf("missing arguments in condition %q", c)
+		}
+		preds := make([]string, 0, len(c.Args))
+		for _, eq := range c.Disjuncts() {
+			pred, err := q.valuePredicate(eq)
+			if err != nil {
+				return "", err
+			}
+			preds = append(preds, pred)
+		}
+		return "(" + strings.Join(preds, " OR ") + ")", nil
+	}
+	if c.Arg == nil {
+		return "", fmt.Errorf("missing argument in condition %q", c)
+	}
+	if c.Op == syntax.TContains {
+		return fmt.Sprintf("strpos(value, %s) > 0", q.arg(c.Arg.Value())), nil
+	}
+	if c.Op == syntax.TStartsWith {
+		arg := q.arg(c.Arg.Value())
+		return fmt.Sprintf("left(value, length(%s)) = %s", arg, arg), nil
+	}
+
+	var op string
+	switch c.Op {
+	case syntax.TEq:
+		op = "="
+	case syntax.TLt:
+		op = "<"
+	case syntax.TLeq:
+		op = "<="
+	case syntax.TGt:
+		op = ">"
+	case syntax.TGeq:
+		op = ">="
+	default:
+		return "", fmt.Errorf("unsupported operator %v in condition %q", c.Op, c)
+	}
+
+	switch c.Arg.Type {
+	case syntax.TString:
+		if c.Op != syntax.TEq {
+			return "", errors.New("strings can only be compared for equality")
+		}
+		return "value = " + q.arg(c.Arg.Value()), nil
+	case syntax.TNumber:
+		return typedComparison(numberPattern, "numeric", op, q.arg(c.Arg.Value())), nil
+	case syntax.TDate:
+		return typedComparison(datePattern, "date", op, q.arg(c.Arg.Value())), nil
+	case syntax.TTime:
+		return typedComparison(timePattern, "timestamptz", op, q.arg(c.Arg.Value())), nil
+	default:
+		return "", fmt.Errorf("unsupported argument type %v in condition %q", c.Arg.Type, c)
+	}
+}
+
+// typedComparison compares the value, cast to sqlType when it matches
+// pattern, to the argument. Values not matching the pattern never satisfy the
+// comparison.
+func typedComparison(pattern, sqlType, op, arg string) string {
+	return fmt.Sprintf("(CASE WHEN value ~ '%s' THEN value::%s END) %s %s::%s",
+		pattern, sqlType, op, arg, sqlType)
+}
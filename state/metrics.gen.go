@@ -34,6 +34,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "validator_set_updates",
 			Help:      "ValidatorSetUpdates is the total number of times the application has updated the validator set since process start. metrics:Number of validator set updates returned by the application since process start.",
 		}, labels).With(labelsAndValues...),
+		SignatureCacheHits: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "signature_cache_hits",
+			Help:      "SignatureCacheHits is the number of commit signatures whose verification was skipped because they were found in the shared verified signature cache.",
+		}, labels).With(labelsAndValues...),
+		SignatureCacheMisses: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "signature_cache_misses",
+			Help:      "SignatureCacheMisses is the number of commit signatures which were not found in the shared verified signature cache and had to be verified.",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
@@ -42,5 +54,7 @@ func NopMetrics() *Metrics {
 		BlockProcessingTime:   discard.NewHistogram(),
 		ConsensusParamUpdates: discard.NewCounter(),
 		ValidatorSetUpdates:   discard.NewCounter(),
+		SignatureCacheHits:    discard.NewCounter(),
+		SignatureCacheMisses:  discard.NewCounter(),
 	}
 }
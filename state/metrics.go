@@ -26,4 +26,13 @@ type Metrics struct {
 	// updated the validator set since process start.
 	// metrics:Number of validator set updates returned by the application since process start.
 	ValidatorSetUpdates metrics.Counter
+
+	// SignatureCacheHits is the number of commit signatures whose
+	// verification was skipped because they were found in the shared
+	// verified signature cache.
+	SignatureCacheHits metrics.Counter
+
+	// SignatureCacheMisses is the number of commit signatures which were not
+	// found in the shared verified signature cache and had to be verified.
+	SignatureCacheMisses metrics.Counter
 }
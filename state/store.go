@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/gogoproto/proto"
 
@@ -36,7 +37,7 @@ func calcConsensusParamsKey(height int64) []byte {
 }
 
 func calcABCIResponsesKey(height int64) []byte {
-	return []byte(fmt.Sprintf("abciResponsesKey:%v", height))
+	return []byte(fmt.Sprintf("%s%v", abciResponsesKeyPrefix, height))
 }
 
 //----------------------
@@ -44,8 +45,22 @@ func calcABCIResponsesKey(height int64) []byte {
 var (
 	lastABCIResponseKey    = []byte("lastABCIResponseKey")
 	offlineStateSyncHeight = []byte("offlineStateSyncHeightKey")
+	companionRetainHeight  = []byte("companionBlockRetainHeightKey")
+	abciResRetainHeight    = []byte("abciResponsesRetainHeightKey")
+
+	abciResponsesKeyPrefix = []byte("abciResponsesKey:")
 )
 
+// KeyPrefixes returns the prefixes of the keys of the state store, by the kind
+// of data they hold.
+func KeyPrefixes() map[string][]byte {
+	return map[string][]byte{
+		"validators":       []byte("validatorsKey:"),
+		"consensus_params": []byte("consensusParamsKey:"),
+		"abci_responses":   abciResponsesKeyPrefix,
+	}
+}
+
 //go:generate ../scripts/mockery_generate.sh Store
 
 // Store defines the state store interface
@@ -63,12 +78,16 @@ type Store interface {
 	Load() (State, error)
 	// LoadValidators loads the validator set at a given height
 	LoadValidators(int64) (*types.ValidatorSet, error)
+	// LoadValidatorsChangeHeight returns the height at which the validator set of a given height took effect
+	LoadValidatorsChangeHeight(int64) (int64, error)
 	// LoadFinalizeBlockResponse loads the abciResponse for a given height
 	LoadFinalizeBlockResponse(int64) (*abci.ResponseFinalizeBlock, error)
 	// LoadLastFinalizeBlockResponse loads the last abciResponse for a given height
 	LoadLastFinalizeBlockResponse(int64) (*abci.ResponseFinalizeBlock, error)
 	// LoadConsensusParams loads the consensus params for a given height
 	LoadConsensusParams(int64) (types.ConsensusParams, error)
+	// LoadConsensusParamsChangeHeight returns the height at which the consensus params of a given height took effect
+	LoadConsensusParamsChangeHeight(int64) (int64, error)
 	// Save overwrites the previous state with the updated one
 	Save(State) error
 	// SaveFinalizeBlockResponse saves ABCIResponses for a given height
@@ -77,10 +96,18 @@ type Store interface {
 	Bootstrap(State) error
 	// PruneStates takes the height from which to start pruning and which height stop at
 	PruneStates(int64, int64, int64) error
+	// PruneABCIResponses deletes the FinalizeBlock responses below the given height, independently of the blocks
+	PruneABCIResponses(targetRetainHeight int64) (int64, error)
+	// GetABCIResponsesRetainHeight returns the height below which FinalizeBlock responses were pruned, or 0
+	GetABCIResponsesRetainHeight() (int64, error)
 	// Saves the height at which the store is bootstrapped after out of band statesync
 	SetOfflineStateSyncHeight(height int64) error
 	// Gets the height at which the store is bootstrapped after out of band statesync
 	GetOfflineStateSyncHeight() (int64, error)
+	// SaveCompanionBlockRetainHeight saves the height below which the data companion allows blocks to be pruned
+	SaveCompanionBlockRetainHeight(height int64) error
+	// GetCompanionBlockRetainHeight returns the data companion block retain height, or 0 if it was never set
+	GetCompanionBlockRetainHeight() (int64, error)
 	// Close closes the connection with the database
 	Close() error
 }
@@ -404,6 +431,97 @@ func (store dbStore) PruneStates(from int64, to int64, evidenceThresholdHeight i
 	return nil
 }
 
+// PruneABCIResponses deletes the FinalizeBlock responses of the heights below
+// targetRetainHeight, and records it as the retain height of the responses.
+// It returns the number of deleted responses. The retain height never
+// decreases: a lower target is a no-op.
+//
+// Responses are otherwise only deleted along with the states of the pruned
+// blocks (see PruneStates), so this allows keeping more blocks than responses.
+func (store dbStore) PruneABCIResponses(targetRetainHeight int64) (int64, error) {
+	if targetRetainHeight <= 0 {
+		return 0, fmt.Errorf("retain height %v must be greater than 0", targetRetainHeight)
+	}
+	from, err := store.GetABCIResponsesRetainHeight()
+	if err != nil {
+		return 0, err
+	}
+	if targetRetainHeight <= from {
+		return 0, nil
+	}
+	if from == 0 {
+		// The responses were never pruned: start from the lowest stored one
+		// rather than from height 1, as the blocks may have been pruned.
+		if from, err = store.lowestABCIResponseHeight(); err != nil {
+			return 0, err
+		}
+	}
+
+	batch := store.db.NewBatch()
+	defer batch.Close()
+	pruned := int64(0)
+
+	for h := max(from, 1); h < targetRetainHeight; h++ {
+		if err := batch.Delete(calcABCIResponsesKey(h)); err != nil {
+			return 0, err
+		}
+		pruned++
+
+		// avoid batches growing too large by flushing to database regularly
+		if pruned%1000 == 0 {
+			if err := batch.Write(); err != nil {
+				return 0, err
+			}
+			batch.Close()
+			batch = store.db.NewBatch()
+			defer batch.Close()
+		}
+	}
+	if err := batch.Set(abciResRetainHeight, int64ToBytes(targetRetainHeight)); err != nil {
+		return 0, err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return 0, err
+	}
+	return pruned, nil
+}
+
+// GetABCIResponsesRetainHeight returns the height below which the FinalizeBlock
+// responses were pruned by PruneABCIResponses, or 0 if they never were.
+func (store dbStore) GetABCIResponsesRetainHeight() (int64, error) {
+	buf, err := store.db.Get(abciResRetainHeight)
+	if err != nil {
+		return 0, err
+	}
+	if len(buf) == 0 {
+		return 0, nil
+	}
+	return int64FromBytes(buf), nil
+}
+
+// lowestABCIResponseHeight returns the lowest height of a stored FinalizeBlock
+// response, or 0 if there is none. Keys are not ordered by height, so it scans
+// all of them.
+func (store dbStore) lowestABCIResponseHeight() (int64, error) {
+	it, err := dbm.IteratePrefix(store.db, abciResponsesKeyPrefix)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	lowest := int64(0)
+	for ; it.Valid(); it.Next() {
+		h, err := strconv.ParseInt(string(it.Key()[len(abciResponsesKeyPrefix):]), 10, 64)
+		if err != nil {
+			continue
+		}
+		if lowest == 0 || h < lowest {
+			lowest = h
+		}
+	}
+	return lowest, it.Error()
+}
+
 //------------------------------------------------------------------------
 
 // TxResultsHash returns the root hash of a Merkle tree of
@@ -587,6 +705,18 @@ func (store dbStore) LoadValidators(height int64) (*types.ValidatorSet, error) {
 	return vip, nil
 }
 
+// LoadValidatorsChangeHeight returns the height at which the validator set of
+// the given height took effect, that is the last height at or below it where
+// the validator set changed.
+// Returns ErrNoValSetForHeight if the validator set can't be found for this height.
+func (store dbStore) LoadValidatorsChangeHeight(height int64) (int64, error) {
+	valInfo, err := loadValidatorsInfo(store.db, height)
+	if err != nil {
+		return 0, ErrNoValSetForHeight{height}
+	}
+	return valInfo.LastHeightChanged, nil
+}
+
 func lastStoredHeightFor(height, lastHeightChanged int64) int64 {
 	checkpointHeight := height - height%valSetCheckpointInterval
 	return cmtmath.MaxInt64(checkpointHeight, lastHeightChanged)
@@ -682,6 +812,17 @@ func (store dbStore) LoadConsensusParams(height int64) (types.ConsensusParams, e
 	return types.ConsensusParamsFromProto(paramsInfo.ConsensusParams), nil
 }
 
+// LoadConsensusParamsChangeHeight returns the height at which the consensus
+// params of the given height took effect, that is the last height at or below
+// it where the consensus params changed.
+func (store dbStore) LoadConsensusParamsChangeHeight(height int64) (int64, error) {
+	paramsInfo, err := store.loadConsensusParamsInfo(height)
+	if err != nil {
+		return 0, fmt.Errorf("could not find consensus params for height #%d: %w", height, err)
+	}
+	return paramsInfo.LastHeightChanged, nil
+}
+
 func (store dbStore) loadConsensusParamsInfo(height int64) (*cmtstate.ConsensusParamsInfo, error) {
 	buf, err := store.db.Get(calcConsensusParamsKey(height))
 	if err != nil {
@@ -753,6 +894,28 @@ func (store dbStore) GetOfflineStateSyncHeight() (int64, error) {
 	return height, nil
 }
 
+// SaveCompanionBlockRetainHeight saves the height below which the data
+// companion allows blocks to be pruned.
+func (store dbStore) SaveCompanionBlockRetainHeight(height int64) error {
+	if height < 0 {
+		return errors.New("invalid value for height: height cannot be negative")
+	}
+	return store.db.SetSync(companionRetainHeight, int64ToBytes(height))
+}
+
+// GetCompanionBlockRetainHeight returns the data companion block retain
+// height, or 0 if it was never set.
+func (store dbStore) GetCompanionBlockRetainHeight() (int64, error) {
+	buf, err := store.db.Get(companionRetainHeight)
+	if err != nil {
+		return 0, err
+	}
+	if len(buf) == 0 {
+		return 0, nil
+	}
+	return int64FromBytes(buf), nil
+}
+
 func (store dbStore) Close() error {
 	return store.db.Close()
 }
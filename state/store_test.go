@@ -45,6 +45,39 @@ func TestStoreLoadValidators(t *testing.T) {
 	assert.NotZero(t, loadedVals.Size())
 }
 
+func TestStoreLoadChangeHeights(t *testing.T) {
+	stateDB := dbm.NewMemDB()
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{})
+	val, _ := types.RandValidator(true, 10)
+	vals := types.NewValidatorSet([]*types.Validator{val})
+
+	require.NoError(t, sm.SaveValidatorsInfo(stateDB, 1, 1, vals))
+	require.NoError(t, sm.SaveValidatorsInfo(stateDB, 2, 1, vals))
+	require.NoError(t, sm.SaveValidatorsInfo(stateDB, 3, 3, vals))
+
+	for height, want := range map[int64]int64{1: 1, 2: 1, 3: 3} {
+		changed, err := stateStore.LoadValidatorsChangeHeight(height)
+		require.NoError(t, err)
+		assert.Equal(t, want, changed, height)
+	}
+	_, err := stateStore.LoadValidatorsChangeHeight(4)
+	require.IsType(t, sm.ErrNoValSetForHeight{}, err)
+
+	state := sm.State{
+		LastBlockHeight:                  1,
+		Validators:                       vals,
+		NextValidators:                   vals,
+		ConsensusParams:                  *types.DefaultConsensusParams(),
+		LastHeightConsensusParamsChanged: 1,
+	}
+	require.NoError(t, stateStore.Save(state))
+	changed, err := stateStore.LoadConsensusParamsChangeHeight(2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, changed)
+	_, err = stateStore.LoadConsensusParamsChangeHeight(3)
+	require.Error(t, err)
+}
+
 func BenchmarkLoadValidators(b *testing.B) {
 	const valSetSize = 100
 
@@ -356,3 +389,55 @@ func TestIntConversion(t *testing.T) {
 	b := sm.Int64ToBytes(x)
 	require.Equal(t, x, sm.Int64FromBytes(b))
 }
+
+func TestCompanionBlockRetainHeight(t *testing.T) {
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+
+	height, err := stateStore.GetCompanionBlockRetainHeight()
+	require.NoError(t, err)
+	require.EqualValues(t, 0, height)
+
+	require.NoError(t, stateStore.SaveCompanionBlockRetainHeight(10))
+	height, err = stateStore.GetCompanionBlockRetainHeight()
+	require.NoError(t, err)
+	require.EqualValues(t, 10, height)
+
+	require.Error(t, stateStore.SaveCompanionBlockRetainHeight(-1))
+}
+
+func TestPruneABCIResponses(t *testing.T) {
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+
+	// The blocks below height 3 were pruned along with their responses.
+	for h := int64(3); h <= 10; h++ {
+		require.NoError(t, stateStore.SaveFinalizeBlockResponse(h, &abci.ResponseFinalizeBlock{AppHash: []byte{byte(h)}}))
+	}
+
+	_, err := stateStore.PruneABCIResponses(0)
+	require.Error(t, err)
+
+	pruned, err := stateStore.PruneABCIResponses(6)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, pruned)
+	_, err = stateStore.LoadFinalizeBlockResponse(5)
+	require.ErrorAs(t, err, &sm.ErrNoABCIResponsesForHeight{})
+	resp, err := stateStore.LoadFinalizeBlockResponse(6)
+	require.NoError(t, err)
+	require.Equal(t, []byte{6}, resp.AppHash)
+
+	// The retain height cannot decrease.
+	pruned, err = stateStore.PruneABCIResponses(4)
+	require.NoError(t, err)
+	require.Zero(t, pruned)
+
+	pruned, err = stateStore.PruneABCIResponses(8)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, pruned)
+	height, err := stateStore.GetABCIResponsesRetainHeight()
+	require.NoError(t, err)
+	require.EqualValues(t, 8, height)
+	_, err = stateStore.LoadFinalizeBlockResponse(7)
+	require.Error(t, err)
+	_, err = stateStore.LoadFinalizeBlockResponse(8)
+	require.NoError(t, err)
+}
@@ -0,0 +1,111 @@
+package txindex
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+// EventFilter selects the event attributes to index, among those the
+// application flagged for indexing. Rules name either an event type, which
+// matches all the attributes of the events of that type, or a composite key
+// ("type.key"), which matches a single attribute.
+//
+// An attribute is indexed if the include rules are empty or one of them
+// matches it, and none of the exclude rules matches it.
+type EventFilter struct {
+	include map[string]struct{}
+	exclude map[string]struct{}
+}
+
+// NewEventFilter returns a filter with the given include and exclude rules.
+// It returns nil, which indexes every attribute, if both are empty.
+func NewEventFilter(include, exclude []string) *EventFilter {
+	if len(include) == 0 && len(exclude) == 0 {
+		return nil
+	}
+	return &EventFilter{
+		include: toSet(include),
+		exclude: toSet(exclude),
+	}
+}
+
+func toSet(rules []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(rules))
+	for _, r := range rules {
+		set[r] = struct{}{}
+	}
+	return set
+}
+
+// Indexed reports whether the attribute key of an event of the given type
+// should be indexed.
+func (f *EventFilter) Indexed(eventType, key string) bool {
+	if f == nil {
+		return true
+	}
+	compositeKey := eventType + "." + key
+	if matches(f.exclude, eventType, compositeKey) {
+		return false
+	}
+	return len(f.include) == 0 || matches(f.include, eventType, compositeKey)
+}
+
+func matches(rules map[string]struct{}, eventType, compositeKey string) bool {
+	if _, ok := rules[eventType]; ok {
+		return true
+	}
+	_, ok := rules[compositeKey]
+	return ok
+}
+
+// Apply returns events with the index flag cleared on the attributes the
+// filter rejects. The given events are not modified: the events holding such
+// an attribute are copied, the others are shared.
+func (f *EventFilter) Apply(events []abci.Event) []abci.Event {
+	filtered, _ := f.apply(events)
+	return filtered
+}
+
+// apply implements Apply, and reports whether the events were copied.
+func (f *EventFilter) apply(events []abci.Event) ([]abci.Event, bool) {
+	if f == nil {
+		return events, false
+	}
+	var filtered []abci.Event
+	for i, event := range events {
+		var attrs []abci.EventAttribute
+		for j, attr := range event.Attributes {
+			if !attr.Index || f.Indexed(event.Type, attr.Key) {
+				continue
+			}
+			if attrs == nil {
+				attrs = make([]abci.EventAttribute, len(event.Attributes))
+				copy(attrs, event.Attributes)
+			}
+			attrs[j].Index = false
+		}
+		if attrs == nil {
+			continue
+		}
+		if filtered == nil {
+			filtered = make([]abci.Event, len(events))
+			copy(filtered, events)
+		}
+		filtered[i] = abci.Event{Type: event.Type, Attributes: attrs}
+	}
+	if filtered == nil {
+		return events, false
+	}
+	return filtered, true
+}
+
+// ApplyTxResult returns txr with the filter applied to its events. txr is not
+// modified.
+func (f *EventFilter) ApplyTxResult(txr *abci.TxResult) *abci.TxResult {
+	events, copied := f.apply(txr.Result.Events)
+	if !copied {
+		return txr
+	}
+	filtered := *txr
+	filtered.Result.Events = events
+	return &filtered
+}
@@ -0,0 +1,64 @@
+package txindex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/state/txindex"
+)
+
+func TestEventFilterIndexed(t *testing.T) {
+	testCases := []struct {
+		include, exclude []string
+		eventType, key   string
+		want             bool
+	}{
+		{nil, nil, "transfer", "sender", true},
+		{[]string{"transfer"}, nil, "transfer", "sender", true},
+		{[]string{"transfer"}, nil, "message", "sender", false},
+		{[]string{"transfer.sender"}, nil, "transfer", "sender", true},
+		{[]string{"transfer.sender"}, nil, "transfer", "memo", false},
+		{nil, []string{"transfer"}, "transfer", "sender", false},
+		{nil, []string{"transfer.memo"}, "transfer", "sender", true},
+		{nil, []string{"transfer.memo"}, "transfer", "memo", false},
+		{[]string{"transfer"}, []string{"transfer.memo"}, "transfer", "memo", false},
+		{[]string{"transfer.memo"}, []string{"transfer"}, "transfer", "memo", false},
+	}
+	for _, tc := range testCases {
+		f := txindex.NewEventFilter(tc.include, tc.exclude)
+		require.Equal(t, tc.want, f.Indexed(tc.eventType, tc.key), "%+v", tc)
+	}
+}
+
+func TestEventFilterApply(t *testing.T) {
+	events := []abci.Event{
+		{Type: "message", Attributes: []abci.EventAttribute{{Key: "sender", Value: "bob", Index: true}}},
+		{Type: "transfer", Attributes: []abci.EventAttribute{
+			{Key: "sender", Value: "bob", Index: true},
+			{Key: "memo", Value: "hello", Index: true},
+			{Key: "amount", Value: "10"},
+		}},
+	}
+
+	require.Equal(t, events, txindex.NewEventFilter(nil, nil).Apply(events))
+
+	filtered := txindex.NewEventFilter(nil, []string{"transfer.memo"}).Apply(events)
+	require.Equal(t, []abci.Event{
+		events[0],
+		{Type: "transfer", Attributes: []abci.EventAttribute{
+			{Key: "sender", Value: "bob", Index: true},
+			{Key: "memo", Value: "hello"},
+			{Key: "amount", Value: "10"},
+		}},
+	}, filtered)
+	require.True(t, events[1].Attributes[1].Index, "events must not be modified")
+
+	txr := &abci.TxResult{Height: 1, Result: abci.ExecTxResult{Events: events}}
+	require.Same(t, txr, txindex.NewEventFilter([]string{"message", "transfer"}, nil).ApplyTxResult(txr))
+	filteredTxr := txindex.NewEventFilter([]string{"message"}, nil).ApplyTxResult(txr)
+	require.NotSame(t, txr, filteredTxr)
+	require.False(t, filteredTxr.Result.Events[1].Attributes[0].Index)
+	require.True(t, txr.Result.Events[1].Attributes[0].Index)
+}
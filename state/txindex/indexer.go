@@ -8,6 +8,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
 )
 
 // XXX/TODO: These types should be moved to the indexer package.
@@ -29,6 +30,24 @@ type TxIndexer interface {
 	// Search allows you to query for transactions.
 	Search(ctx context.Context, q *query.Query) ([]*abci.TxResult, error)
 
+	// SearchPage returns a page of the transactions matching the query,
+	// ordered by height and index, and reports whether more transactions
+	// follow the page.
+	SearchPage(ctx context.Context, q *query.Query, page indexer.Page) ([]*abci.TxResult, bool, error)
+
+	// SetRetainHeight sets the height below which the indexed transactions
+	// are pruned. The retain height can only be increased.
+	SetRetainHeight(height int64) error
+
+	// GetRetainHeight returns the retain height, or 0 if it was never set.
+	GetRetainHeight() (int64, error)
+
+	// Prune removes part of the transactions indexed below the retain height,
+	// doing an amount of work proportional to batchSize. It returns the number
+	// of deleted keys and whether all the transactions below the retain height
+	// have been removed.
+	Prune(batchSize int) (int, bool, error)
+
 	// Set Logger
 	SetLogger(l log.Logger)
 }
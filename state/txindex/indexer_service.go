@@ -2,6 +2,7 @@ package txindex
 
 import (
 	"context"
+	"time"
 
 	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/state/indexer"
@@ -12,8 +13,28 @@ import (
 
 const (
 	subscriber = "IndexerService"
+
+	// defaultPruneInterval is the default time between two pruning rounds.
+	defaultPruneInterval = 10 * time.Second
+	// pruneBatchSize bounds the work done by the indexers in a single Prune
+	// call, so that pruning does not hold up indexing.
+	pruneBatchSize = 1000
 )
 
+// IndexerServiceOption sets an optional parameter on the IndexerService.
+type IndexerServiceOption func(*IndexerService)
+
+// WithPruneInterval sets the time between two pruning rounds, in which the
+// data indexed below the retain height of the indexers is removed.
+func WithPruneInterval(d time.Duration) IndexerServiceOption {
+	return func(is *IndexerService) { is.pruneInterval = d }
+}
+
+// WithEventFilter sets the filter selecting the event attributes to index.
+func WithEventFilter(f *EventFilter) IndexerServiceOption {
+	return func(is *IndexerService) { is.eventFilter = f }
+}
+
 // IndexerService connects event bus, transaction and block indexers together in
 // order to index transactions and blocks coming from the event bus.
 type IndexerService struct {
@@ -23,6 +44,8 @@ type IndexerService struct {
 	blockIdxr        indexer.BlockIndexer
 	eventBus         *types.EventBus
 	terminateOnError bool
+	pruneInterval    time.Duration
+	eventFilter      *EventFilter
 }
 
 // NewIndexerService returns a new service instance.
@@ -31,10 +54,20 @@ func NewIndexerService(
 	blockIdxr indexer.BlockIndexer,
 	eventBus *types.EventBus,
 	terminateOnError bool,
+	options ...IndexerServiceOption,
 ) *IndexerService {
 
-	is := &IndexerService{txIdxr: txIdxr, blockIdxr: blockIdxr, eventBus: eventBus, terminateOnError: terminateOnError}
+	is := &IndexerService{
+		txIdxr:           txIdxr,
+		blockIdxr:        blockIdxr,
+		eventBus:         eventBus,
+		terminateOnError: terminateOnError,
+		pruneInterval:    defaultPruneInterval,
+	}
 	is.BaseService = *service.NewBaseService(nil, "IndexerService", is)
+	for _, option := range options {
+		option(is)
+	}
 	return is
 }
 
@@ -64,6 +97,7 @@ func (is *IndexerService) OnStart() error {
 				return
 			case msg := <-blockSub.Out():
 				eventNewBlockEvents := msg.Data().(types.EventDataNewBlockEvents)
+				eventNewBlockEvents.Events = is.eventFilter.Apply(eventNewBlockEvents.Events)
 				height := eventNewBlockEvents.Height
 				numTxs := eventNewBlockEvents.NumTxs
 
@@ -73,7 +107,7 @@ func (is *IndexerService) OnStart() error {
 					msg2 := <-txsSub.Out()
 					txResult := msg2.Data().(types.EventDataTx).TxResult
 
-					if err = batch.Add(&txResult); err != nil {
+					if err = batch.Add(is.eventFilter.ApplyTxResult(&txResult)); err != nil {
 						is.Logger.Error(
 							"failed to add tx to batch",
 							"height", height,
@@ -116,9 +150,59 @@ func (is *IndexerService) OnStart() error {
 			}
 		}
 	}()
+	go is.pruneRoutine()
 	return nil
 }
 
+// pruneRoutine periodically removes the data indexed below the retain height
+// of the indexers. Each indexer is pruned in bounded batches until it has
+// caught up with its retain height, so that indexing is never blocked for
+// long.
+func (is *IndexerService) pruneRoutine() {
+	ticker := time.NewTicker(is.pruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-is.Quit():
+			return
+		case <-ticker.C:
+		}
+		is.prune("tx", is.txIdxr)
+		is.prune("block", is.blockIdxr)
+	}
+}
+
+// pruner is the pruning method shared by the tx and block indexers.
+type pruner interface {
+	Prune(batchSize int) (int, bool, error)
+}
+
+// prune calls Prune on the indexer until it has caught up with its retain
+// height, or the service is stopped.
+func (is *IndexerService) prune(name string, idxr pruner) {
+	total := 0
+	for {
+		deleted, done, err := idxr.Prune(pruneBatchSize)
+		if err != nil {
+			is.Logger.Error("failed to prune indexer", "indexer", name, "err", err)
+			return
+		}
+		total += deleted
+		if done {
+			break
+		}
+		select {
+		case <-is.Quit():
+			return
+		default:
+		}
+	}
+	if total > 0 {
+		is.Logger.Info("pruned indexer", "indexer", name, "deleted_keys", total)
+	}
+}
+
 // OnStop implements service.Service by unsubscribing from all transactions.
 func (is *IndexerService) OnStop() {
 	if is.eventBus.IsRunning() {
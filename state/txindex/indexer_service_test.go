@@ -1,6 +1,8 @@
 package txindex_test
 
 import (
+	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
 	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/state/txindex/kv"
@@ -92,3 +95,143 @@ func TestIndexerServiceIndexesBlocks(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, txResult2, res)
 }
+
+func TestIndexerServicePrunes(t *testing.T) {
+	// event bus
+	eventBus := types.NewEventBus()
+	eventBus.SetLogger(log.TestingLogger())
+	err := eventBus.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// tx indexer
+	store := db.NewMemDB()
+	txIndexer := kv.NewTxIndex(store)
+	blockIndexer := blockidxkv.New(db.NewPrefixDB(store, []byte("block_events")))
+
+	service := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus, false,
+		txindex.WithPruneInterval(10*time.Millisecond))
+	service.SetLogger(log.TestingLogger())
+	err = service.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := service.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// publish blocks with one tx each
+	for height := int64(1); height <= 3; height++ {
+		err = eventBus.PublishEventNewBlockEvents(types.EventDataNewBlockEvents{
+			Height: height,
+			NumTxs: 1,
+		})
+		require.NoError(t, err)
+		err = eventBus.PublishEventTx(types.EventDataTx{TxResult: abci.TxResult{
+			Height: height,
+			Tx:     types.Tx(fmt.Sprint(height)),
+		}})
+		require.NoError(t, err)
+	}
+	require.Eventually(t, func() bool {
+		ok, err := blockIndexer.Has(3)
+		return err == nil && ok
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, txIndexer.SetRetainHeight(3))
+	require.NoError(t, blockIndexer.SetRetainHeight(3))
+
+	require.Eventually(t, func() bool {
+		res, err := txIndexer.Get(types.Tx("2").Hash())
+		if err != nil || res != nil {
+			return false
+		}
+		ok, err := blockIndexer.Has(2)
+		return err == nil && !ok
+	}, time.Second, 10*time.Millisecond)
+
+	res, err := txIndexer.Get(types.Tx("3").Hash())
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	ok, err := blockIndexer.Has(3)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestIndexerServiceFiltersEvents(t *testing.T) {
+	// event bus
+	eventBus := types.NewEventBus()
+	eventBus.SetLogger(log.TestingLogger())
+	err := eventBus.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// tx indexer
+	store := db.NewMemDB()
+	txIndexer := kv.NewTxIndex(store)
+	blockIndexer := blockidxkv.New(db.NewPrefixDB(store, []byte("block_events")))
+
+	service := txindex.NewIndexerService(txIndexer, blockIndexer, eventBus, false,
+		txindex.WithEventFilter(txindex.NewEventFilter(nil, []string{"begin_event", "transfer.memo"})))
+	service.SetLogger(log.TestingLogger())
+	err = service.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := service.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	events := []abci.Event{
+		{Type: "begin_event", Attributes: []abci.EventAttribute{{Key: "proposer", Value: "FCAA001", Index: true}}},
+		{Type: "end_event", Attributes: []abci.EventAttribute{{Key: "foo", Value: "100", Index: true}}},
+	}
+	err = eventBus.PublishEventNewBlockEvents(types.EventDataNewBlockEvents{
+		Height: 1,
+		Events: events,
+		NumTxs: 1,
+	})
+	require.NoError(t, err)
+	txEvents := []abci.Event{{Type: "transfer", Attributes: []abci.EventAttribute{
+		{Key: "sender", Value: "bob", Index: true},
+		{Key: "memo", Value: "hello", Index: true},
+	}}}
+	err = eventBus.PublishEventTx(types.EventDataTx{TxResult: abci.TxResult{
+		Height: 1,
+		Tx:     types.Tx("foo"),
+		Result: abci.ExecTxResult{Events: txEvents},
+	}})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		res, err := txIndexer.Get(types.Tx("foo").Hash())
+		return err == nil && res != nil
+	}, time.Second, 10*time.Millisecond)
+
+	ctx := context.Background()
+	heights, err := blockIndexer.Search(ctx, query.MustCompile("end_event.foo = 100"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1}, heights)
+	heights, err = blockIndexer.Search(ctx, query.MustCompile("begin_event.proposer = 'FCAA001'"))
+	require.NoError(t, err)
+	require.Empty(t, heights)
+
+	results, err := txIndexer.Search(ctx, query.MustCompile("transfer.sender = 'bob'"))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	results, err = txIndexer.Search(ctx, query.MustCompile("transfer.memo = 'hello'"))
+	require.NoError(t, err)
+	require.Empty(t, results)
+
+	// The published events are left untouched.
+	require.True(t, events[0].Attributes[0].Index)
+	require.True(t, txEvents[0].Attributes[1].Index)
+}
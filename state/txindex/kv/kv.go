@@ -6,7 +6,9 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -20,6 +22,7 @@ import (
 	idxutil "github.com/cometbft/cometbft/internal/indexer"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
 	"github.com/cometbft/cometbft/libs/pubsub/query/syntax"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/types"
@@ -29,6 +32,19 @@ const (
 	tagKeySeparator     = "/"
 	tagKeySeparatorRune = '/'
 	eventSeqSeparator   = "$es$"
+	// positionKeyName is the name of the keys of the index of transactions by
+	// position, ordered by height and index.
+	positionKeyName = "txPosition"
+)
+
+var (
+	// retainHeightKey stores the height below which transactions are pruned.
+	retainHeightKey = []byte("txIndexerRetainHeight")
+	// prunedHeightKey stores the lowest height which was not pruned yet.
+	prunedHeightKey = []byte("txIndexerPrunedHeight")
+	// positionIndexedFromKey stores the first height indexed with the index
+	// of transactions by position.
+	positionIndexedFromKey = []byte("txIndexerPositionIndexedFrom")
 )
 
 var _ txindex.TxIndexer = (*TxIndex)(nil)
@@ -39,6 +55,12 @@ type TxIndex struct {
 	// Number the events in the event list
 	eventSeq int64
 
+	// serializes the updates of the transaction results with pruning, so that
+	// a result re-indexed at a new height is not deleted with the old one
+	mtx cmtsync.Mutex
+	// whether positionIndexedFromKey is known to be set
+	positionIndexed bool
+
 	log log.Logger
 }
 
@@ -82,12 +104,19 @@ func (txi *TxIndex) Get(hash []byte) (*abci.TxResult, error) {
 // the respective attribute's key delimited by a "." (eg. "account.number").
 // Any event with an empty type is not indexed.
 func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
+	txi.mtx.Lock()
+	defer txi.mtx.Unlock()
+
 	storeBatch := txi.store.NewBatch()
 	defer storeBatch.Close()
 
 	for _, result := range b.Ops {
 		hash := types.Tx(result.Tx).Hash()
 
+		if err := txi.deleteStaleEvents(hash, storeBatch); err != nil {
+			return err
+		}
+
 		// index tx by events
 		err := txi.indexEvents(result, hash, storeBatch)
 		if err != nil {
@@ -100,6 +129,15 @@ func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
 			return err
 		}
 
+		// index by position (always)
+		err = storeBatch.Set(positionKey(indexer.Cursor{Height: result.Height, Index: result.Index}), hash)
+		if err != nil {
+			return err
+		}
+		if err := txi.markPositionIndexed(result.Height, storeBatch); err != nil {
+			return err
+		}
+
 		rawBytes, err := proto.Marshal(result)
 		if err != nil {
 			return err
@@ -124,26 +162,34 @@ func (txi *TxIndex) AddBatch(b *txindex.Batch) error {
 // more transactions that successfully executed overwrite transactions that failed
 // or successful yet older transactions.
 func (txi *TxIndex) Index(result *abci.TxResult) error {
+	txi.mtx.Lock()
+	defer txi.mtx.Unlock()
+
 	b := txi.store.NewBatch()
 	defer b.Close()
 
 	hash := types.Tx(result.Tx).Hash()
 
-	if !result.Result.IsOK() {
-		oldResult, err := txi.Get(hash)
-		if err != nil {
-			return err
-		}
+	oldResult, err := txi.Get(hash)
+	if err != nil {
+		return err
+	}
+
+	// if the new transaction failed and it's already indexed in an older block and was successful
+	// we skip it as we want users to get the older successful transaction when they query.
+	if !result.Result.IsOK() && oldResult != nil && oldResult.Result.Code == abci.CodeTypeOK {
+		return nil
+	}
 
-		// if the new transaction failed and it's already indexed in an older block and was successful
-		// we skip it as we want users to get the older successful transaction when they query.
-		if oldResult != nil && oldResult.Result.Code == abci.CodeTypeOK {
-			return nil
+	// see deleteStaleEvents
+	if oldResult != nil {
+		if _, err := txi.pruneEvents(oldResult, b); err != nil {
+			return err
 		}
 	}
 
 	// index tx by events
-	err := txi.indexEvents(result, hash, b)
+	err = txi.indexEvents(result, hash, b)
 	if err != nil {
 		return err
 	}
@@ -154,6 +200,15 @@ func (txi *TxIndex) Index(result *abci.TxResult) error {
 		return err
 	}
 
+	// index by position (always)
+	err = b.Set(positionKey(indexer.Cursor{Height: result.Height, Index: result.Index}), hash)
+	if err != nil {
+		return err
+	}
+	if err := txi.markPositionIndexed(result.Height, b); err != nil {
+		return err
+	}
+
 	rawBytes, err := proto.Marshal(result)
 	if err != nil {
 		return err
@@ -200,12 +255,15 @@ func (txi *TxIndex) indexEvents(result *abci.TxResult, hash []byte, store dbm.Ba
 
 // Search performs a search using the given query.
 //
-// It breaks the query into conditions (like "tx.height > 5"). For each
-// condition, it queries the DB index. One special use cases here: (1) if
-// "tx.hash" is found, it returns tx result for it (2) for range queries it is
-// better for the client to provide both lower and upper bounds, so we are not
-// performing a full scan. Results from querying indexes are then intersected
-// and returned to the caller, in no particular order.
+// The query is first put in disjunctive normal form: a disjunction of
+// conjunctions of possibly negated conditions. Each conjunction is broken into
+// conditions (like "tx.height > 5"). For each condition, it queries the DB
+// index. One special use cases here: (1) if "tx.hash" is found, it returns tx
+// result for it (2) for range queries it is better for the client to provide
+// both lower and upper bounds, so we are not performing a full scan. Results
+// from querying indexes are then intersected, the transactions matching a
+// negated condition are removed, and the results of all the conjunctions are
+// merged and returned to the caller, in no particular order.
 //
 // Search will exit early and return any result fetched so far,
 // when a message is received on the context chan.
@@ -217,26 +275,574 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 	default:
 	}
 
+	refs, err := txi.searchRefs(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*abci.TxResult, 0, len(refs))
+RESULTS_LOOP:
+	for _, ref := range refs {
+
+		res, err := txi.Get(ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Tx{%X}: %w", ref, err)
+		}
+		if res != nil {
+			results = append(results, res)
+		}
+		// Potentially exit early.
+		select {
+		case <-ctx.Done():
+			break RESULTS_LOOP
+		default:
+		}
+	}
+
+	return results, nil
+}
+
+// SearchPage returns a page of the transactions matching the query, and
+// reports whether more transactions follow the page.
+//
+// The candidate transactions of each conjunction of the query are read from
+// the index of one of its conditions on event attributes, or from the index
+// by height if it has a single height. Those of the conjunctions made only of
+// conditions on a range of heights are visited in order using the index of
+// transactions by position, so that the cost of a page does not depend on the
+// number of transactions preceding it. The candidates are visited in the
+// order of the page, from its cursor, and matched against the query like in
+// Search, i.e. the conditions of a conjunction on event attributes must be
+// satisfied by the same event, until the page is full. A query looking for
+// transactions by hash is evaluated like in Search instead.
+//
+// The transactions indexed by versions without the index by position are
+// found from the index by height below the first height indexed with it.
+func (txi *TxIndex) SearchPage(ctx context.Context, q *query.Query, page indexer.Page) ([]*abci.TxResult, bool, error) {
+	if page.Limit <= 0 {
+		return nil, false, errors.New("page limit must be positive")
+	}
+
+	conjunctions, err := q.DNF()
+	if err != nil {
+		return nil, false, err
+	}
+	byHash := true
+	for _, conj := range conjunctions {
+		if len(conj.Conditions) == 0 && len(conj.Negated) != 0 {
+			return nil, false, errors.New("negated conditions must be combined with at least one other condition")
+		}
+		if _, ok, _ := lookForHash(conj.Conditions); !ok {
+			byHash = false
+		}
+	}
+	if byHash {
+		return txi.searchPageByHash(ctx, q, page)
+	}
+	matcher, err := newTxMatcher(conjunctions)
+	if err != nil {
+		return nil, false, err
+	}
+	positionIndexedFrom, err := txi.positionIndexedFrom()
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Collect the candidates read from the condition indexes, and the height
+	// range of the conjunctions visited using the index by position.
+	candidates := make(map[indexer.Cursor][]byte)
+	scanLo, scanHi := int64(math.MaxInt64), int64(0)
+	for _, conj := range conjunctions {
+		lo, hi := indexer.HeightBounds([]syntax.Conjunction{conj}, types.TxHeightKey)
+		if lo > hi {
+			continue
+		}
+		if c, ok := drivingCondition(conj.Conditions); ok {
+			err = txi.collectCandidates(ctx, c, startKeyForDriving(c), lo, hi, page, candidates)
+		} else if lo == hi {
+			err = txi.collectCandidates(ctx, syntax.Condition{}, startKey(types.TxHeightKey, lo, lo), lo, hi, page, candidates)
+		} else {
+			scanLo, scanHi = min(scanLo, lo), max(scanHi, hi)
+			if lo < positionIndexedFrom {
+				err = txi.collectCandidates(ctx, syntax.Condition{}, startKey(types.TxHeightKey),
+					lo, min(hi, positionIndexedFrom-1), page, candidates)
+			}
+		}
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	sorted := make([]indexer.Cursor, 0, len(candidates))
+	for pos := range candidates {
+		sorted = append(sorted, pos)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return page.Before(sorted[i], sorted[j]) })
+
+	var it dbm.Iterator
+	if scanLo <= scanHi {
+		if it, err = txi.positionIterator(scanLo, scanHi, page); err != nil {
+			return nil, false, err
+		}
+		defer it.Close()
+	}
+
+	// Merge the candidates with the positions visited in order.
+	results := make([]*abci.TxResult, 0, page.Limit)
+	for {
+		var (
+			pos  indexer.Cursor
+			hash []byte
+		)
+		if it != nil && it.Valid() {
+			itPos, err := parsePositionKey(it.Key())
+			if err != nil {
+				txi.log.Error("failure to parse position from key:", err)
+				it.Next()
+				continue
+			}
+			pos, hash = itPos, it.Value()
+		}
+		switch {
+		case len(sorted) > 0 && (hash == nil || page.Before(sorted[0], pos)):
+			pos, hash = sorted[0], candidates[sorted[0]]
+			sorted = sorted[1:]
+		case hash != nil:
+			if len(sorted) > 0 && sorted[0] == pos {
+				sorted = sorted[1:]
+			}
+			hash = append([]byte(nil), hash...)
+			it.Next()
+		default:
+			if it != nil {
+				if err := it.Error(); err != nil {
+					return nil, false, err
+				}
+			}
+			return results, false, nil
+		}
+
+		res, err := txi.Get(hash)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get Tx{%X}: %w", hash, err)
+		}
+		// Skip the former positions of transactions indexed again at another
+		// height.
+		if res == nil || res.Height != pos.Height || res.Index != pos.Index {
+			continue
+		}
+		if ok, err := matcher.matches(res); err != nil {
+			return nil, false, err
+		} else if !ok {
+			continue
+		}
+		if len(results) == page.Limit {
+			return results, true, nil
+		}
+		results = append(results, res)
+
+		select {
+		case <-ctx.Done():
+			return nil, false, ctx.Err()
+
+		default:
+		}
+	}
+}
+
+// markPositionIndexed records height as the first height indexed with the
+// index by position, unless one was recorded already.
+func (txi *TxIndex) markPositionIndexed(height int64, batch dbm.Batch) error {
+	if txi.positionIndexed {
+		return nil
+	}
+	from, err := getInt64(txi.store, positionIndexedFromKey)
+	if err != nil {
+		return err
+	}
+	if from == 0 {
+		if err := batch.Set(positionIndexedFromKey, int64ToBytes(height)); err != nil {
+			return err
+		}
+	}
+	txi.positionIndexed = true
+	return nil
+}
+
+// positionIndexedFrom returns the first height indexed with the index by
+// position, or math.MaxInt64 if there is none: the transactions below it may
+// be missing from this index.
+func (txi *TxIndex) positionIndexedFrom() (int64, error) {
+	from, err := getInt64(txi.store, positionIndexedFromKey)
+	if err != nil || from == 0 {
+		return math.MaxInt64, err
+	}
+	return from, nil
+}
+
+// positionIterator returns an iterator over the index of transactions by
+// position, between the heights lo and hi included, following the cursor of
+// the page in its order.
+func (txi *TxIndex) positionIterator(lo, hi int64, page indexer.Page) (dbm.Iterator, error) {
+	from := indexer.Cursor{Height: lo}
+	var to *indexer.Cursor // exclusive, nil if unbounded
+	if hi < math.MaxInt64 {
+		to = &indexer.Cursor{Height: hi + 1}
+	}
+	if after := page.After; after != nil && page.Desc {
+		if to == nil || after.Less(*to) {
+			to = after
+		}
+	} else if after != nil {
+		next := indexer.Cursor{Height: after.Height, Index: after.Index + 1}
+		if after.Index == math.MaxUint32 {
+			next = indexer.Cursor{Height: after.Height + 1}
+		}
+		if from.Less(next) {
+			from = next
+		}
+	}
+	end := positionKeyEnd()
+	if to != nil {
+		end = positionKey(*to)
+	}
+
+	var (
+		it  dbm.Iterator
+		err error
+	)
+	if page.Desc {
+		it, err = txi.store.ReverseIterator(positionKey(from), end)
+	} else {
+		it, err = txi.store.Iterator(positionKey(from), end)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create iterator: %w", err)
+	}
+	return it, nil
+}
+
+// drivingCondition returns the condition on an event attribute whose index
+// is read to find the candidates matching the conditions, preferring the most
+// selective operators.
+func drivingCondition(conditions []syntax.Condition) (syntax.Condition, bool) {
+	rank := func(c syntax.Condition) int {
+		switch c.Op {
+		case syntax.TEq:
+			return 0
+		case syntax.TStartsWith:
+			return 1
+		case syntax.TContains:
+			return 2
+		default:
+			return 3
+		}
+	}
+	var (
+		best  syntax.Condition
+		found bool
+	)
+	for _, c := range conditions {
+		if c.Tag == types.TxHeightKey || c.Tag == types.TxHashKey {
+			continue
+		}
+		if !found || rank(c) < rank(best) {
+			best, found = c, true
+		}
+	}
+	return best, found
+}
+
+// startKeyForDriving returns the prefix of the index keys which may satisfy
+// the driving condition c.
+func startKeyForDriving(c syntax.Condition) []byte {
+	switch c.Op {
+	case syntax.TEq:
+		return startKey(c.Tag, c.Arg.Value())
+	case syntax.TStartsWith:
+		return append(startKey(c.Tag), c.Arg.Value()...)
+	default:
+		return startKey(c.Tag)
+	}
+}
+
+// collectCandidates adds the positions and hashes of the index keys starting
+// with prefix, between the heights lo and hi included and following the
+// cursor of the page, to candidates. Unless c is the zero condition, only the
+// keys whose value satisfies it are added.
+func (txi *TxIndex) collectCandidates(
+	ctx context.Context,
+	c syntax.Condition,
+	prefix []byte,
+	lo, hi int64,
+	page indexer.Page,
+	candidates map[indexer.Cursor][]byte,
+) error {
+	var cq *query.Query
+	if c.Tag != "" {
+		var err error
+		if cq, err = query.Compile(syntax.Query{c}); err != nil {
+			return err
+		}
+	}
+
+	it, err := dbm.IteratePrefix(txi.store, prefix)
+	if err != nil {
+		return fmt.Errorf("failed to create iterator: %w", err)
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		if !isTagKey(key) {
+			continue
+		}
+		pos, err := extractPositionFromKey(key)
+		if err != nil {
+			txi.log.Error("failure to parse position from key:", err)
+			continue
+		}
+		if pos.Height < lo || pos.Height > hi || !page.Follows(pos) {
+			continue
+		}
+		if _, ok := candidates[pos]; ok {
+			continue
+		}
+		if cq != nil {
+			if ok, err := cq.Matches(map[string][]string{c.Tag: {extractValueFromKey(key)}}); err != nil || !ok {
+				continue
+			}
+		}
+		candidates[pos] = append([]byte(nil), it.Value()...)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		default:
+		}
+	}
+	return it.Error()
+}
+
+// txMatcher matches transaction results against the conjunctions of a query
+// with the semantics of Search: the conditions of a conjunction on event
+// attributes must be satisfied by the same event, while a negated condition
+// excludes the transaction if any of its events satisfies it.
+type txMatcher []conjunctionMatcher
+
+type conjunctionMatcher struct {
+	tx      *query.Query // the conditions on tx.height and tx.hash, nil if none
+	event   *query.Query // the conditions on event attributes, nil if none
+	negated []*query.Query
+}
+
+func newTxMatcher(conjunctions []syntax.Conjunction) (txMatcher, error) {
+	m := make(txMatcher, 0, len(conjunctions))
+	for _, conj := range conjunctions {
+		var txConds, eventConds syntax.Query
+		for _, c := range conj.Conditions {
+			if c.Tag == types.TxHeightKey || c.Tag == types.TxHashKey {
+				txConds = append(txConds, c)
+			} else {
+				eventConds = append(eventConds, c)
+			}
+		}
+		var (
+			cm  conjunctionMatcher
+			err error
+		)
+		if len(txConds) > 0 {
+			if cm.tx, err = query.Compile(txConds); err != nil {
+				return nil, err
+			}
+		}
+		if len(eventConds) > 0 {
+			if cm.event, err = query.Compile(eventConds); err != nil {
+				return nil, err
+			}
+		}
+		for _, c := range conj.Negated {
+			nq, err := query.Compile(syntax.Query{c})
+			if err != nil {
+				return nil, err
+			}
+			cm.negated = append(cm.negated, nq)
+		}
+		m = append(m, cm)
+	}
+	return m, nil
+}
+
+// matches reports whether the transaction result matches any of the
+// conjunctions.
+func (m txMatcher) matches(result *abci.TxResult) (bool, error) {
+	all := indexedEvents(result)
+	txFields := map[string][]string{
+		types.TxHashKey:   all[types.TxHashKey],
+		types.TxHeightKey: all[types.TxHeightKey],
+	}
+	var events []map[string][]string
+	for _, event := range result.Result.Events {
+		if len(event.Type) == 0 {
+			continue
+		}
+		attrs := make(map[string][]string)
+		for _, attr := range event.Attributes {
+			if len(attr.Key) == 0 || !attr.GetIndex() {
+				continue
+			}
+			compositeTag := fmt.Sprintf("%s.%s", event.Type, attr.Key)
+			attrs[compositeTag] = append(attrs[compositeTag], attr.Value)
+		}
+		events = append(events, attrs)
+	}
+
+CONJ_LOOP:
+	for _, cm := range m {
+		ok, err := cm.tx.Matches(txFields)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			continue
+		}
+		if cm.event != nil {
+			ok = false
+			for _, attrs := range events {
+				if ok, err = cm.event.Matches(attrs); err != nil {
+					return false, err
+				} else if ok {
+					break
+				}
+			}
+			if !ok {
+				continue
+			}
+		}
+		for _, nq := range cm.negated {
+			ok, err := nq.Matches(all)
+			if err != nil {
+				return false, err
+			}
+			if ok {
+				continue CONJ_LOOP
+			}
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// searchPageByHash returns a page of the transactions matching a query
+// looking for transactions by hash, which are few, after evaluating the query
+// like Search.
+func (txi *TxIndex) searchPageByHash(ctx context.Context, q *query.Query, page indexer.Page) ([]*abci.TxResult, bool, error) {
+	refs, err := txi.searchRefs(ctx, q)
+	if err != nil {
+		return nil, false, err
+	}
+
+	results := make([]*abci.TxResult, 0, len(refs))
+	for _, ref := range refs {
+		res, err := txi.Get(ref)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get Tx{%X}: %w", ref, err)
+		}
+		if res != nil && page.Follows(indexer.Cursor{Height: res.Height, Index: res.Index}) {
+			results = append(results, res)
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return page.Before(
+			indexer.Cursor{Height: results[i].Height, Index: results[i].Index},
+			indexer.Cursor{Height: results[j].Height, Index: results[j].Index},
+		)
+	})
+
+	more := len(results) > page.Limit
+	if more {
+		results = results[:page.Limit]
+	}
+	return results, more, nil
+}
+
+// indexedEvents returns the events of the transaction result which can be
+// searched: its hash and height, and the attributes of its events flagged for
+// indexing, keyed by composite key.
+func indexedEvents(result *abci.TxResult) map[string][]string {
+	events := map[string][]string{
+		types.TxHashKey:   {fmt.Sprintf("%X", types.Tx(result.Tx).Hash())},
+		types.TxHeightKey: {strconv.FormatInt(result.Height, 10)},
+	}
+	for _, event := range result.Result.Events {
+		if len(event.Type) == 0 {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if len(attr.Key) == 0 || !attr.GetIndex() {
+				continue
+			}
+			compositeTag := fmt.Sprintf("%s.%s", event.Type, attr.Key)
+			events[compositeTag] = append(events[compositeTag], attr.Value)
+		}
+	}
+	return events
+}
+
+// searchRefs returns the transactions matching the query, by hash.
+func (txi *TxIndex) searchRefs(ctx context.Context, q *query.Query) (map[string][]byte, error) {
+	conjunctions, err := q.DNF()
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make(map[string][]byte)
+	for _, conj := range conjunctions {
+		if len(conj.Conditions) == 0 && len(conj.Negated) != 0 {
+			return nil, errors.New("negated conditions must be combined with at least one other condition")
+		}
+		matches, err := txi.searchConjunction(ctx, conj.Conditions)
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range conj.Negated {
+			if len(matches) == 0 {
+				break
+			}
+			excluded, err := txi.searchConjunction(ctx, []syntax.Condition{c})
+			if err != nil {
+				return nil, err
+			}
+			excludedSet := make(map[string]struct{}, len(excluded))
+			for _, ref := range excluded {
+				excludedSet[string(ref)] = struct{}{}
+			}
+			for k, ref := range matches {
+				if _, ok := excludedSet[string(ref)]; ok {
+					delete(matches, k)
+				}
+			}
+		}
+		for _, ref := range matches {
+			refs[string(ref)] = ref
+		}
+	}
+	return refs, nil
+}
+
+// searchConjunction returns the transactions matching all the given
+// conditions. A transaction may be found under several keys.
+func (txi *TxIndex) searchConjunction(ctx context.Context, conditions []syntax.Condition) (map[string][]byte, error) {
 	var hashesInitialized bool
 	filteredHashes := make(map[string][]byte)
 
-	// get a list of conditions (like "tx.height > 5")
-	conditions := q.Syntax()
-
 	// if there is a hash condition, return the result immediately
 	hash, ok, err := lookForHash(conditions)
 	if err != nil {
 		return nil, fmt.Errorf("error during searching for a hash in the query: %w", err)
 	} else if ok {
-		res, err := txi.Get(hash)
-		switch {
-		case err != nil:
-			return []*abci.TxResult{}, fmt.Errorf("error while retrieving the result: %w", err)
-		case res == nil:
-			return []*abci.TxResult{}, nil
-		default:
-			return []*abci.TxResult{res}, nil
-		}
+		filteredHashes[string(hash)] = hash
+		return filteredHashes, nil
 	}
 
 	// conditions to skip because they're handled before "everything else"
@@ -305,29 +911,7 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 		}
 	}
 
-	results := make([]*abci.TxResult, 0, len(filteredHashes))
-	resultMap := make(map[string]struct{})
-RESULTS_LOOP:
-	for _, h := range filteredHashes {
-
-		res, err := txi.Get(h)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
-		}
-		hashString := string(h)
-		if _, ok := resultMap[hashString]; !ok {
-			resultMap[hashString] = struct{}{}
-			results = append(results, res)
-		}
-		// Potentially exit early.
-		select {
-		case <-ctx.Done():
-			break RESULTS_LOOP
-		default:
-		}
-	}
-
-	return results, nil
+	return filteredHashes, nil
 }
 
 func lookForHash(conditions []syntax.Condition) (hash []byte, ok bool, err error) {
@@ -448,11 +1032,16 @@ func (txi *TxIndex) match(
 			panic(err)
 		}
 
-	case syntax.TContains:
+	case syntax.TContains, syntax.TStartsWith:
 		// XXX: startKey does not apply here.
 		// For example, if startKey = "account.owner/an/" and search query = "account.owner CONTAINS an"
 		// we can't iterate with prefix "account.owner/an/" because we might miss keys like "account.owner/Ulan/"
-		it, err := dbm.IteratePrefix(txi.store, startKey(c.Tag))
+		// A prefix match can still narrow the iteration to "account.owner/an".
+		prefix, matches := startKey(c.Tag), strings.Contains
+		if c.Op == syntax.TStartsWith {
+			prefix, matches = append(prefix, c.Arg.Value()...), strings.HasPrefix
+		}
+		it, err := dbm.IteratePrefix(txi.store, prefix)
 		if err != nil {
 			panic(err)
 		}
@@ -464,7 +1053,7 @@ func (txi *TxIndex) match(
 				continue
 			}
 
-			if strings.Contains(extractValueFromKey(it.Key()), c.Arg.Value()) {
+			if matches(extractValueFromKey(it.Key()), c.Arg.Value()) {
 				key := it.Key()
 				keyHeight, err := extractHeightFromKey(key)
 				if err != nil {
@@ -654,6 +1243,186 @@ REMOVE_LOOP:
 	return filteredHashes
 }
 
+// SetRetainHeight sets the height below which transactions are pruned. It
+// returns an error if the height is lower than the current retain height.
+func (txi *TxIndex) SetRetainHeight(height int64) error {
+	current, err := txi.GetRetainHeight()
+	if err != nil {
+		return err
+	}
+	if height < current {
+		return fmt.Errorf("cannot decrease the tx indexer retain height from %d to %d", current, height)
+	}
+	return txi.store.SetSync(retainHeightKey, int64ToBytes(height))
+}
+
+// GetRetainHeight returns the height below which transactions are pruned, or
+// 0 if it was never set.
+func (txi *TxIndex) GetRetainHeight() (int64, error) {
+	return getInt64(txi.store, retainHeightKey)
+}
+
+// Prune deletes the transactions indexed below the retain height, one height
+// at a time starting from the lowest height not pruned yet, until the number
+// of visited heights and deleted keys reaches batchSize. It returns the number
+// of deleted keys and whether all the heights below the retain height have
+// been pruned.
+//
+// Event keys are found from the events of the indexed results, so the events
+// of a transaction which was indexed again at a later height are not deleted
+// along with the older height.
+func (txi *TxIndex) Prune(batchSize int) (int, bool, error) {
+	retainHeight, err := txi.GetRetainHeight()
+	if err != nil {
+		return 0, false, err
+	}
+	height, err := getInt64(txi.store, prunedHeightKey)
+	if err != nil {
+		return 0, false, err
+	}
+	if height < 1 {
+		height = 1
+	}
+
+	txi.mtx.Lock()
+	defer txi.mtx.Unlock()
+
+	batch := txi.store.NewBatch()
+	defer batch.Close()
+
+	work, deleted := 0, 0
+	for ; height < retainHeight && work < batchSize; height++ {
+		n, err := txi.pruneHeight(height, batch)
+		if err != nil {
+			return 0, false, fmt.Errorf("pruning height %d: %w", height, err)
+		}
+		work += n + 1
+		deleted += n
+	}
+	if err := batch.Set(prunedHeightKey, int64ToBytes(height)); err != nil {
+		return 0, false, err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return 0, false, err
+	}
+	return deleted, height >= retainHeight, nil
+}
+
+// DeleteHeight deletes all the keys of the transactions indexed at the given
+// height. Re-indexing a height after deleting it drops the keys of the events
+// which are no longer indexed.
+func (txi *TxIndex) DeleteHeight(height int64) error {
+	txi.mtx.Lock()
+	defer txi.mtx.Unlock()
+
+	batch := txi.store.NewBatch()
+	defer batch.Close()
+
+	if _, err := txi.pruneHeight(height, batch); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// pruneHeight adds the deletion of all the keys of the transactions indexed at
+// the given height to the batch, and returns the number of deleted keys.
+func (txi *TxIndex) pruneHeight(height int64, batch dbm.Batch) (int, error) {
+	var heightKeys, hashes [][]byte
+	it, err := dbm.IteratePrefix(txi.store, startKey(types.TxHeightKey, height, height))
+	if err != nil {
+		return 0, err
+	}
+	for ; it.Valid(); it.Next() {
+		heightKeys = append(heightKeys, append([]byte(nil), it.Key()...))
+		hashes = append(hashes, append([]byte(nil), it.Value()...))
+	}
+	if err := it.Error(); err != nil {
+		it.Close()
+		return 0, err
+	}
+	it.Close()
+
+	deleted := 0
+	for i, hash := range hashes {
+		result, err := txi.Get(hash)
+		if err != nil {
+			return 0, err
+		}
+		if result != nil && result.Height == height {
+			n, err := txi.pruneEvents(result, batch)
+			if err != nil {
+				return 0, err
+			}
+			if err := batch.Delete(hash); err != nil {
+				return 0, err
+			}
+			deleted += n + 1
+		}
+		if err := batch.Delete(heightKeys[i]); err != nil {
+			return 0, err
+		}
+		deleted++
+		if pos, err := extractPositionFromKey(heightKeys[i]); err == nil {
+			if err := batch.Delete(positionKey(pos)); err != nil {
+				return 0, err
+			}
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// deleteStaleEvents adds the deletion of the event keys of the result
+// previously indexed for the given hash, if any, to the batch. The pruning of
+// the height of that result can no longer find them once the result is
+// overwritten.
+func (txi *TxIndex) deleteStaleEvents(hash []byte, batch dbm.Batch) error {
+	oldResult, err := txi.Get(hash)
+	if err != nil || oldResult == nil {
+		return err
+	}
+	_, err = txi.pruneEvents(oldResult, batch)
+	return err
+}
+
+// pruneEvents adds the deletion of the event keys of the transaction result
+// to the batch, and returns the number of deleted keys.
+func (txi *TxIndex) pruneEvents(result *abci.TxResult, batch dbm.Batch) (int, error) {
+	deleted := 0
+	for _, event := range result.Result.Events {
+		if len(event.Type) == 0 {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if len(attr.Key) == 0 || !attr.GetIndex() {
+				continue
+			}
+			// keys without an event sequence were written by older versions
+			key := fmt.Sprintf("%s.%s/%s/%d/%d", event.Type, attr.Key, attr.Value, result.Height, result.Index)
+			if err := batch.Delete([]byte(key)); err != nil {
+				return 0, err
+			}
+			it, err := dbm.IteratePrefix(txi.store, []byte(key+eventSeqSeparator))
+			if err != nil {
+				return 0, err
+			}
+			for ; it.Valid(); it.Next() {
+				if err := batch.Delete(append([]byte(nil), it.Key()...)); err != nil {
+					it.Close()
+					return 0, err
+				}
+				deleted++
+			}
+			if err := it.Error(); err != nil {
+				it.Close()
+				return 0, err
+			}
+			it.Close()
+		}
+	}
+	return deleted, nil
+}
+
 // Keys
 
 func isTagKey(key []byte) bool {
@@ -695,6 +1464,24 @@ func extractHeightFromKey(key []byte) (int64, error) {
 	return height, nil
 }
 
+// extractPositionFromKey returns the height and index of the transaction of
+// an event or height key.
+func extractPositionFromKey(key []byte) (indexer.Cursor, error) {
+	height, err := extractHeightFromKey(key)
+	if err != nil {
+		return indexer.Cursor{}, err
+	}
+	last := key[bytes.LastIndexByte(key, tagKeySeparatorRune)+1:]
+	if i := bytes.Index(last, []byte(eventSeqSeparator)); i != -1 {
+		last = last[:i]
+	}
+	index, err := strconv.ParseUint(string(last), 10, 32)
+	if err != nil {
+		return indexer.Cursor{}, err
+	}
+	return indexer.Cursor{Height: height, Index: uint32(index)}, nil
+}
+
 func extractValueFromKey(key []byte) string {
 	// Find the positions of tagKeySeparator in the byte slice
 	var indices []int
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"testing"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -15,6 +16,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	idxpkg "github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
 	"github.com/cometbft/cometbft/types"
 )
@@ -662,6 +664,273 @@ func TestTxSearchMultipleTxs(t *testing.T) {
 	require.Len(t, results, 3)
 }
 
+func TestTxSearchExpressions(t *testing.T) {
+	indexer := NewTxIndex(db.NewMemDB())
+
+	owners := []string{"Ivan", "Igor", "Vlad", "Ivanka"}
+	for i, owner := range owners {
+		txResult := txResultWithEvents([]abci.Event{
+			{Type: "account", Attributes: []abci.EventAttribute{
+				{Key: "number", Value: fmt.Sprint(i + 1), Index: true},
+				{Key: "owner", Value: owner, Index: true},
+			}},
+		})
+		txResult.Tx = types.Tx(owner + "'s account")
+		txResult.Height = int64(i + 1)
+		require.NoError(t, indexer.Index(txResult))
+	}
+	// A transaction without an owner.
+	txResult := txResultWithEvents([]abci.Event{
+		{Type: "account", Attributes: []abci.EventAttribute{{Key: "number", Value: "5", Index: true}}},
+	})
+	txResult.Tx = types.Tx("anonymous account")
+	txResult.Height = 5
+	require.NoError(t, indexer.Index(txResult))
+
+	testCases := []struct {
+		q       string
+		heights []int64
+	}{
+		{"account.owner = 'Ivan' OR account.owner = 'Vlad'", []int64{1, 3}},
+		{"account.owner IN ('Ivan', 'Vlad', 'Pavel')", []int64{1, 3}},
+		{"account.owner STARTS_WITH 'Iv'", []int64{1, 4}},
+		{"account.owner STARTS_WITH 'van'", nil},
+		{"account.number >= 1 AND NOT account.owner EXISTS", []int64{5}},
+		{"account.number > 1 AND NOT account.owner STARTS_WITH 'I'", []int64{3, 5}},
+		{"account.number <= 4 AND NOT (account.owner = 'Igor' OR account.number = 3)", []int64{1, 4}},
+		{"(account.owner = 'Igor' OR account.number = 5) AND tx.height > 2", []int64{5}},
+		{"account.owner IN ('Igor', 'Vlad') AND account.number IN (3, 4)", []int64{3}},
+		{"tx.height = 1 OR account.number = 4", []int64{1, 4}},
+	}
+
+	ctx := context.Background()
+	for _, tc := range testCases {
+		t.Run(tc.q, func(t *testing.T) {
+			results, err := indexer.Search(ctx, query.MustCompile(tc.q))
+			require.NoError(t, err)
+
+			var heights []int64
+			for _, res := range results {
+				heights = append(heights, res.Height)
+			}
+			sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+			assert.Equal(t, tc.heights, heights)
+		})
+	}
+
+	_, err := indexer.Search(ctx, query.MustCompile("NOT account.owner EXISTS"))
+	require.Error(t, err)
+}
+
+func TestTxSearchPage(t *testing.T) {
+	store := db.NewMemDB()
+	indexer := NewTxIndex(store)
+
+	// Three transactions per height, indexed out of order, with heights that
+	// do not sort as strings.
+	for _, height := range []int64{10, 2, 9, 1} {
+		for index := uint32(0); index < 3; index++ {
+			txResult := txResultWithEvents([]abci.Event{
+				{Type: "account", Attributes: []abci.EventAttribute{
+					{Key: "owner", Value: fmt.Sprintf("owner%d", index), Index: true},
+					{Key: "number", Value: fmt.Sprint(index), Index: true},
+				}},
+				{Type: "transfer", Attributes: []abci.EventAttribute{
+					{Key: "amount", Value: fmt.Sprint(index), Index: true},
+				}},
+			})
+			txResult.Tx = types.Tx(fmt.Sprintf("tx %d/%d", height, index))
+			txResult.Height = height
+			txResult.Index = index
+			require.NoError(t, indexer.Index(txResult))
+		}
+	}
+
+	positions := func(results []*abci.TxResult) []idxpkg.Cursor {
+		var out []idxpkg.Cursor
+		for _, res := range results {
+			out = append(out, idxpkg.Cursor{Height: res.Height, Index: res.Index})
+		}
+		return out
+	}
+	pos := func(height int64, index uint32) idxpkg.Cursor {
+		return idxpkg.Cursor{Height: height, Index: index}
+	}
+	ctx := context.Background()
+
+	testCases := []struct {
+		q    string
+		desc bool
+		want []idxpkg.Cursor
+	}{
+		{"account.owner EXISTS", false, []idxpkg.Cursor{
+			pos(1, 0), pos(1, 1), pos(1, 2), pos(2, 0), pos(2, 1), pos(2, 2), pos(9, 0), pos(9, 1), pos(9, 2), pos(10, 0), pos(10, 1), pos(10, 2),
+		}},
+		{"account.owner = 'owner1'", true, []idxpkg.Cursor{pos(10, 1), pos(9, 1), pos(2, 1), pos(1, 1)}},
+		{"tx.height > 1 AND NOT account.owner IN ('owner0', 'owner2')", false, []idxpkg.Cursor{pos(2, 1), pos(9, 1), pos(10, 1)}},
+		{fmt.Sprintf("tx.hash = '%X'", types.Tx("tx 9/2").Hash()), false, []idxpkg.Cursor{pos(9, 2)}},
+		{"account.owner = 'owner0' OR tx.height = 2", false, []idxpkg.Cursor{
+			pos(1, 0), pos(2, 0), pos(2, 1), pos(2, 2), pos(9, 0), pos(10, 0),
+		}},
+		{"tx.height >= 2 AND tx.height <= 9 AND account.owner = 'owner2'", true, []idxpkg.Cursor{pos(9, 2), pos(2, 2)}},
+		{"tx.height > 10", false, nil},
+		// the conditions of a conjunction must be satisfied by the same event
+		{"account.owner = 'owner1' AND account.number = 1", false, []idxpkg.Cursor{pos(1, 1), pos(2, 1), pos(9, 1), pos(10, 1)}},
+		{"account.owner = 'owner1' AND transfer.amount = 1", false, nil},
+		{"account.owner = 'owner1' AND account.number > 0 AND tx.height < 9", true, []idxpkg.Cursor{pos(2, 1), pos(1, 1)}},
+		{"account.number >= 1 AND NOT transfer.amount = 2", false, []idxpkg.Cursor{pos(1, 1), pos(2, 1), pos(9, 1), pos(10, 1)}},
+	}
+	searchAll := func(t *testing.T, q *query.Query, desc bool) []idxpkg.Cursor {
+		t.Helper()
+		var got []idxpkg.Cursor
+		page := idxpkg.Page{Limit: 2, Desc: desc}
+		for {
+			results, more, err := indexer.SearchPage(ctx, q, page)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(results), page.Limit)
+			got = append(got, positions(results)...)
+			if !more {
+				break
+			}
+			require.Len(t, results, page.Limit)
+			last := got[len(got)-1]
+			page.After = &last
+		}
+		return got
+	}
+	for _, tc := range testCases {
+		t.Run(tc.q, func(t *testing.T) {
+			q := query.MustCompile(tc.q)
+			got := searchAll(t, q, tc.desc)
+			assert.Equal(t, tc.want, got)
+
+			// the pages hold the results of Search
+			results, err := indexer.Search(ctx, q)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, positions(results), got)
+		})
+	}
+
+	// a transaction indexed by a version without the index by position, at
+	// a height below the first one indexed with it
+	legacy := txResultWithEvents([]abci.Event{
+		{Type: "account", Attributes: []abci.EventAttribute{
+			{Key: "owner", Value: "owner1", Index: true},
+		}},
+	})
+	legacy.Tx = types.Tx("tx 5/0")
+	legacy.Height = 5
+	legacyHash := types.Tx(legacy.Tx).Hash()
+	rawBytes, err := proto.Marshal(legacy)
+	require.NoError(t, err)
+	require.NoError(t, store.Set(legacyHash, rawBytes))
+	require.NoError(t, store.Set(keyForHeight(legacy), legacyHash))
+	require.NoError(t, store.Set([]byte("account.owner/owner1/5/0"), legacyHash))
+	assert.Equal(t, []idxpkg.Cursor{pos(2, 1), pos(5, 0), pos(9, 1)},
+		searchAll(t, query.MustCompile("account.owner = 'owner1' AND tx.height > 1 AND tx.height < 10"), false))
+	assert.Equal(t, []idxpkg.Cursor{pos(9, 2), pos(9, 1), pos(9, 0), pos(5, 0), pos(2, 2), pos(2, 1), pos(2, 0)},
+		searchAll(t, query.MustCompile("tx.height >= 2 AND tx.height < 10"), true))
+
+	// a transaction indexed again is only found at its new position
+	reindexed := txResultWithEvents([]abci.Event{
+		{Type: "account", Attributes: []abci.EventAttribute{
+			{Key: "owner", Value: "owner0", Index: true},
+		}},
+	})
+	reindexed.Tx = types.Tx("tx 1/0")
+	reindexed.Height = 10
+	reindexed.Index = 3
+	require.NoError(t, indexer.Index(reindexed))
+	assert.Equal(t, []idxpkg.Cursor{pos(2, 0), pos(9, 0), pos(10, 0), pos(10, 3)},
+		searchAll(t, query.MustCompile("account.owner = 'owner0'"), false))
+
+	_, _, err = indexer.SearchPage(ctx, query.MustCompile("account.owner EXISTS"), idxpkg.Page{})
+	require.Error(t, err)
+}
+
+func TestTxIndexPrune(t *testing.T) {
+	store := db.NewMemDB()
+	indexer := NewTxIndex(store)
+
+	for height := int64(1); height <= 10; height++ {
+		batch := txindex.NewBatch(2)
+		for i := uint32(0); i < 2; i++ {
+			txResult := txResultWithEvents([]abci.Event{
+				{Type: "account", Attributes: []abci.EventAttribute{
+					{Key: "number", Value: fmt.Sprint(height), Index: true},
+				}},
+			})
+			txResult.Tx = types.Tx(fmt.Sprintf("tx-%d-%d", height, i))
+			txResult.Height = height
+			txResult.Index = i
+			require.NoError(t, batch.Add(txResult))
+		}
+		require.NoError(t, indexer.AddBatch(batch))
+	}
+	// a transaction indexed again at a later height is kept
+	reindexed := txResultWithEvents(nil)
+	reindexed.Tx = types.Tx("tx-2-0")
+	reindexed.Height = 8
+	reindexed.Index = 2
+	require.NoError(t, indexer.Index(reindexed))
+
+	// nothing to prune without a retain height
+	deleted, done, err := indexer.Prune(100)
+	require.NoError(t, err)
+	assert.True(t, done)
+	assert.Zero(t, deleted)
+
+	require.NoError(t, indexer.SetRetainHeight(6))
+	retainHeight, err := indexer.GetRetainHeight()
+	require.NoError(t, err)
+	assert.EqualValues(t, 6, retainHeight)
+	assert.Error(t, indexer.SetRetainHeight(5))
+
+	// prune in small batches
+	calls, total := 0, 0
+	for done = false; !done; {
+		deleted, done, err = indexer.Prune(3)
+		require.NoError(t, err)
+		total += deleted
+		calls++
+	}
+	assert.Greater(t, calls, 1)
+	// 2 txs per pruned height, each with a height, a position, a hash and an
+	// event key, minus the hash of the transaction indexed again and its event
+	// key, deleted when it was indexed again
+	assert.Equal(t, 5*2*4-2, total)
+
+	for height := int64(1); height <= 10; height++ {
+		for i := 0; i < 2; i++ {
+			txResult, err := indexer.Get(types.Tx(fmt.Sprintf("tx-%d-%d", height, i)).Hash())
+			require.NoError(t, err)
+			switch {
+			case height == 2 && i == 0:
+				assert.True(t, proto.Equal(reindexed, txResult))
+			case height < 6:
+				assert.Nil(t, txResult)
+			default:
+				assert.NotNil(t, txResult)
+			}
+		}
+	}
+
+	// the old event key of the transaction indexed again was dropped when it
+	// was indexed again
+	results, err := indexer.Search(context.Background(), query.MustCompile("account.number >= 1"))
+	require.NoError(t, err)
+	assert.Len(t, results, 10)
+	results, err = indexer.Search(context.Background(), query.MustCompile("tx.height < 6"))
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	// pruning is complete until the retain height is increased
+	deleted, done, err = indexer.Prune(100)
+	require.NoError(t, err)
+	assert.True(t, done)
+	assert.Zero(t, deleted)
+}
+
 func txResultWithEvents(events []abci.Event) *abci.TxResult {
 	tx := types.Tx("HELLO WORLD")
 	return &abci.TxResult{
@@ -1,9 +1,12 @@
 package kv
 
 import (
+	"encoding/binary"
 	"fmt"
 	"math/big"
 
+	dbm "github.com/cometbft/cometbft-db"
+
 	idxutil "github.com/cometbft/cometbft/internal/indexer"
 	cmtsyntax "github.com/cometbft/cometbft/libs/pubsub/query/syntax"
 	"github.com/cometbft/cometbft/state/indexer"
@@ -104,3 +107,62 @@ func checkHeightConditions(heightInfo HeightInfo, keyHeight int64) (bool, error)
 	}
 	return true, nil
 }
+
+func int64ToBytes(i int64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutVarint(buf, i)
+	return buf[:n]
+}
+
+// getInt64 returns the integer stored at key, or 0 if the key is not set.
+func getInt64(store dbm.DB, key []byte) (int64, error) {
+	bz, err := store.Get(key)
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	v, n := binary.Varint(bz)
+	if n <= 0 {
+		return 0, fmt.Errorf("invalid integer stored at %q", key)
+	}
+	return v, nil
+}
+
+// positionKey returns the key of the transaction at the given position in the
+// index of transactions by position. Its name has no dot nor separator, so it
+// cannot collide with the key of an event attribute.
+func positionKey(pos indexer.Cursor) []byte {
+	key, err := orderedcode.Append(nil, positionKeyName, pos.Height, int64(pos.Index))
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// positionKeyEnd returns the smallest key greater than all the keys of the
+// index of transactions by position.
+func positionKeyEnd() []byte {
+	prefix, err := orderedcode.Append(nil, positionKeyName)
+	if err != nil {
+		panic(err)
+	}
+	// The encoding of the name ends with a terminator lower than 0xff.
+	prefix[len(prefix)-1]++
+	return prefix
+}
+
+// parsePositionKey returns the position of the transaction of a key of the
+// index of transactions by position.
+func parsePositionKey(key []byte) (indexer.Cursor, error) {
+	var (
+		name          string
+		height, index int64
+	)
+	remaining, err := orderedcode.Parse(string(key), &name, &height, &index)
+	if err != nil {
+		return indexer.Cursor{}, fmt.Errorf("failed to parse position key: %w", err)
+	}
+	if name != positionKeyName || len(remaining) != 0 {
+		return indexer.Cursor{}, fmt.Errorf("invalid position key %X", key)
+	}
+	return indexer.Cursor{Height: height, Index: uint32(index)}, nil
+}
@@ -10,6 +10,8 @@ import (
 
 	query "github.com/cometbft/cometbft/libs/pubsub/query"
 
+	indexer "github.com/cometbft/cometbft/state/indexer"
+
 	txindex "github.com/cometbft/cometbft/state/txindex"
 
 	types "github.com/cometbft/cometbft/abci/types"
@@ -68,6 +70,34 @@ func (_m *TxIndexer) Get(hash []byte) (*types.TxResult, error) {
 	return r0, r1
 }
 
+// GetRetainHeight provides a mock function with no fields
+func (_m *TxIndexer) GetRetainHeight() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRetainHeight")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Index provides a mock function with given fields: result
 func (_m *TxIndexer) Index(result *types.TxResult) error {
 	ret := _m.Called(result)
@@ -86,6 +116,41 @@ func (_m *TxIndexer) Index(result *types.TxResult) error {
 	return r0
 }
 
+// Prune provides a mock function with given fields: batchSize
+func (_m *TxIndexer) Prune(batchSize int) (int, bool, error) {
+	ret := _m.Called(batchSize)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Prune")
+	}
+
+	var r0 int
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(int) (int, bool, error)); ok {
+		return rf(batchSize)
+	}
+	if rf, ok := ret.Get(0).(func(int) int); ok {
+		r0 = rf(batchSize)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(int) bool); ok {
+		r1 = rf(batchSize)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(int) error); ok {
+		r2 = rf(batchSize)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Search provides a mock function with given fields: ctx, q
 func (_m *TxIndexer) Search(ctx context.Context, q *query.Query) ([]*types.TxResult, error) {
 	ret := _m.Called(ctx, q)
@@ -116,11 +181,66 @@ func (_m *TxIndexer) Search(ctx context.Context, q *query.Query) ([]*types.TxRes
 	return r0, r1
 }
 
+// SearchPage provides a mock function with given fields: ctx, q, page
+func (_m *TxIndexer) SearchPage(ctx context.Context, q *query.Query, page indexer.Page) ([]*types.TxResult, bool, error) {
+	ret := _m.Called(ctx, q, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchPage")
+	}
+
+	var r0 []*types.TxResult
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *query.Query, indexer.Page) ([]*types.TxResult, bool, error)); ok {
+		return rf(ctx, q, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *query.Query, indexer.Page) []*types.TxResult); ok {
+		r0 = rf(ctx, q, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.TxResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *query.Query, indexer.Page) bool); ok {
+		r1 = rf(ctx, q, page)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *query.Query, indexer.Page) error); ok {
+		r2 = rf(ctx, q, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // SetLogger provides a mock function with given fields: l
 func (_m *TxIndexer) SetLogger(l log.Logger) {
 	_m.Called(l)
 }
 
+// SetRetainHeight provides a mock function with given fields: height
+func (_m *TxIndexer) SetRetainHeight(height int64) error {
+	ret := _m.Called(height)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRetainHeight")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(height)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewTxIndexer creates a new instance of TxIndexer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewTxIndexer(t interface {
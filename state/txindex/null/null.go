@@ -8,6 +8,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/state/indexer"
 	"github.com/cometbft/cometbft/state/txindex"
 )
 
@@ -35,6 +36,25 @@ func (txi *TxIndex) Search(_ context.Context, _ *query.Query) ([]*abci.TxResult,
 	return []*abci.TxResult{}, nil
 }
 
+func (txi *TxIndex) SearchPage(context.Context, *query.Query, indexer.Page) ([]*abci.TxResult, bool, error) {
+	return []*abci.TxResult{}, false, nil
+}
+
+// SetRetainHeight on a TxIndex is disabled and returns an error.
+func (txi *TxIndex) SetRetainHeight(int64) error {
+	return errors.New(`indexing is disabled (set 'tx_index = "kv"' in config)`)
+}
+
+// GetRetainHeight always returns 0.
+func (txi *TxIndex) GetRetainHeight() (int64, error) {
+	return 0, nil
+}
+
+// Prune is a noop and always reports that pruning is complete.
+func (txi *TxIndex) Prune(int) (int, bool, error) {
+	return 0, true, nil
+}
+
 func (txi *TxIndex) SetLogger(log.Logger) {
 
 }
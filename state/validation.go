@@ -16,6 +16,7 @@ import (
 type blockValidationOptions struct {
 	blockTimeTolerance         time.Duration
 	skipLastCommitVerification bool
+	signatureCache             *types.SignatureCache
 }
 
 func validateBlock(state State, block *types.Block, opts ...func(*blockValidationOptions)) error {
@@ -99,8 +100,8 @@ func validateBlock(state State, block *types.Block, opts ...func(*blockValidatio
 		}
 	} else if !vopts.skipLastCommitVerification {
 		// LastCommit.Signatures length is checked in VerifyCommit.
-		if err := state.LastValidators.VerifyCommit(
-			state.ChainID, state.LastBlockID, block.Height-1, block.LastCommit); err != nil {
+		if err := state.LastValidators.VerifyCommitWithCache(
+			state.ChainID, state.LastBlockID, block.Height-1, block.LastCommit, vopts.signatureCache); err != nil {
 			return err
 		}
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cometbft/cometbft/types/errors"
 
+	"github.com/go-kit/kit/metrics/generic"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -548,3 +549,62 @@ func TestValidateBlockInvalidCommit(t *testing.T) {
 		require.Contains(t, err.Error(), "commit validator not found in validator set")
 	})
 }
+
+func TestValidateBlockCommitWithSignatureCache(t *testing.T) {
+	proxyApp := newTestApp()
+	require.NoError(t, proxyApp.Start())
+	defer proxyApp.Stop() //nolint:errcheck // ignore for tests
+
+	state, stateDB, privVals := makeState(3, 1)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	mp := &mpmocks.Mempool{}
+	mp.On("Lock").Return()
+	mp.On("Unlock").Return()
+	mp.On("FlushAppConn", mock.Anything).Return(nil)
+	mp.On("Update",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+		mock.Anything).Return(nil)
+
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+
+	hits, misses := generic.NewCounter("hits"), generic.NewCounter("misses")
+	cache := types.NewSignatureCache(types.SignatureCacheMetrics(hits, misses))
+	blockExec := sm.NewBlockExecutor(
+		stateStore,
+		log.TestingLogger(),
+		proxyApp.Consensus(),
+		mp,
+		sm.EmptyEvidencePool{},
+		blockStore,
+		sm.BlockExecutorWithSignatureCache(cache),
+	)
+	require.Equal(t, cache, blockExec.SignatureCache())
+	lastCommit := &types.Commit{}
+	var lastExtCommit *types.ExtendedCommit
+	var verifiedCommit *types.Commit
+
+	for height := int64(1); height < 4; height++ {
+		var err error
+		verifiedCommit = lastCommit
+		state, _, lastExtCommit, err = makeAndCommitGoodBlock(
+			state, height, lastCommit, state.Validators.GetProposer().Address, blockExec, privVals, nil)
+		require.NoError(t, err, "height %d", height)
+		lastCommit = lastExtCommit.ToCommit()
+	}
+
+	// the LastCommit signatures of heights 2 and 3 were verified and cached
+	assert.Equal(t, 6, cache.Len())
+	assert.Zero(t, hits.Value())
+
+	// verifying one of these commits again does not verify any signature
+	err := state.LastValidators.VerifyCommitWithCache(
+		state.ChainID, verifiedCommit.BlockID, verifiedCommit.Height, verifiedCommit, cache)
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, hits.Value())
+}
@@ -45,6 +45,7 @@ type lightClientStateProvider struct {
 }
 
 // NewLightClientStateProvider creates a new StateProvider using a light client and RPC clients.
+// lightOptions are passed on to the light client.
 func NewLightClientStateProvider(
 	ctx context.Context,
 	chainID string,
@@ -53,6 +54,7 @@ func NewLightClientStateProvider(
 	servers []string,
 	trustOptions light.TrustOptions,
 	logger log.Logger,
+	lightOptions ...light.Option,
 ) (StateProvider, error) {
 	if len(servers) < 2 {
 		return nil, fmt.Errorf("at least 2 RPC servers are required, got %v", len(servers))
@@ -72,8 +74,9 @@ func NewLightClientStateProvider(
 		providerRemotes[provider] = server
 	}
 
+	lightOptions = append([]light.Option{light.Logger(logger), light.MaxRetryAttempts(5)}, lightOptions...)
 	lc, err := light.NewClient(ctx, chainID, trustOptions, providers[0], providers[1:],
-		lightdb.New(dbm.NewMemDB(), ""), light.Logger(logger), light.MaxRetryAttempts(5))
+		lightdb.New(dbm.NewMemDB(), ""), lightOptions...)
 	if err != nil {
 		return nil, err
 	}
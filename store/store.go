@@ -630,6 +630,19 @@ func (bs *BlockStore) Close() error {
 
 //-----------------------------------------------------------------------------
 
+// KeyPrefixes returns the prefixes of the keys of the block store, by the kind
+// of data they hold.
+func KeyPrefixes() map[string][]byte {
+	return map[string][]byte{
+		"block_meta":       []byte("H:"),
+		"block_parts":      []byte("P:"),
+		"commits":          []byte("C:"),
+		"seen_commits":     []byte("SC:"),
+		"extended_commits": []byte("EC:"),
+		"block_hashes":     []byte("BH:"),
+	}
+}
+
 func calcBlockMetaKey(height int64) []byte {
 	return []byte(fmt.Sprintf("H:%v", height))
 }
@@ -369,6 +369,9 @@ func (t Testnet) Validate() error {
 	if t.BlockMaxBytes > types.MaxBlockSizeBytes {
 		return fmt.Errorf("value of BlockMaxBytes cannot be higher than %d", types.MaxBlockSizeBytes)
 	}
+	if _, ok := types.ABCIPubKeyTypesToNames[t.KeyType]; !ok {
+		return fmt.Errorf("unsupported key type %q (bls12_381 requires the bls12381 build tag)", t.KeyType)
+	}
 	if t.VoteExtensionsUpdateHeight < -1 {
 		return fmt.Errorf("value of VoteExtensionsUpdateHeight must be positive, 0 (InitChain), "+
 			"or -1 (Genesis); update height %d", t.VoteExtensionsUpdateHeight)
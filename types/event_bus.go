@@ -109,7 +109,7 @@ func (b *EventBus) Publish(eventType string, eventData TMEventData) error {
 // map of stringified events where each key is composed of the event
 // type and each of the event's attributes keys in the form of
 // "{event.Type}.{attribute.Key}" and the value is each attribute's value.
-func (*EventBus) validateAndStringifyEvents(events []types.Event) map[string][]string {
+func validateAndStringifyEvents(events []types.Event) map[string][]string {
 	result := make(map[string][]string)
 	for _, event := range events {
 		if len(event.Type) == 0 {
@@ -132,24 +132,33 @@ func (*EventBus) validateAndStringifyEvents(events []types.Event) map[string][]s
 func (b *EventBus) PublishEventNewBlock(data EventDataNewBlock) error {
 	// no explicit deadline for publishing events
 	ctx := context.Background()
-	events := b.validateAndStringifyEvents(data.ResultFinalizeBlock.Events)
+	return b.pubsub.PublishWithEvents(ctx, data, data.QueryEvents())
+}
+
+// QueryEvents returns the events against which the queries of the
+// subscriptions are matched when data is published.
+func (data EventDataNewBlock) QueryEvents() map[string][]string {
+	events := validateAndStringifyEvents(data.ResultFinalizeBlock.Events)
 
 	// add predefined new block event
 	events[EventTypeKey] = append(events[EventTypeKey], EventNewBlock)
-
-	return b.pubsub.PublishWithEvents(ctx, data, events)
+	return events
 }
 
 func (b *EventBus) PublishEventNewBlockEvents(data EventDataNewBlockEvents) error {
 	// no explicit deadline for publishing events
 	ctx := context.Background()
+	return b.pubsub.PublishWithEvents(ctx, data, data.QueryEvents())
+}
 
-	events := b.validateAndStringifyEvents(data.Events)
+// QueryEvents returns the events against which the queries of the
+// subscriptions are matched when data is published.
+func (data EventDataNewBlockEvents) QueryEvents() map[string][]string {
+	events := validateAndStringifyEvents(data.Events)
 
 	// add predefined new block event
 	events[EventTypeKey] = append(events[EventTypeKey], EventNewBlockEvents)
-
-	return b.pubsub.PublishWithEvents(ctx, data, events)
+	return events
 }
 
 func (b *EventBus) PublishEventNewBlockHeader(data EventDataNewBlockHeader) error {
@@ -174,15 +183,19 @@ func (b *EventBus) PublishEventValidBlock(data EventDataRoundState) error {
 func (b *EventBus) PublishEventTx(data EventDataTx) error {
 	// no explicit deadline for publishing events
 	ctx := context.Background()
+	return b.pubsub.PublishWithEvents(ctx, data, data.QueryEvents())
+}
 
-	events := b.validateAndStringifyEvents(data.Result.Events)
+// QueryEvents returns the events against which the queries of the
+// subscriptions are matched when data is published.
+func (data EventDataTx) QueryEvents() map[string][]string {
+	events := validateAndStringifyEvents(data.Result.Events)
 
 	// add predefined compositeKeys
 	events[EventTypeKey] = append(events[EventTypeKey], EventTx)
 	events[TxHashKey] = append(events[TxHashKey], fmt.Sprintf("%X", Tx(data.Tx).Hash()))
 	events[TxHeightKey] = append(events[TxHeightKey], fmt.Sprintf("%d", data.Height))
-
-	return b.pubsub.PublishWithEvents(ctx, data, events)
+	return events
 }
 
 func (b *EventBus) PublishEventNewRoundStep(data EventDataRoundState) error {
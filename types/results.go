@@ -1,8 +1,12 @@
 package types
 
 import (
+	"bytes"
+	"errors"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 )
 
 // ABCIResults wraps the deliver tx results to return a proof.
@@ -29,6 +33,16 @@ func (a ABCIResults) ProveResult(i int) merkle.Proof {
 	return *proofs[i]
 }
 
+// Proofs returns the proofs of all the results, in order.
+func (a ABCIResults) Proofs() []TxResultProof {
+	root, proofs := merkle.ProofsFromByteSlices(a.toByteSlices())
+	res := make([]TxResultProof, len(proofs))
+	for i, proof := range proofs {
+		res[i] = TxResultProof{RootHash: root, Proof: *proof}
+	}
+	return res
+}
+
 func (a ABCIResults) toByteSlices() [][]byte {
 	l := len(a)
 	bzs := make([][]byte, l)
@@ -41,3 +55,42 @@ func (a ABCIResults) toByteSlices() [][]byte {
 	}
 	return bzs
 }
+
+// TxResultProof represents a Merkle proof of the presence of the result of a
+// transaction in the results of a block, whose root is the LastResultsHash of
+// the header of the next block. Only the deterministic fields of the result
+// (code, data, gas wanted and gas used) are proven: its events, log, info and
+// codespace are not part of the results hash.
+type TxResultProof struct {
+	RootHash cmtbytes.HexBytes `json:"root_hash"`
+	Proof    merkle.Proof      `json:"proof"`
+}
+
+// Leaf returns the encoding of the deterministic fields of result, which is
+// the leaf in the merkle tree which this proof refers to.
+func (rp TxResultProof) Leaf(result *abci.ExecTxResult) []byte {
+	bz, err := abci.DeterministicExecTxResult(result).Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// Validate verifies the proof of result. It returns nil if the RootHash
+// matches the resultsHash argument, and if the proof is internally
+// consistent. Otherwise, it returns a sensible error.
+func (rp TxResultProof) Validate(resultsHash []byte, result *abci.ExecTxResult) error {
+	if !bytes.Equal(resultsHash, rp.RootHash) {
+		return errors.New("proof matches different results hash")
+	}
+	if rp.Proof.Index < 0 {
+		return errors.New("proof index cannot be negative")
+	}
+	if rp.Proof.Total <= 0 {
+		return errors.New("proof total must be positive")
+	}
+	if err := rp.Proof.Verify(rp.RootHash, rp.Leaf(result)); err != nil {
+		return errors.New("proof is not internally consistent")
+	}
+	return nil
+}
@@ -52,3 +52,30 @@ func TestABCIResults(t *testing.T) {
 		assert.NoError(t, valid, "%d", i)
 	}
 }
+
+func TestTxResultProofs(t *testing.T) {
+	results := NewResults([]*abci.ExecTxResult{
+		{Code: 0, Data: []byte("one"), GasUsed: 10},
+		{Code: 14, Log: "failed", Codespace: "app"},
+		{Code: 0, Events: []abci.Event{{Type: "app"}}},
+	})
+	root := results.Hash()
+
+	proofs := results.Proofs()
+	require.Len(t, proofs, len(results))
+	for i, proof := range proofs {
+		assert.Equal(t, results.ProveResult(i), proof.Proof, "%d", i)
+		assert.EqualValues(t, root, proof.RootHash, "%d", i)
+		assert.NoError(t, proof.Validate(root, results[i]), "%d", i)
+		assert.Error(t, proof.Validate([]byte("foobar"), results[i]), "%d", i)
+		assert.Error(t, proof.Validate(root, results[(i+1)%len(results)]), "%d", i)
+	}
+
+	// The non-deterministic fields are not proven.
+	result := *results[1]
+	result.Log = "other"
+	result.Events = []abci.Event{{Type: "other"}}
+	assert.NoError(t, proofs[1].Validate(root, &result))
+	result.Code = 15
+	assert.Error(t, proofs[1].Validate(root, &result))
+}
@@ -1,30 +1,143 @@
 package types
 
+import (
+	"bytes"
+	"container/list"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// DefaultSignatureCacheSize is the default maximum number of verified
+// signatures kept by a SignatureCache.
+const DefaultSignatureCacheSize = 10000
+
 // The value type for the verified signature cache.
 type SignatureCacheValue struct {
 	ValidatorAddress []byte
 	VoteSignBytes    []byte
 }
 
+// SignatureCache is a thread-safe, size-bounded LRU cache of verified vote
+// signatures, keyed by signature. A single cache can be shared by every
+// component verifying commits (consensus, blocksync, light client), so that a
+// signature verified by one of them is not verified again by the others.
 type SignatureCache struct {
-	cache map[string]SignatureCacheValue
+	mtx      cmtsync.Mutex
+	size     int
+	cacheMap map[string]*list.Element
+	list     *list.List
+
+	hits   metrics.Counter
+	misses metrics.Counter
+}
+
+type signatureCacheEntry struct {
+	key   string
+	value SignatureCacheValue
 }
 
-func NewSignatureCache() *SignatureCache {
-	return &SignatureCache{
-		cache: make(map[string]SignatureCacheValue),
+// SignatureCacheOption sets an optional parameter on the SignatureCache.
+type SignatureCacheOption func(*SignatureCache)
+
+// SignatureCacheSize sets the maximum number of signatures kept in the cache.
+// Once full, the least recently used signature is evicted.
+func SignatureCacheSize(size int) SignatureCacheOption {
+	return func(sc *SignatureCache) {
+		sc.size = size
 	}
 }
 
+// SignatureCacheMetrics sets the counters incremented on every hit and miss of
+// Verified.
+func SignatureCacheMetrics(hits, misses metrics.Counter) SignatureCacheOption {
+	return func(sc *SignatureCache) {
+		sc.hits = hits
+		sc.misses = misses
+	}
+}
+
+// NewSignatureCache returns a new SignatureCache holding at most
+// DefaultSignatureCacheSize signatures, unless overridden by an option.
+func NewSignatureCache(options ...SignatureCacheOption) *SignatureCache {
+	sc := &SignatureCache{
+		size:   DefaultSignatureCacheSize,
+		list:   list.New(),
+		hits:   discard.NewCounter(),
+		misses: discard.NewCounter(),
+	}
+	for _, option := range options {
+		option(sc)
+	}
+	if sc.size < 1 {
+		sc.size = 1
+	}
+	sc.cacheMap = make(map[string]*list.Element)
+	return sc
+}
+
+// Add adds a verified signature to the cache, evicting the least recently
+// used one if the cache is full.
 func (sc *SignatureCache) Add(key string, value SignatureCacheValue) {
-	sc.cache[key] = value
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	if e, ok := sc.cacheMap[key]; ok {
+		e.Value.(*signatureCacheEntry).value = value
+		sc.list.MoveToBack(e)
+		return
+	}
+
+	if sc.list.Len() >= sc.size {
+		if front := sc.list.Front(); front != nil {
+			delete(sc.cacheMap, front.Value.(*signatureCacheEntry).key)
+			sc.list.Remove(front)
+		}
+	}
+
+	sc.cacheMap[key] = sc.list.PushBack(&signatureCacheEntry{key: key, value: value})
 }
 
+// Get returns the value stored for the given signature, if any.
 func (sc *SignatureCache) Get(key string) (SignatureCacheValue, bool) {
-	value, ok := sc.cache[key]
-	return value, ok
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	e, ok := sc.cacheMap[key]
+	if !ok {
+		return SignatureCacheValue{}, false
+	}
+	sc.list.MoveToBack(e)
+	return e.Value.(*signatureCacheEntry).value, true
 }
 
+// Verified returns true if the given signature was verified for the same
+// validator and sign bytes as value. Only such a match counts as a hit.
+func (sc *SignatureCache) Verified(key string, value SignatureCacheValue) bool {
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	e, ok := sc.cacheMap[key]
+	if ok {
+		cached := e.Value.(*signatureCacheEntry).value
+		ok = bytes.Equal(cached.ValidatorAddress, value.ValidatorAddress) &&
+			bytes.Equal(cached.VoteSignBytes, value.VoteSignBytes)
+	}
+	if !ok {
+		sc.misses.Add(1)
+		return false
+	}
+	sc.hits.Add(1)
+	sc.list.MoveToBack(e)
+	return true
+}
+
+// Len returns the number of signatures in the cache.
 func (sc *SignatureCache) Len() int {
-	return len(sc.cache)
+	sc.mtx.Lock()
+	defer sc.mtx.Unlock()
+
+	return sc.list.Len()
 }
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/go-kit/kit/metrics/generic"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignatureCache_Eviction(t *testing.T) {
+	cache := NewSignatureCache(SignatureCacheSize(2))
+
+	cache.Add("a", SignatureCacheValue{ValidatorAddress: []byte("a")})
+	cache.Add("b", SignatureCacheValue{ValidatorAddress: []byte("b")})
+
+	// "a" is now the most recently used entry
+	_, ok := cache.Get("a")
+	require.True(t, ok)
+
+	cache.Add("c", SignatureCacheValue{ValidatorAddress: []byte("c")})
+	assert.Equal(t, 2, cache.Len())
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok, "least recently used entry must be evicted")
+	v, ok := cache.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, []byte("a"), v.ValidatorAddress)
+	_, ok = cache.Get("c")
+	assert.True(t, ok)
+
+	// re-adding an existing key does not grow the cache
+	cache.Add("c", SignatureCacheValue{ValidatorAddress: []byte("d")})
+	assert.Equal(t, 2, cache.Len())
+	v, _ = cache.Get("c")
+	assert.Equal(t, []byte("d"), v.ValidatorAddress)
+}
+
+func TestSignatureCache_Metrics(t *testing.T) {
+	hits, misses := generic.NewCounter("hits"), generic.NewCounter("misses")
+	cache := NewSignatureCache(SignatureCacheMetrics(hits, misses))
+
+	value := SignatureCacheValue{ValidatorAddress: []byte("val"), VoteSignBytes: []byte("vote")}
+	cache.Add("a", value)
+	assert.True(t, cache.Verified("a", value))
+	assert.True(t, cache.Verified("a", value))
+	assert.False(t, cache.Verified("b", value))
+	// a signature cached for other sign bytes is not a hit
+	assert.False(t, cache.Verified("a", SignatureCacheValue{ValidatorAddress: []byte("val"), VoteSignBytes: []byte("other")}))
+	// nor is a lookup without verification
+	cache.Get("a")
+
+	assert.Equal(t, 2.0, hits.Value())
+	assert.Equal(t, 2.0, misses.Value())
+}
+
+func TestSignatureCache_Concurrency(t *testing.T) {
+	const size = 100
+	cache := NewSignatureCache(SignatureCacheSize(size))
+
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				key := strconv.Itoa(w*1000 + i)
+				cache.Add(key, SignatureCacheValue{})
+				cache.Get(key)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	assert.Equal(t, size, cache.Len())
+}
@@ -29,6 +29,18 @@ func shouldBatchVerify(vals *ValidatorSet, commit *Commit) bool {
 // with a bonus for including more than +2/3 of the signatures.
 func VerifyCommit(chainID string, vals *ValidatorSet, blockID BlockID,
 	height int64, commit *Commit,
+) error {
+	return VerifyCommitWithCache(chainID, vals, blockID, height, commit, nil)
+}
+
+// VerifyCommitWithCache verifies +2/3 of the set had signed the given commit.
+//
+// Like VerifyCommit, it checks all the signatures, but the cache provided will
+// be used to skip signature verification for entries where the key
+// (signature), validator pubkey, and vote sign bytes all match.
+// Additionally, any verified signatures will be added to the cache.
+func VerifyCommitWithCache(chainID string, vals *ValidatorSet, blockID BlockID,
+	height int64, commit *Commit, verifiedSignatureCache *SignatureCache,
 ) error {
 	// run a basic validation of the arguments
 	if err := verifyBasicValsAndCommit(vals, commit, height, blockID); err != nil {
@@ -48,12 +60,12 @@ func VerifyCommit(chainID string, vals *ValidatorSet, blockID BlockID,
 	// attempt to batch verify
 	if shouldBatchVerify(vals, commit) {
 		return verifyCommitBatch(chainID, vals, commit,
-			votingPowerNeeded, ignore, count, true, true, nil, nil)
+			votingPowerNeeded, ignore, count, true, true, nil, verifiedSignatureCache)
 	}
 
 	// if verification failed or is not supported then fallback to single verification
 	return verifyCommitSingle(chainID, vals, commit, votingPowerNeeded,
-		ignore, count, true, true, nil)
+		ignore, count, true, true, verifiedSignatureCache)
 }
 
 // LIGHT CLIENT VERIFICATION METHODS
@@ -325,8 +337,10 @@ func verifyCommitBatch(
 
 		cacheHit := false
 		if verifiedSignatureCache != nil {
-			cacheVal, sigIsInCache := verifiedSignatureCache.Get(string(commitSig.Signature))
-			cacheHit = sigIsInCache && bytes.Equal(cacheVal.ValidatorAddress, val.PubKey.Address()) && bytes.Equal(cacheVal.VoteSignBytes, voteSignBytes)
+			cacheHit = verifiedSignatureCache.Verified(string(commitSig.Signature), SignatureCacheValue{
+				ValidatorAddress: val.PubKey.Address(),
+				VoteSignBytes:    voteSignBytes,
+			})
 		}
 
 		if !cacheHit {
@@ -472,8 +486,10 @@ func verifyCommitSingle(
 		cacheKey, cacheHit := "", false
 		if verifiedSignatureCache != nil {
 			cacheKey = string(commitSig.Signature)
-			cacheVal, sigIsInCache := verifiedSignatureCache.Get(cacheKey)
-			cacheHit = sigIsInCache && bytes.Equal(cacheVal.ValidatorAddress, val.PubKey.Address()) && bytes.Equal(cacheVal.VoteSignBytes, voteSignBytes)
+			cacheHit = verifiedSignatureCache.Verified(cacheKey, SignatureCacheValue{
+				ValidatorAddress: val.PubKey.Address(),
+				VoteSignBytes:    voteSignBytes,
+			})
 		}
 
 		if !cacheHit {
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+	"sort"
 	"strconv"
 	"testing"
 	"time"
@@ -8,7 +10,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/cometbft/cometbft/crypto/ed25519"
 	cryptomocks "github.com/cometbft/cometbft/crypto/mocks"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/internal/keytypes"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
@@ -567,3 +573,88 @@ func TestValidation_verifyCommitSingle_UsesCache(t *testing.T) {
 	mockValPubkeys[3].AssertNotCalled(t, "VerifySignature")
 	mockValPubkeys[4].AssertNotCalled(t, "VerifySignature")
 }
+
+// randValidatorSetWithKeyType returns a validator set whose validators all use
+// keys of the given type, and the matching private validators sorted by
+// address.
+func randValidatorSetWithKeyType(tb testing.TB, keyType string, numValidators int) (*ValidatorSet, []PrivValidator) {
+	tb.Helper()
+
+	var (
+		valz           = make([]*Validator, numValidators)
+		privValidators = make([]PrivValidator, numValidators)
+	)
+	for i := 0; i < numValidators; i++ {
+		privKey, err := keytypes.GenPrivKey(keyType)
+		require.NoError(tb, err)
+		pv := NewMockPVWithParams(privKey, false, false)
+		valz[i] = pv.ExtractIntoValidator(10)
+		privValidators[i] = pv
+	}
+	sort.Sort(PrivValidatorsByAddress(privValidators))
+
+	return NewValidatorSet(valz), privValidators
+}
+
+func testKeyTypes() []string {
+	keyTypes := []string{ed25519.KeyType, secp256k1.KeyType}
+	if bls12381.Enabled {
+		keyTypes = append(keyTypes, bls12381.KeyType)
+	}
+	return keyTypes
+}
+
+func TestValidatorSet_VerifyCommit_BatchKeyTypes(t *testing.T) {
+	const chainID = "test_chain_id"
+
+	for _, keyType := range testKeyTypes() {
+		t.Run(keyType, func(t *testing.T) {
+			valSet, vals := randValidatorSetWithKeyType(t, keyType, 4)
+			require.True(t, shouldBatchVerify(valSet, &Commit{Signatures: make([]CommitSig, 4)}))
+
+			blockID := makeBlockIDRandom()
+			voteSet := NewVoteSet(chainID, 1, 0, cmtproto.PrecommitType, valSet)
+			// all votes share the same timestamp, hence the same sign bytes
+			extCommit, err := MakeExtCommit(blockID, 1, 0, voteSet, vals, cmttime.Now(), false)
+			require.NoError(t, err)
+			commit := extCommit.ToCommit()
+
+			require.NoError(t, valSet.VerifyCommit(chainID, blockID, 1, commit))
+			require.NoError(t, valSet.VerifyCommitLight(chainID, blockID, 1, commit))
+			require.NoError(t, valSet.VerifyCommitLightTrusting(chainID, commit, cmtmath.Fraction{Numerator: 1, Denominator: 3}))
+
+			// replace the signature of the second validator by the one of
+			// the third
+			commit.Signatures[1].Signature = commit.Signatures[2].Signature
+			err = valSet.VerifyCommit(chainID, blockID, 1, commit)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), "wrong signature (#1)")
+			}
+		})
+	}
+}
+
+func BenchmarkVerifyCommit(b *testing.B) {
+	const chainID = "test_chain_id"
+
+	for _, keyType := range testKeyTypes() {
+		for _, numValidators := range []int{1, 10, 100} {
+			b.Run(fmt.Sprintf("%s/vals-%d", keyType, numValidators), func(b *testing.B) {
+				valSet, vals := randValidatorSetWithKeyType(b, keyType, numValidators)
+				blockID := makeBlockIDRandom()
+				voteSet := NewVoteSet(chainID, 1, 0, cmtproto.PrecommitType, valSet)
+				extCommit, err := MakeExtCommit(blockID, 1, 0, voteSet, vals, cmttime.Now(), false)
+				require.NoError(b, err)
+				commit := extCommit.ToCommit()
+
+				b.ResetTimer()
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if err := valSet.VerifyCommit(chainID, blockID, 1, commit); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -731,6 +731,19 @@ func (vals *ValidatorSet) VerifyCommit(chainID string, blockID BlockID,
 	return VerifyCommit(chainID, vals, blockID, height, commit)
 }
 
+// VerifyCommitWithCache verifies +2/3 of the set had signed the given commit
+// and all other signatures are valid.
+//
+// The cache provided will be used to skip signature verification for entries where the
+// key (signature), validator pubkey, and vote sign bytes all match.
+// Additionally, any verified signatures will be added to the cache.
+func (vals *ValidatorSet) VerifyCommitWithCache(chainID string, blockID BlockID,
+	height int64, commit *Commit,
+	verifiedSignatureCache *SignatureCache,
+) error {
+	return VerifyCommitWithCache(chainID, vals, blockID, height, commit, verifiedSignatureCache)
+}
+
 // LIGHT CLIENT VERIFICATION METHODS
 
 // VerifyCommitLight verifies +2/3 of the set had signed the given commit.